@@ -0,0 +1,108 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// o2control-fakedevice is a simulated O² device, which serves the OCC API
+// like an OCClib-based or FairMQ process, but does no data processing.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/occ/fakedevice"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+)
+
+func parseEventDelays(delays []string) (map[string]time.Duration, error) {
+	eventDelays := make(map[string]time.Duration)
+	for _, delay := range delays {
+		split := strings.SplitN(delay, "=", 2)
+		if len(split) != 2 {
+			return nil, fmt.Errorf("invalid delay %s, expected EVENT=DURATION", delay)
+		}
+		duration, err := time.ParseDuration(split[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid delay %s: %s", delay, err.Error())
+		}
+		eventDelays[split[0]] = duration
+	}
+	return eventDelays, nil
+}
+
+func main() {
+	defaultControlPort, _ := strconv.ParseUint(os.Getenv("OCC_CONTROL_PORT"), 10, 64)
+
+	// Task classes written for real devices pass their own arguments too
+	flags := pflag.NewFlagSet(os.Args[0], pflag.ExitOnError)
+	flags.ParseErrorsWhitelist.UnknownFlags = true
+
+	controlPort := flags.Uint64("control-port", defaultControlPort, "Port of the OCC gRPC server")
+	controlMode := flags.String("control-mode", "direct", "State machine to emulate: direct or fairmq")
+	transitionDelay := flags.Duration("transition-delay", 0, "Duration of every transition")
+	delays := flags.StringArray("delay", []string{}, "Duration of the transitions of one event, as EVENT=DURATION, overrides --transition-delay")
+	failOn := flags.StringArray("fail-on", []string{}, "Event whose transition fails and leaves the device in ERROR, e.g. CONFIGURE, or \"INIT TASK\" with --control-mode fairmq")
+	crashAfter := flags.Duration("crash-after", 0, "Crash with exit code 1 after running for this long")
+	endOfDataAfter := flags.Duration("end-of-data-after", 0, "Emit END_OF_DATA after running for this long")
+	verbose := flags.Bool("verbose", false, "Verbose logging")
+	_ = flags.Parse(os.Args[1:])
+
+	if *verbose {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+
+	cfg := fakedevice.Config{
+		TransitionDelay: *transitionDelay,
+		FailEvents:      *failOn,
+		CrashAfter:      *crashAfter,
+		EndOfDataAfter:  *endOfDataAfter,
+	}
+	if err := cfg.ControlMode.UnmarshalText([]byte(*controlMode)); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(2)
+	}
+	eventDelays, err := parseEventDelays(*delays)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(2)
+	}
+	cfg.EventDelays = eventDelays
+
+	if *controlPort == 0 {
+		fmt.Fprintln(os.Stderr, "no control port, use --control-port or OCC_CONTROL_PORT")
+		os.Exit(2)
+	}
+
+	err = fakedevice.Run(cfg, *controlPort)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
@@ -51,6 +51,10 @@ Examples:
  * ` + "`myworkflow@rev`" + ` - loads a workflow from default repository, on branch, tag or revision ` + "`rev`" + `
  * ` + "`coconut env create -w github.com/AliceO2Group/MyConfRepo/myworkflow@rev`" + ` - loads a workflow from a specific git repository, on branch, tag or revision ` + "`rev`" + `
 
+Workflow templates may declare parameters, which are set with the var flag, once per parameter:
+ * ` + "`coconut env create -w myworkflow --var detector=TPC --var flps=4`" + `
+The parameters declared by each workflow template are listed by ` + "`coconut template list`" + `.
+
 For more information on the %s workflow configuration system, see documentation for the ` + "`coconut repository`" + ` command.`, product.PRETTY_SHORTNAME, product.PRETTY_SHORTNAME),
 	Run:   control.WrapCall(control.CreateEnvironment),
 
@@ -61,4 +65,5 @@ func init() {
 
 	environmentCreateCmd.Flags().StringP("workflow-template", "w", "", "workflow to be loaded in the new environment")
 	environmentCreateCmd.MarkFlagRequired("workflow-template")
+	environmentCreateCmd.Flags().StringArray("var", []string{}, "workflow template parameter as KEY=VALUE, can be repeated")
 }
@@ -29,21 +29,29 @@ import (
 	"github.com/AliceO2Group/Control/coconut/control"
 )
 
-// environmentModifyCmd represents the environment list command
+// environmentModifyCmd represents the environment modify command
 var environmentModifyCmd = &cobra.Command{
 	Use:   "modify [environment id]",
 	Aliases: []string{"mod", "m"},
 	Short: "modify an environment",
 	Long: `The environment modify command changes the roles workflow of an 
-existing O² environment.`,
+existing O² environment.
+
+An iterator role can be given a new range with --begin and --end, or a new list of values with --values. The iterator is identified with --iterator, either by the path of its template or by the path of any of its current child roles. New child roles are deployed and configured, child roles which are no longer generated have their tasks released, and tasks with channels towards added or removed roles are reconfigured.
+
+The environment must be in state CONFIGURED.`,
 	Run:   control.WrapCall(control.ModifyEnvironment),
 	Args:  cobra.ExactArgs(1),
 }
 
 func init() {
-	//environmentCmd.AddCommand(environmentModifyCmd)
-	//
+	environmentCmd.AddCommand(environmentModifyCmd)
+
 	//environmentModifyCmd.Flags().StringArrayP("addroles", "a", []string{}, "a list of roles to add to the environment")
 	//environmentModifyCmd.Flags().StringArrayP("removeroles", "r", []string{}, "a list of roles to remove from the environment")
 	//environmentModifyCmd.Flags().BoolP("reconfigure", "c", false, "reconfigure all roles")
+	environmentModifyCmd.Flags().StringP("iterator", "i", "", "path of the iterator role to modify")
+	environmentModifyCmd.Flags().Int32P("begin", "b", 0, "new first value of the iterator range")
+	environmentModifyCmd.Flags().Int32P("end", "e", 0, "new last value of the iterator range")
+	environmentModifyCmd.Flags().StringSlice("values", []string{}, "new list of values of the iterator, overrides --begin and --end")
 }
@@ -48,4 +48,5 @@ func init() {
 
 	environmentShowCmd.Flags().BoolP("tasks", "t", false, "print a list of tasks in this environment")
 	environmentShowCmd.Flags().BoolP("workflow", "w", false, "print the workflow tree")
+	environmentShowCmd.Flags().BoolP("history", "H", false, "print the history of transitions and task restarts")
 }
@@ -0,0 +1,51 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/AliceO2Group/Control/coconut/control"
+)
+
+// hostDrainCmd represents the host drain command
+var hostDrainCmd = &cobra.Command{
+	Use:   "drain [hostname or agent id]",
+	Short: "exclude a host from new deployments",
+	Long: `The host drain command marks a host as draining, or as under maintenance
+with --maintenance. Tasks already running on the host are not affected, but
+offers from the host are declined for new deployments and its idle tasks are
+not reused by new environments. Environments with tasks on the host are flagged.
+The host state persists in the configuration store across core restarts.`,
+	Args:  cobra.ExactArgs(1),
+	Run:   control.WrapCall(control.DrainHost),
+}
+
+func init() {
+	hostCmd.AddCommand(hostDrainCmd)
+	hostDrainCmd.Flags().BoolP("maintenance", "m", false, "mark the host as under maintenance instead of draining")
+}
@@ -0,0 +1,47 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/AliceO2Group/Control/coconut/control"
+)
+
+// hostEnableCmd represents the host enable command
+var hostEnableCmd = &cobra.Command{
+	Use:   "enable [hostname or agent id]",
+	Short: "return a drained host to service",
+	Long: `The host enable command marks a host which was drained or under
+maintenance as active again, so that it is considered for new deployments.`,
+	Args:  cobra.ExactArgs(1),
+	Run:   control.WrapCall(control.EnableHost),
+}
+
+func init() {
+	hostCmd.AddCommand(hostEnableCmd)
+}
@@ -0,0 +1,50 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/AliceO2Group/Control/coconut/control"
+)
+
+// taskClassCmd represents the task class command
+var taskClassCmd = &cobra.Command{
+	Use:   "class [task class]",
+	Aliases: []string{"c"},
+	Short: "show a resolved task class",
+	Long: `The task class command shows the definition of a task class as it is
+used to deploy tasks, i.e. with all the task classes it extends merged in.
+The task class is looked up in the default repository unless a repository
+is specified, e.g. github.com/AliceO2Group/ControlWorkflows/tasks/readout@master.`,
+	Run:   control.WrapCall(control.ShowTaskClass),
+	Args:  cobra.ExactArgs(1),
+}
+
+func init() {
+	taskCmd.AddCommand(taskClassCmd)
+}
@@ -35,7 +35,9 @@ var templateListCmd = &cobra.Command{
 	Aliases: []string{"list", "ls", "l"},
 	Short: "list available workflow templates",
 	Long: `The template list command shows a list of available workflow templates.
-These workflow templates can then be loaded to create an environment.`,
+These workflow templates can then be loaded to create an environment.
+For each template, the parameters it declares are listed with their type, default
+value, allowed values and description. Parameters without a default are required.`,
 	Run:   control.WrapCall(control.ListWorkflowTemplates),
 }
 
@@ -153,7 +153,11 @@ func GetEnvironments(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Com
 		data := make([][]string, 0, 0)
 		for _, envi := range response.GetEnvironments() {
 			formatted := formatTimestamp(envi.GetCreatedWhen())
-			data = append(data, []string{envi.GetId(), formatted, colorState(envi.GetState())})
+			stateStr := colorState(envi.GetState())
+			if len(envi.GetUnavailableHosts()) > 0 {
+				stateStr += " " + red(fmt.Sprintf("(%d hosts unavailable)", len(envi.GetUnavailableHosts())))
+			}
+			data = append(data, []string{envi.GetId(), formatted, stateStr})
 		}
 
 		table.AppendBulk(data)
@@ -174,9 +178,24 @@ func CreateEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 		return
 	}
 
+	varsList, err := cmd.Flags().GetStringArray("var")
+	if err != nil {
+		return
+	}
+	vars := make(map[string]string)
+	for _, kv := range varsList {
+		kvSplit := strings.SplitN(kv, "=", 2)
+		if len(kvSplit) != 2 || len(strings.TrimSpace(kvSplit[0])) == 0 {
+			err = fmt.Errorf("invalid var %s, expected KEY=VALUE", kv)
+			return
+		}
+		vars[strings.TrimSpace(kvSplit[0])] = kvSplit[1]
+	}
+
 	var response *pb.NewEnvironmentReply
-	response, err = rpc.NewEnvironment(cxt, &pb.NewEnvironmentRequest{WorkflowTemplate: wfPath}, grpc.EmptyCallOption{})
+	response, err = rpc.NewEnvironment(cxt, &pb.NewEnvironmentRequest{WorkflowTemplate: wfPath, Vars: vars}, grpc.EmptyCallOption{})
 	if err != nil {
+		printDeploymentFailure(err, o)
 		return
 	}
 
@@ -205,6 +224,10 @@ func ShowEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Com
 	if err != nil {
 		return
 	}
+	printHistory, err := cmd.Flags().GetBool("history")
+	if err != nil {
+		return
+	}
 
 	var response *pb.GetEnvironmentReply
 	response, err = rpc.GetEnvironment(cxt, &pb.GetEnvironmentRequest{Id: args[0]}, grpc.EmptyCallOption{})
@@ -220,6 +243,9 @@ func ShowEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Com
 	_, _ = fmt.Fprintf(o, "created:            %s\n", formatTimestamp(env.GetCreatedWhen()))
 	_, _ = fmt.Fprintf(o, "state:              %s\n", colorState(env.GetState()))
 	_, _ = fmt.Fprintf(o, "run number:         %s\n", rnString)
+	if len(env.GetUnavailableHosts()) > 0 {
+		_, _ = fmt.Fprintf(o, "unavailable hosts:  %s\n", red(strings.Join(env.GetUnavailableHosts(), ", ")))
+	}
 
 	if printTasks {
 		fmt.Fprintln(o, "")
@@ -239,6 +265,28 @@ func ShowEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Com
 		fmt.Fprintf(o, "\nworkflow:\n")
 		drawWorkflow(response.GetWorkflow(), o)
 	}
+
+	if printHistory {
+		fmt.Fprintf(o, "\nhistory:\n")
+		table := tablewriter.NewWriter(o)
+		table.SetHeader([]string{"time", "event", "role", "task id", "message"})
+		table.SetBorder(false)
+		fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+		table.SetHeaderColor(fg, fg, fg, fg, fg)
+
+		data := make([][]string, 0, 0)
+		for _, entry := range response.GetHistory() {
+			data = append(data, []string{
+				formatTimestamp(entry.GetTimestamp()),
+				entry.GetEvent(),
+				entry.GetRolePath(),
+				entry.GetTaskId(),
+				entry.GetMessage()})
+		}
+
+		table.AppendBulk(data)
+		table.Render()
+	}
 	return
 }
 
@@ -257,6 +305,7 @@ func ControlEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.
 	var response *pb.ControlEnvironmentReply
 	response, err = rpc.ControlEnvironment(cxt, &pb.ControlEnvironmentRequest{Id: args[0], Type: pb.ControlEnvironmentRequest_Optype(pb.ControlEnvironmentRequest_Optype_value[event])}, grpc.EmptyCallOption{})
 	if err != nil {
+		printDeploymentFailure(err, o)
 		return
 	}
 
@@ -277,35 +326,44 @@ func ModifyEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 	}
 	envId := args[0]
 
-	addRoles, err := cmd.Flags().GetStringArray("addroles")
+	iteratorPath, err := cmd.Flags().GetString("iterator")
 	if err != nil {
-		fmt.Fprintln(o, "error: addroles")
+		fmt.Fprintln(o, "error: iterator")
 		return
 	}
 
-	removeRoles, err := cmd.Flags().GetStringArray("removeroles")
+	values, err := cmd.Flags().GetStringSlice("values")
 	if err != nil {
-		fmt.Fprintln(o, "error: removeroles")
+		fmt.Fprintln(o, "error: values")
 		return
 	}
 
-	reconfigure, err := cmd.Flags().GetBool("reconfigure")
+	begin, err := cmd.Flags().GetInt32("begin")
 	if err != nil {
-		fmt.Fprintln(o, "error: reconfigure")
+		fmt.Fprintln(o, "error: begin")
 		return
 	}
 
-	ops := make([]*pb.EnvironmentOperation, 0)
-	for _, it := range addRoles {
-		ops = append(ops, &pb.EnvironmentOperation{
-			Type: pb.EnvironmentOperation_ADD_ROLE,
-			RoleName: it,
-		})
+	end, err := cmd.Flags().GetInt32("end")
+	if err != nil {
+		fmt.Fprintln(o, "error: end")
+		return
 	}
-	for _, it := range removeRoles {
+
+	ops := make([]*pb.EnvironmentOperation, 0)
+	if len(iteratorPath) != 0 {
+		if len(values) == 0 && !(cmd.Flags().Changed("begin") && cmd.Flags().Changed("end")) {
+			err = errors.New("an iterator modification requires either --values, or both --begin and --end")
+			return
+		}
 		ops = append(ops, &pb.EnvironmentOperation{
-			Type: pb.EnvironmentOperation_REMOVE_ROLE,
-			RoleName: it,
+			Type: pb.EnvironmentOperation_SET_ITERATOR,
+			RoleName: iteratorPath,
+			IteratorRange: &pb.IteratorRange{
+				Begin: begin,
+				End: end,
+				Values: values,
+			},
 		})
 	}
 
@@ -333,7 +391,6 @@ func ModifyEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 	response, err = rpc.ModifyEnvironment(cxt, &pb.ModifyEnvironmentRequest{
 		Id: envId,
 		Operations: ops,
-		ReconfigureAll: reconfigure,
 	}, grpc.EmptyCallOption{})
 	if err != nil {
 		return
@@ -455,6 +512,27 @@ func CleanTasks(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command,
 }
 
 
+func ShowTaskClass(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	var response *pb.GetTaskClassReply
+	response, err = rpc.GetTaskClass(cxt, &pb.GetTaskClassRequest{TaskClass: args[0]}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(o, "task class:         %s\n", response.GetIdentifier())
+	for _, ancestor := range response.GetExtends() {
+		_, _ = fmt.Fprintf(o, "extends:            %s\n", ancestor)
+	}
+	_, _ = fmt.Fprintf(o, "\n%s", response.GetResolvedDefinition())
+	return
+}
+
+
 func QueryRoles(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) != 2 {
 		err = errors.New(fmt.Sprintf("accepts 2 arg(s), received %d", len(args)))
@@ -488,6 +566,125 @@ func QueryRoles(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command,
 	return nil
 }
 
+func ListHosts(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	var response *pb.GetHostsReply
+	response, err = rpc.GetHosts(cxt, &pb.GetHostsRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	hosts := response.GetHosts()
+	if len(hosts) == 0 {
+		fmt.Fprintln(o, "no hosts known")
+		return
+	}
+
+	table := tablewriter.NewWriter(o)
+	table.SetHeader([]string{fmt.Sprintf("hostname (%d hosts)", len(hosts)), "agent id", "state", "cpus", "mem", "tasks", "environments", "last offered"})
+	table.SetBorder(false)
+	fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+	table.SetHeaderColor(fg, fg, fg, fg, fg, fg, fg, fg)
+
+	data := make([][]string, 0, 0)
+	for _, host := range hosts {
+		data = append(data, []string{
+			host.GetHostname(),
+			host.GetAgentId(),
+			colorHostState(host.GetState()),
+			host.GetResources()["cpus"],
+			host.GetResources()["mem"],
+			strconv.Itoa(len(host.GetTasks())),
+			strconv.Itoa(len(host.GetEnvironmentIds())),
+			formatLastOffered(host.GetLastOffered())})
+	}
+
+	table.AppendBulk(data)
+	table.Render()
+	return
+}
+
+func ShowHost(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	var response *pb.GetHostReply
+	response, err = rpc.GetHost(cxt, &pb.GetHostRequest{Host: args[0]}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	host := response.GetHost()
+	_, _ = fmt.Fprintf(o, "hostname:           %s\n", host.GetHostname())
+	_, _ = fmt.Fprintf(o, "agent id:           %s\n", host.GetAgentId())
+	_, _ = fmt.Fprintf(o, "state:              %s\n", colorHostState(host.GetState()))
+	_, _ = fmt.Fprintf(o, "last offered:       %s\n", formatLastOffered(host.GetLastOffered()))
+	_, _ = fmt.Fprintf(o, "environments:       %s\n", strings.Join(host.GetEnvironmentIds(), ", "))
+
+	_, _ = fmt.Fprintf(o, "\nattributes:\n")
+	printSortedMap(host.GetAttributes(), o)
+	_, _ = fmt.Fprintf(o, "\nresources offered:\n")
+	printSortedMap(host.GetResources(), o)
+
+	tasks := host.GetTasks()
+	fmt.Fprintln(o, "")
+	if len(tasks) == 0 {
+		fmt.Fprintln(o, "no tasks running on this host")
+		return
+	}
+	drawTableShortTaskInfos(tasks,
+		[]string{fmt.Sprintf("task id (%d tasks)", len(tasks)), "class name", "locked", "status", "state"},
+		func(t *pb.ShortTaskInfo) []string {
+			return []string{
+				t.GetTaskId(),
+				t.GetClassName(),
+				strconv.FormatBool(t.GetLocked()),
+				t.GetStatus(),
+				colorState(t.GetState())}
+		}, o)
+	return
+}
+
+// DrainHost marks a host as draining, or as under maintenance if requested,
+// so that no new tasks are deployed on it.
+func DrainHost(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	maintenance, err := cmd.Flags().GetBool("maintenance")
+	if err != nil {
+		return
+	}
+	state := "DRAINING"
+	if maintenance {
+		state = "MAINTENANCE"
+	}
+	return setHostState(cxt, rpc, args, state, o)
+}
+
+// EnableHost returns a drained host or a host under maintenance to service.
+func EnableHost(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	return setHostState(cxt, rpc, args, "ACTIVE", o)
+}
+
+func setHostState(cxt context.Context, rpc *coconut.RpcClient, args []string, state string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	var response *pb.SetHostStateReply
+	response, err = rpc.SetHostState(cxt, &pb.SetHostStateRequest{Host: args[0], State: state}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	host := response.GetHost()
+	_, _ = fmt.Fprintf(o, "host %s is now %s\n", host.GetHostname(), colorHostState(host.GetState()))
+	if envIds := host.GetEnvironmentIds(); state != "ACTIVE" && len(envIds) > 0 {
+		_, _ = fmt.Fprintf(o, "environments with tasks on this host: %s\n", strings.Join(envIds, ", "))
+	}
+	return
+}
+
 // ListWorkflowTemplates lists the available workflow templates and the git repo on which they reside.
 func ListWorkflowTemplates(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) != 0 {
@@ -516,7 +713,15 @@ func ListWorkflowTemplates(cxt context.Context, rpc *coconut.RpcClient, cmd *cob
 				aTree.SetValue(blue(tmpl.GetRepo()))
 				prevRepo = tmpl.GetRepo()
 			}
-			aTree.AddNode(tmpl.GetTemplate())
+			params := tmpl.GetParameters()
+			if len(params) == 0 {
+				aTree.AddNode(tmpl.GetTemplate())
+				continue
+			}
+			tmplBranch := aTree.AddBranch(tmpl.GetTemplate())
+			for _, param := range params {
+				tmplBranch.AddNode(formatWorkflowParameter(param))
+			}
 		}
 
 		fmt.Fprintln(o, aTree.String())
@@ -27,6 +27,7 @@ package control
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/olekukonko/tablewriter"
 	"github.com/xlab/treeprint"
+	"google.golang.org/grpc/status"
 )
 
 var(
@@ -143,6 +145,37 @@ func drawTableShortTaskInfos(tasks []*pb.ShortTaskInfo, headers []string, linePr
 	table.Render()
 }
 
+func colorHostState(state string) string {
+	switch state {
+	case "ACTIVE":
+		return green(state)
+	case "DRAINING":
+		return yellow(state)
+	default:
+		return red(state)
+	}
+}
+
+// formatLastOffered formats an offer timestamp, or returns "never" if the host was never offered.
+func formatLastOffered(rfc3339timestamp string) string {
+	if len(rfc3339timestamp) == 0 {
+		return "never"
+	}
+	return formatTimestamp(rfc3339timestamp)
+}
+
+// printSortedMap prints one indented key: value line per map entry, sorted by key.
+func printSortedMap(m map[string]string, o io.Writer) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(o, "    %s: %s\n", k, m[k])
+	}
+}
+
 func formatTimestamp(rfc3339timestamp string) string {
 	timestamp, err := time.Parse(time.RFC3339, rfc3339timestamp)
 	var formatted string
@@ -158,3 +191,49 @@ func isValidUUID(u string) bool {
 	_, err := uuid.Parse(u)
 	return err == nil
 }
+
+func formatWorkflowParameter(param *pb.WorkflowParameterInfo) string {
+	defaultValue := red("required")
+	if !param.GetRequired() {
+		defaultValue = fmt.Sprintf("default: %s", param.GetDefaultValue())
+	}
+	str := fmt.Sprintf("%s %s (%s)", yellow(param.GetName()), grey(param.GetType()), defaultValue)
+	if len(param.GetAllowedValues()) > 0 {
+		str += fmt.Sprintf(" %v", param.GetAllowedValues())
+	}
+	if len(param.GetDescription()) > 0 {
+		str += " - " + param.GetDescription()
+	}
+	return str
+}
+
+// printDeploymentFailure writes out the explanation of a failed task deployment,
+// if err carries one, i.e. why each role could not be deployed on each host.
+func printDeploymentFailure(err error, o io.Writer) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return
+	}
+	for _, detail := range st.Details() {
+		failure, ok := detail.(*pb.DeploymentFailure)
+		if !ok {
+			continue
+		}
+		_, _ = fmt.Fprintf(o, "%s could not be deployed (offers received: %d)\n",
+			red(fmt.Sprintf("%d roles", len(failure.GetDescriptors()))), failure.GetOffersReceived())
+		for _, descriptor := range failure.GetDescriptors() {
+			_, _ = fmt.Fprintf(o, "%s %s\n", yellow(descriptor.GetRolePath()), grey(descriptor.GetTaskClass()))
+			if len(descriptor.GetRejections()) == 0 {
+				if failure.GetOffersReceived() == 0 {
+					_, _ = fmt.Fprintln(o, "    no offers received")
+				} else {
+					_, _ = fmt.Fprintln(o, "    no offer considered")
+				}
+				continue
+			}
+			for _, rejection := range descriptor.GetRejections() {
+				_, _ = fmt.Fprintf(o, "    %s: %s\n", rejection.GetHostname(), rejection.GetReason())
+			}
+		}
+	}
+}
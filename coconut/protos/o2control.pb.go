@@ -88,27 +88,30 @@ func (x ControlEnvironmentRequest_Optype) String() string {
 }
 
 func (ControlEnvironmentRequest_Optype) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{16, 0}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{17, 0}
 }
 
 type EnvironmentOperation_Optype int32
 
 const (
-	EnvironmentOperation_NOOP        EnvironmentOperation_Optype = 0
-	EnvironmentOperation_REMOVE_ROLE EnvironmentOperation_Optype = 3
-	EnvironmentOperation_ADD_ROLE    EnvironmentOperation_Optype = 4
+	EnvironmentOperation_NOOP         EnvironmentOperation_Optype = 0
+	EnvironmentOperation_REMOVE_ROLE  EnvironmentOperation_Optype = 3
+	EnvironmentOperation_ADD_ROLE     EnvironmentOperation_Optype = 4
+	EnvironmentOperation_SET_ITERATOR EnvironmentOperation_Optype = 5
 )
 
 var EnvironmentOperation_Optype_name = map[int32]string{
 	0: "NOOP",
 	3: "REMOVE_ROLE",
 	4: "ADD_ROLE",
+	5: "SET_ITERATOR",
 }
 
 var EnvironmentOperation_Optype_value = map[string]int32{
-	"NOOP":        0,
-	"REMOVE_ROLE": 3,
-	"ADD_ROLE":    4,
+	"NOOP":         0,
+	"REMOVE_ROLE":  3,
+	"ADD_ROLE":     4,
+	"SET_ITERATOR": 5,
 }
 
 func (x EnvironmentOperation_Optype) String() string {
@@ -116,7 +119,7 @@ func (x EnvironmentOperation_Optype) String() string {
 }
 
 func (EnvironmentOperation_Optype) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{19, 0}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{20, 0}
 }
 
 type Event_MesosHeartbeat struct {
@@ -158,9 +161,9 @@ func (m *Event_MesosHeartbeat) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Event_MesosHeartbeat proto.InternalMessageInfo
 
-////////////////////////////////////////
+// //////////////////////////////////////
 // Global status
-////////////////////////////////////////
+// //////////////////////////////////////
 type StatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -386,9 +389,9 @@ func _StatusUpdate_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-////////////////////////////////////////
+// //////////////////////////////////////
 // Framework
-////////////////////////////////////////
+// //////////////////////////////////////
 type GetFrameworkInfoRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -697,9 +700,9 @@ func (m *TeardownReply) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TeardownReply proto.InternalMessageInfo
 
-////////////////////////////////////////
+// //////////////////////////////////////
 // Environment
-////////////////////////////////////////
+// //////////////////////////////////////
 type GetEnvironmentsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -801,6 +804,7 @@ type EnvironmentInfo struct {
 	Tasks                []*ShortTaskInfo `protobuf:"bytes,4,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	RootRole             string           `protobuf:"bytes,5,opt,name=rootRole,proto3" json:"rootRole,omitempty"`
 	CurrentRunNumber     uint32           `protobuf:"varint,6,opt,name=currentRunNumber,proto3" json:"currentRunNumber,omitempty"`
+	UnavailableHosts     []string         `protobuf:"bytes,7,rep,name=unavailableHosts,proto3" json:"unavailableHosts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -881,11 +885,19 @@ func (m *EnvironmentInfo) GetCurrentRunNumber() uint32 {
 	return 0
 }
 
+func (m *EnvironmentInfo) GetUnavailableHosts() []string {
+	if m != nil {
+		return m.UnavailableHosts
+	}
+	return nil
+}
+
 type NewEnvironmentRequest struct {
-	WorkflowTemplate     string   `protobuf:"bytes,1,opt,name=workflowTemplate,proto3" json:"workflowTemplate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	WorkflowTemplate     string            `protobuf:"bytes,1,opt,name=workflowTemplate,proto3" json:"workflowTemplate,omitempty"`
+	Vars                 map[string]string `protobuf:"bytes,2,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *NewEnvironmentRequest) Reset()         { *m = NewEnvironmentRequest{} }
@@ -928,6 +940,13 @@ func (m *NewEnvironmentRequest) GetWorkflowTemplate() string {
 	return ""
 }
 
+func (m *NewEnvironmentRequest) GetVars() map[string]string {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
 type NewEnvironmentReply struct {
 	Environment          *EnvironmentInfo `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -1023,11 +1042,12 @@ func (m *GetEnvironmentRequest) GetId() string {
 }
 
 type GetEnvironmentReply struct {
-	Environment          *EnvironmentInfo `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
-	Workflow             *RoleInfo        `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Environment          *EnvironmentInfo    `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	Workflow             *RoleInfo           `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	History              []*EnvironmentEvent `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *GetEnvironmentReply) Reset()         { *m = GetEnvironmentReply{} }
@@ -1077,6 +1097,92 @@ func (m *GetEnvironmentReply) GetWorkflow() *RoleInfo {
 	return nil
 }
 
+func (m *GetEnvironmentReply) GetHistory() []*EnvironmentEvent {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+type EnvironmentEvent struct {
+	Timestamp            string   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event                string   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	RolePath             string   `protobuf:"bytes,3,opt,name=rolePath,proto3" json:"rolePath,omitempty"`
+	TaskId               string   `protobuf:"bytes,4,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnvironmentEvent) Reset()         { *m = EnvironmentEvent{} }
+func (m *EnvironmentEvent) String() string { return proto.CompactTextString(m) }
+func (*EnvironmentEvent) ProtoMessage()    {}
+func (*EnvironmentEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{16}
+}
+func (m *EnvironmentEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnvironmentEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnvironmentEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnvironmentEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnvironmentEvent.Merge(m, src)
+}
+func (m *EnvironmentEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnvironmentEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnvironmentEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnvironmentEvent proto.InternalMessageInfo
+
+func (m *EnvironmentEvent) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *EnvironmentEvent) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *EnvironmentEvent) GetRolePath() string {
+	if m != nil {
+		return m.RolePath
+	}
+	return ""
+}
+
+func (m *EnvironmentEvent) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *EnvironmentEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type ControlEnvironmentRequest struct {
 	Id                   string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type                 ControlEnvironmentRequest_Optype `protobuf:"varint,2,opt,name=type,proto3,enum=o2control.ControlEnvironmentRequest_Optype" json:"type,omitempty"`
@@ -1089,7 +1195,7 @@ func (m *ControlEnvironmentRequest) Reset()         { *m = ControlEnvironmentReq
 func (m *ControlEnvironmentRequest) String() string { return proto.CompactTextString(m) }
 func (*ControlEnvironmentRequest) ProtoMessage()    {}
 func (*ControlEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{16}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{17}
 }
 func (m *ControlEnvironmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1145,7 +1251,7 @@ func (m *ControlEnvironmentReply) Reset()         { *m = ControlEnvironmentReply
 func (m *ControlEnvironmentReply) String() string { return proto.CompactTextString(m) }
 func (*ControlEnvironmentReply) ProtoMessage()    {}
 func (*ControlEnvironmentReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{17}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{18}
 }
 func (m *ControlEnvironmentReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1208,7 +1314,7 @@ func (m *ModifyEnvironmentRequest) Reset()         { *m = ModifyEnvironmentReque
 func (m *ModifyEnvironmentRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyEnvironmentRequest) ProtoMessage()    {}
 func (*ModifyEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{18}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{19}
 }
 func (m *ModifyEnvironmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1259,18 +1365,20 @@ func (m *ModifyEnvironmentRequest) GetReconfigureAll() bool {
 }
 
 type EnvironmentOperation struct {
-	Type                 EnvironmentOperation_Optype `protobuf:"varint,1,opt,name=type,proto3,enum=o2control.EnvironmentOperation_Optype" json:"type,omitempty"`
-	RoleName             string                      `protobuf:"bytes,2,opt,name=roleName,proto3" json:"roleName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Type     EnvironmentOperation_Optype `protobuf:"varint,1,opt,name=type,proto3,enum=o2control.EnvironmentOperation_Optype" json:"type,omitempty"`
+	RoleName string                      `protobuf:"bytes,2,opt,name=roleName,proto3" json:"roleName,omitempty"`
+	// only for SET_ITERATOR, roleName is the path of the iterator or of one of its children
+	IteratorRange        *IteratorRange `protobuf:"bytes,3,opt,name=iteratorRange,proto3" json:"iteratorRange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *EnvironmentOperation) Reset()         { *m = EnvironmentOperation{} }
 func (m *EnvironmentOperation) String() string { return proto.CompactTextString(m) }
 func (*EnvironmentOperation) ProtoMessage()    {}
 func (*EnvironmentOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{19}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{20}
 }
 func (m *EnvironmentOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1313,6 +1421,77 @@ func (m *EnvironmentOperation) GetRoleName() string {
 	return ""
 }
 
+func (m *EnvironmentOperation) GetIteratorRange() *IteratorRange {
+	if m != nil {
+		return m.IteratorRange
+	}
+	return nil
+}
+
+type IteratorRange struct {
+	Begin int32 `protobuf:"varint,1,opt,name=begin,proto3" json:"begin,omitempty"`
+	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// if not empty, the iterator goes through these values instead of begin..end
+	Values               []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IteratorRange) Reset()         { *m = IteratorRange{} }
+func (m *IteratorRange) String() string { return proto.CompactTextString(m) }
+func (*IteratorRange) ProtoMessage()    {}
+func (*IteratorRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{21}
+}
+func (m *IteratorRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IteratorRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IteratorRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IteratorRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IteratorRange.Merge(m, src)
+}
+func (m *IteratorRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *IteratorRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_IteratorRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IteratorRange proto.InternalMessageInfo
+
+func (m *IteratorRange) GetBegin() int32 {
+	if m != nil {
+		return m.Begin
+	}
+	return 0
+}
+
+func (m *IteratorRange) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *IteratorRange) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 type ModifyEnvironmentReply struct {
 	FailedOperations     []*EnvironmentOperation `protobuf:"bytes,1,rep,name=failedOperations,proto3" json:"failedOperations,omitempty"`
 	Id                   string                  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -1326,7 +1505,7 @@ func (m *ModifyEnvironmentReply) Reset()         { *m = ModifyEnvironmentReply{}
 func (m *ModifyEnvironmentReply) String() string { return proto.CompactTextString(m) }
 func (*ModifyEnvironmentReply) ProtoMessage()    {}
 func (*ModifyEnvironmentReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{20}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{22}
 }
 func (m *ModifyEnvironmentReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1567,7 @@ func (m *DestroyEnvironmentRequest) Reset()         { *m = DestroyEnvironmentReq
 func (m *DestroyEnvironmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DestroyEnvironmentRequest) ProtoMessage()    {}
 func (*DestroyEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{21}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{23}
 }
 func (m *DestroyEnvironmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1442,7 +1621,7 @@ func (m *DestroyEnvironmentReply) Reset()         { *m = DestroyEnvironmentReply
 func (m *DestroyEnvironmentReply) String() string { return proto.CompactTextString(m) }
 func (*DestroyEnvironmentReply) ProtoMessage()    {}
 func (*DestroyEnvironmentReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{22}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{24}
 }
 func (m *DestroyEnvironmentReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1478,9 +1657,192 @@ func (m *DestroyEnvironmentReply) GetCleanupTasksReply() *CleanupTasksReply {
 	return nil
 }
 
-////////////////////////////////////////
+// Attached as error details by NewEnvironment and ControlEnvironment when
+// some tasks could not be deployed, to explain why
+type DeploymentFailure struct {
+	Descriptors          []*DescriptorExplanation `protobuf:"bytes,1,rep,name=descriptors,proto3" json:"descriptors,omitempty"`
+	OffersReceived       int32                    `protobuf:"varint,2,opt,name=offersReceived,proto3" json:"offersReceived,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *DeploymentFailure) Reset()         { *m = DeploymentFailure{} }
+func (m *DeploymentFailure) String() string { return proto.CompactTextString(m) }
+func (*DeploymentFailure) ProtoMessage()    {}
+func (*DeploymentFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{25}
+}
+func (m *DeploymentFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeploymentFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeploymentFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentFailure.Merge(m, src)
+}
+func (m *DeploymentFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentFailure proto.InternalMessageInfo
+
+func (m *DeploymentFailure) GetDescriptors() []*DescriptorExplanation {
+	if m != nil {
+		return m.Descriptors
+	}
+	return nil
+}
+
+func (m *DeploymentFailure) GetOffersReceived() int32 {
+	if m != nil {
+		return m.OffersReceived
+	}
+	return 0
+}
+
+type DescriptorExplanation struct {
+	RolePath             string            `protobuf:"bytes,1,opt,name=rolePath,proto3" json:"rolePath,omitempty"`
+	TaskClass            string            `protobuf:"bytes,2,opt,name=taskClass,proto3" json:"taskClass,omitempty"`
+	Rejections           []*OfferRejection `protobuf:"bytes,3,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DescriptorExplanation) Reset()         { *m = DescriptorExplanation{} }
+func (m *DescriptorExplanation) String() string { return proto.CompactTextString(m) }
+func (*DescriptorExplanation) ProtoMessage()    {}
+func (*DescriptorExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{26}
+}
+func (m *DescriptorExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescriptorExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescriptorExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescriptorExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescriptorExplanation.Merge(m, src)
+}
+func (m *DescriptorExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescriptorExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescriptorExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescriptorExplanation proto.InternalMessageInfo
+
+func (m *DescriptorExplanation) GetRolePath() string {
+	if m != nil {
+		return m.RolePath
+	}
+	return ""
+}
+
+func (m *DescriptorExplanation) GetTaskClass() string {
+	if m != nil {
+		return m.TaskClass
+	}
+	return ""
+}
+
+func (m *DescriptorExplanation) GetRejections() []*OfferRejection {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
+}
+
+type OfferRejection struct {
+	OfferId              string   `protobuf:"bytes,1,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	Hostname             string   `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OfferRejection) Reset()         { *m = OfferRejection{} }
+func (m *OfferRejection) String() string { return proto.CompactTextString(m) }
+func (*OfferRejection) ProtoMessage()    {}
+func (*OfferRejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{27}
+}
+func (m *OfferRejection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OfferRejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OfferRejection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OfferRejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OfferRejection.Merge(m, src)
+}
+func (m *OfferRejection) XXX_Size() int {
+	return m.Size()
+}
+func (m *OfferRejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_OfferRejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OfferRejection proto.InternalMessageInfo
+
+func (m *OfferRejection) GetOfferId() string {
+	if m != nil {
+		return m.OfferId
+	}
+	return ""
+}
+
+func (m *OfferRejection) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *OfferRejection) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// //////////////////////////////////////
 // Tasks
-////////////////////////////////////////
+// //////////////////////////////////////
 type ShortTaskInfo struct {
 	Name                 string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Locked               bool                `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
@@ -1498,7 +1860,7 @@ func (m *ShortTaskInfo) Reset()         { *m = ShortTaskInfo{} }
 func (m *ShortTaskInfo) String() string { return proto.CompactTextString(m) }
 func (*ShortTaskInfo) ProtoMessage()    {}
 func (*ShortTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{23}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{28}
 }
 func (m *ShortTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1590,7 +1952,7 @@ func (m *TaskDeploymentInfo) Reset()         { *m = TaskDeploymentInfo{} }
 func (m *TaskDeploymentInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskDeploymentInfo) ProtoMessage()    {}
 func (*TaskDeploymentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{24}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{29}
 }
 func (m *TaskDeploymentInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1657,7 +2019,7 @@ func (m *GetTasksRequest) Reset()         { *m = GetTasksRequest{} }
 func (m *GetTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTasksRequest) ProtoMessage()    {}
 func (*GetTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{25}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{30}
 }
 func (m *GetTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1697,7 +2059,7 @@ func (m *GetTasksReply) Reset()         { *m = GetTasksReply{} }
 func (m *GetTasksReply) String() string { return proto.CompactTextString(m) }
 func (*GetTasksReply) ProtoMessage()    {}
 func (*GetTasksReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{26}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{31}
 }
 func (m *GetTasksReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1744,7 +2106,7 @@ func (m *GetTaskRequest) Reset()         { *m = GetTaskRequest{} }
 func (m *GetTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTaskRequest) ProtoMessage()    {}
 func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{27}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{32}
 }
 func (m *GetTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1791,7 +2153,7 @@ func (m *GetTaskReply) Reset()         { *m = GetTaskReply{} }
 func (m *GetTaskReply) String() string { return proto.CompactTextString(m) }
 func (*GetTaskReply) ProtoMessage()    {}
 func (*GetTaskReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{28}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{33}
 }
 func (m *GetTaskReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1827,19 +2189,130 @@ func (m *GetTaskReply) GetTask() *TaskInfo {
 	return nil
 }
 
-type TaskClassInfo struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ControlMode          string   `protobuf:"bytes,2,opt,name=controlMode,proto3" json:"controlMode,omitempty"`
+type GetTaskClassRequest struct {
+	TaskClass            string   `protobuf:"bytes,1,opt,name=taskClass,proto3" json:"taskClass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTaskClassRequest) Reset()         { *m = GetTaskClassRequest{} }
+func (m *GetTaskClassRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTaskClassRequest) ProtoMessage()    {}
+func (*GetTaskClassRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{34}
+}
+func (m *GetTaskClassRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskClassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskClassRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTaskClassRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskClassRequest.Merge(m, src)
+}
+func (m *GetTaskClassRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskClassRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskClassRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskClassRequest proto.InternalMessageInfo
+
+func (m *GetTaskClassRequest) GetTaskClass() string {
+	if m != nil {
+		return m.TaskClass
+	}
+	return ""
+}
+
+type GetTaskClassReply struct {
+	Identifier           string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Extends              []string `protobuf:"bytes,2,rep,name=extends,proto3" json:"extends,omitempty"`
+	ResolvedDefinition   string   `protobuf:"bytes,3,opt,name=resolvedDefinition,proto3" json:"resolvedDefinition,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *GetTaskClassReply) Reset()         { *m = GetTaskClassReply{} }
+func (m *GetTaskClassReply) String() string { return proto.CompactTextString(m) }
+func (*GetTaskClassReply) ProtoMessage()    {}
+func (*GetTaskClassReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{35}
+}
+func (m *GetTaskClassReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskClassReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskClassReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTaskClassReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskClassReply.Merge(m, src)
+}
+func (m *GetTaskClassReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskClassReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskClassReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskClassReply proto.InternalMessageInfo
+
+func (m *GetTaskClassReply) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *GetTaskClassReply) GetExtends() []string {
+	if m != nil {
+		return m.Extends
+	}
+	return nil
+}
+
+func (m *GetTaskClassReply) GetResolvedDefinition() string {
+	if m != nil {
+		return m.ResolvedDefinition
+	}
+	return ""
+}
+
+type TaskClassInfo struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ControlMode          string                `protobuf:"bytes,2,opt,name=controlMode,proto3" json:"controlMode,omitempty"`
+	CustomResources      []*CustomResourceInfo `protobuf:"bytes,3,rep,name=customResources,proto3" json:"customResources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
 func (m *TaskClassInfo) Reset()         { *m = TaskClassInfo{} }
 func (m *TaskClassInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskClassInfo) ProtoMessage()    {}
 func (*TaskClassInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{29}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{36}
 }
 func (m *TaskClassInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1882,6 +2355,76 @@ func (m *TaskClassInfo) GetControlMode() string {
 	return ""
 }
 
+func (m *TaskClassInfo) GetCustomResources() []*CustomResourceInfo {
+	if m != nil {
+		return m.CustomResources
+	}
+	return nil
+}
+
+type CustomResourceInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CustomResourceInfo) Reset()         { *m = CustomResourceInfo{} }
+func (m *CustomResourceInfo) String() string { return proto.CompactTextString(m) }
+func (*CustomResourceInfo) ProtoMessage()    {}
+func (*CustomResourceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{37}
+}
+func (m *CustomResourceInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomResourceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomResourceInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CustomResourceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceInfo.Merge(m, src)
+}
+func (m *CustomResourceInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomResourceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceInfo proto.InternalMessageInfo
+
+func (m *CustomResourceInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CustomResourceInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CustomResourceInfo) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type CommandInfo struct {
 	Env                  []string `protobuf:"bytes,1,rep,name=env,proto3" json:"env,omitempty"`
 	Shell                bool     `protobuf:"varint,2,opt,name=shell,proto3" json:"shell,omitempty"`
@@ -1897,7 +2440,7 @@ func (m *CommandInfo) Reset()         { *m = CommandInfo{} }
 func (m *CommandInfo) String() string { return proto.CompactTextString(m) }
 func (*CommandInfo) ProtoMessage()    {}
 func (*CommandInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{30}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{38}
 }
 func (m *CommandInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1974,7 +2517,7 @@ func (m *ChannelInfo) Reset()         { *m = ChannelInfo{} }
 func (m *ChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelInfo) ProtoMessage()    {}
 func (*ChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{31}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{39}
 }
 func (m *ChannelInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2025,23 +2568,25 @@ func (m *ChannelInfo) GetTarget() string {
 }
 
 type TaskInfo struct {
-	ShortInfo            *ShortTaskInfo `protobuf:"bytes,1,opt,name=shortInfo,proto3" json:"shortInfo,omitempty"`
-	ClassInfo            *TaskClassInfo `protobuf:"bytes,2,opt,name=classInfo,proto3" json:"classInfo,omitempty"`
-	InboundChannels      []*ChannelInfo `protobuf:"bytes,3,rep,name=inboundChannels,proto3" json:"inboundChannels,omitempty"`
-	OutboundChannels     []*ChannelInfo `protobuf:"bytes,4,rep,name=outboundChannels,proto3" json:"outboundChannels,omitempty"`
-	CommandInfo          *CommandInfo   `protobuf:"bytes,5,opt,name=commandInfo,proto3" json:"commandInfo,omitempty"`
-	TaskPath             string         `protobuf:"bytes,6,opt,name=taskPath,proto3" json:"taskPath,omitempty"`
-	EnvId                string         `protobuf:"bytes,7,opt,name=envId,proto3" json:"envId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	ShortInfo        *ShortTaskInfo `protobuf:"bytes,1,opt,name=shortInfo,proto3" json:"shortInfo,omitempty"`
+	ClassInfo        *TaskClassInfo `protobuf:"bytes,2,opt,name=classInfo,proto3" json:"classInfo,omitempty"`
+	InboundChannels  []*ChannelInfo `protobuf:"bytes,3,rep,name=inboundChannels,proto3" json:"inboundChannels,omitempty"`
+	OutboundChannels []*ChannelInfo `protobuf:"bytes,4,rep,name=outboundChannels,proto3" json:"outboundChannels,omitempty"`
+	CommandInfo      *CommandInfo   `protobuf:"bytes,5,opt,name=commandInfo,proto3" json:"commandInfo,omitempty"`
+	TaskPath         string         `protobuf:"bytes,6,opt,name=taskPath,proto3" json:"taskPath,omitempty"`
+	EnvId            string         `protobuf:"bytes,7,opt,name=envId,proto3" json:"envId,omitempty"`
+	// the custom resources wanted by the task class, as overridden by the role
+	CustomResources      []*CustomResourceInfo `protobuf:"bytes,8,rep,name=customResources,proto3" json:"customResources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *TaskInfo) Reset()         { *m = TaskInfo{} }
 func (m *TaskInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskInfo) ProtoMessage()    {}
 func (*TaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{32}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{40}
 }
 func (m *TaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2119,6 +2664,13 @@ func (m *TaskInfo) GetEnvId() string {
 	return ""
 }
 
+func (m *TaskInfo) GetCustomResources() []*CustomResourceInfo {
+	if m != nil {
+		return m.CustomResources
+	}
+	return nil
+}
+
 type CleanupTasksRequest struct {
 	TaskIds              []string `protobuf:"bytes,1,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2130,7 +2682,7 @@ func (m *CleanupTasksRequest) Reset()         { *m = CleanupTasksRequest{} }
 func (m *CleanupTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*CleanupTasksRequest) ProtoMessage()    {}
 func (*CleanupTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{33}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{41}
 }
 func (m *CleanupTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2178,7 +2730,7 @@ func (m *CleanupTasksReply) Reset()         { *m = CleanupTasksReply{} }
 func (m *CleanupTasksReply) String() string { return proto.CompactTextString(m) }
 func (*CleanupTasksReply) ProtoMessage()    {}
 func (*CleanupTasksReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{34}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{42}
 }
 func (m *CleanupTasksReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2221,9 +2773,9 @@ func (m *CleanupTasksReply) GetRunningTasks() []*ShortTaskInfo {
 	return nil
 }
 
-////////////////////////////////////////
+// //////////////////////////////////////
 // Roles
-////////////////////////////////////////
+// //////////////////////////////////////
 type GetRolesRequest struct {
 	EnvId                string   `protobuf:"bytes,1,opt,name=envId,proto3" json:"envId,omitempty"`
 	PathSpec             string   `protobuf:"bytes,2,opt,name=pathSpec,proto3" json:"pathSpec,omitempty"`
@@ -2236,7 +2788,7 @@ func (m *GetRolesRequest) Reset()         { *m = GetRolesRequest{} }
 func (m *GetRolesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRolesRequest) ProtoMessage()    {}
 func (*GetRolesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{35}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{43}
 }
 func (m *GetRolesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2295,7 +2847,7 @@ func (m *RoleInfo) Reset()         { *m = RoleInfo{} }
 func (m *RoleInfo) String() string { return proto.CompactTextString(m) }
 func (*RoleInfo) ProtoMessage()    {}
 func (*RoleInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{36}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{44}
 }
 func (m *RoleInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2377,7 +2929,7 @@ func (m *GetRolesReply) Reset()         { *m = GetRolesReply{} }
 func (m *GetRolesReply) String() string { return proto.CompactTextString(m) }
 func (*GetRolesReply) ProtoMessage()    {}
 func (*GetRolesReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{37}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{45}
 }
 func (m *GetRolesReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2413,24 +2965,27 @@ func (m *GetRolesReply) GetRoles() []*RoleInfo {
 	return nil
 }
 
-type GetWorkflowTemplatesRequest struct {
+// //////////////////////////////////////
+// Hosts
+// //////////////////////////////////////
+type GetHostsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetWorkflowTemplatesRequest) Reset()         { *m = GetWorkflowTemplatesRequest{} }
-func (m *GetWorkflowTemplatesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetWorkflowTemplatesRequest) ProtoMessage()    {}
-func (*GetWorkflowTemplatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{38}
+func (m *GetHostsRequest) Reset()         { *m = GetHostsRequest{} }
+func (m *GetHostsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHostsRequest) ProtoMessage()    {}
+func (*GetHostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{46}
 }
-func (m *GetWorkflowTemplatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetHostsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetWorkflowTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetHostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetWorkflowTemplatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetHostsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2440,38 +2995,37 @@ func (m *GetWorkflowTemplatesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetWorkflowTemplatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWorkflowTemplatesRequest.Merge(m, src)
+func (m *GetHostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHostsRequest.Merge(m, src)
 }
-func (m *GetWorkflowTemplatesRequest) XXX_Size() int {
+func (m *GetHostsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetWorkflowTemplatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWorkflowTemplatesRequest.DiscardUnknown(m)
+func (m *GetHostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHostsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWorkflowTemplatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetHostsRequest proto.InternalMessageInfo
 
-type WorkflowTemplateInfo struct {
-	Repo                 string   `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Template             string   `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetHostsReply struct {
+	Hosts                []*HostInfo `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *WorkflowTemplateInfo) Reset()         { *m = WorkflowTemplateInfo{} }
-func (m *WorkflowTemplateInfo) String() string { return proto.CompactTextString(m) }
-func (*WorkflowTemplateInfo) ProtoMessage()    {}
-func (*WorkflowTemplateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{39}
+func (m *GetHostsReply) Reset()         { *m = GetHostsReply{} }
+func (m *GetHostsReply) String() string { return proto.CompactTextString(m) }
+func (*GetHostsReply) ProtoMessage()    {}
+func (*GetHostsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{47}
 }
-func (m *WorkflowTemplateInfo) XXX_Unmarshal(b []byte) error {
+func (m *GetHostsReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WorkflowTemplateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetHostsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WorkflowTemplateInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetHostsReply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2481,51 +3035,44 @@ func (m *WorkflowTemplateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *WorkflowTemplateInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WorkflowTemplateInfo.Merge(m, src)
+func (m *GetHostsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHostsReply.Merge(m, src)
 }
-func (m *WorkflowTemplateInfo) XXX_Size() int {
+func (m *GetHostsReply) XXX_Size() int {
 	return m.Size()
 }
-func (m *WorkflowTemplateInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_WorkflowTemplateInfo.DiscardUnknown(m)
+func (m *GetHostsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHostsReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WorkflowTemplateInfo proto.InternalMessageInfo
-
-func (m *WorkflowTemplateInfo) GetRepo() string {
-	if m != nil {
-		return m.Repo
-	}
-	return ""
-}
+var xxx_messageInfo_GetHostsReply proto.InternalMessageInfo
 
-func (m *WorkflowTemplateInfo) GetTemplate() string {
+func (m *GetHostsReply) GetHosts() []*HostInfo {
 	if m != nil {
-		return m.Template
+		return m.Hosts
 	}
-	return ""
+	return nil
 }
 
-type GetWorkflowTemplatesReply struct {
-	WorkflowTemplates    []*WorkflowTemplateInfo `protobuf:"bytes,1,rep,name=workflowTemplates,proto3" json:"workflowTemplates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+type GetHostRequest struct {
+	Host                 string   `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetWorkflowTemplatesReply) Reset()         { *m = GetWorkflowTemplatesReply{} }
-func (m *GetWorkflowTemplatesReply) String() string { return proto.CompactTextString(m) }
-func (*GetWorkflowTemplatesReply) ProtoMessage()    {}
-func (*GetWorkflowTemplatesReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{40}
+func (m *GetHostRequest) Reset()         { *m = GetHostRequest{} }
+func (m *GetHostRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHostRequest) ProtoMessage()    {}
+func (*GetHostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{48}
 }
-func (m *GetWorkflowTemplatesReply) XXX_Unmarshal(b []byte) error {
+func (m *GetHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetWorkflowTemplatesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetHostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetWorkflowTemplatesReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetHostRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2535,43 +3082,44 @@ func (m *GetWorkflowTemplatesReply) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *GetWorkflowTemplatesReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWorkflowTemplatesReply.Merge(m, src)
+func (m *GetHostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHostRequest.Merge(m, src)
 }
-func (m *GetWorkflowTemplatesReply) XXX_Size() int {
+func (m *GetHostRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetWorkflowTemplatesReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWorkflowTemplatesReply.DiscardUnknown(m)
+func (m *GetHostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHostRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWorkflowTemplatesReply proto.InternalMessageInfo
+var xxx_messageInfo_GetHostRequest proto.InternalMessageInfo
 
-func (m *GetWorkflowTemplatesReply) GetWorkflowTemplates() []*WorkflowTemplateInfo {
+func (m *GetHostRequest) GetHost() string {
 	if m != nil {
-		return m.WorkflowTemplates
+		return m.Host
 	}
-	return nil
+	return ""
 }
 
-type ListReposRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetHostReply struct {
+	Host                 *HostInfo `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *ListReposRequest) Reset()         { *m = ListReposRequest{} }
-func (m *ListReposRequest) String() string { return proto.CompactTextString(m) }
-func (*ListReposRequest) ProtoMessage()    {}
-func (*ListReposRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{41}
+func (m *GetHostReply) Reset()         { *m = GetHostReply{} }
+func (m *GetHostReply) String() string { return proto.CompactTextString(m) }
+func (*GetHostReply) ProtoMessage()    {}
+func (*GetHostReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{49}
 }
-func (m *ListReposRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetHostReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListReposRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetHostReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListReposRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetHostReply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2581,38 +3129,45 @@ func (m *ListReposRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ListReposRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListReposRequest.Merge(m, src)
+func (m *GetHostReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHostReply.Merge(m, src)
 }
-func (m *ListReposRequest) XXX_Size() int {
+func (m *GetHostReply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListReposRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListReposRequest.DiscardUnknown(m)
+func (m *GetHostReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHostReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListReposRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetHostReply proto.InternalMessageInfo
 
-type RepoInfo struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Default              bool     `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+func (m *GetHostReply) GetHost() *HostInfo {
+	if m != nil {
+		return m.Host
+	}
+	return nil
+}
+
+type SetHostStateRequest struct {
+	Host                 string   `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RepoInfo) Reset()         { *m = RepoInfo{} }
-func (m *RepoInfo) String() string { return proto.CompactTextString(m) }
-func (*RepoInfo) ProtoMessage()    {}
-func (*RepoInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{42}
+func (m *SetHostStateRequest) Reset()         { *m = SetHostStateRequest{} }
+func (m *SetHostStateRequest) String() string { return proto.CompactTextString(m) }
+func (*SetHostStateRequest) ProtoMessage()    {}
+func (*SetHostStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{50}
 }
-func (m *RepoInfo) XXX_Unmarshal(b []byte) error {
+func (m *SetHostStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RepoInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetHostStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RepoInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetHostStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2622,51 +3177,51 @@ func (m *RepoInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RepoInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RepoInfo.Merge(m, src)
+func (m *SetHostStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetHostStateRequest.Merge(m, src)
 }
-func (m *RepoInfo) XXX_Size() int {
+func (m *SetHostStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RepoInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_RepoInfo.DiscardUnknown(m)
+func (m *SetHostStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetHostStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RepoInfo proto.InternalMessageInfo
+var xxx_messageInfo_SetHostStateRequest proto.InternalMessageInfo
 
-func (m *RepoInfo) GetName() string {
+func (m *SetHostStateRequest) GetHost() string {
 	if m != nil {
-		return m.Name
+		return m.Host
 	}
 	return ""
 }
 
-func (m *RepoInfo) GetDefault() bool {
+func (m *SetHostStateRequest) GetState() string {
 	if m != nil {
-		return m.Default
+		return m.State
 	}
-	return false
+	return ""
 }
 
-type ListReposReply struct {
-	Repos                []*RepoInfo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type SetHostStateReply struct {
+	Host                 *HostInfo `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *ListReposReply) Reset()         { *m = ListReposReply{} }
-func (m *ListReposReply) String() string { return proto.CompactTextString(m) }
-func (*ListReposReply) ProtoMessage()    {}
-func (*ListReposReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{43}
+func (m *SetHostStateReply) Reset()         { *m = SetHostStateReply{} }
+func (m *SetHostStateReply) String() string { return proto.CompactTextString(m) }
+func (*SetHostStateReply) ProtoMessage()    {}
+func (*SetHostStateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{51}
 }
-func (m *ListReposReply) XXX_Unmarshal(b []byte) error {
+func (m *SetHostStateReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListReposReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetHostStateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListReposReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetHostStateReply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2676,44 +3231,51 @@ func (m *ListReposReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ListReposReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListReposReply.Merge(m, src)
+func (m *SetHostStateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetHostStateReply.Merge(m, src)
 }
-func (m *ListReposReply) XXX_Size() int {
+func (m *SetHostStateReply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListReposReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListReposReply.DiscardUnknown(m)
+func (m *SetHostStateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetHostStateReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListReposReply proto.InternalMessageInfo
+var xxx_messageInfo_SetHostStateReply proto.InternalMessageInfo
 
-func (m *ListReposReply) GetRepos() []*RepoInfo {
+func (m *SetHostStateReply) GetHost() *HostInfo {
 	if m != nil {
-		return m.Repos
+		return m.Host
 	}
 	return nil
 }
 
-type AddRepoRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type HostInfo struct {
+	Hostname             string            `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	AgentId              string            `protobuf:"bytes,2,opt,name=agentId,proto3" json:"agentId,omitempty"`
+	Attributes           map[string]string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Resources            map[string]string `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LastOffered          string            `protobuf:"bytes,5,opt,name=lastOffered,proto3" json:"lastOffered,omitempty"`
+	Tasks                []*ShortTaskInfo  `protobuf:"bytes,6,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	EnvironmentIds       []string          `protobuf:"bytes,7,rep,name=environmentIds,proto3" json:"environmentIds,omitempty"`
+	State                string            `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *AddRepoRequest) Reset()         { *m = AddRepoRequest{} }
-func (m *AddRepoRequest) String() string { return proto.CompactTextString(m) }
-func (*AddRepoRequest) ProtoMessage()    {}
-func (*AddRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{44}
+func (m *HostInfo) Reset()         { *m = HostInfo{} }
+func (m *HostInfo) String() string { return proto.CompactTextString(m) }
+func (*HostInfo) ProtoMessage()    {}
+func (*HostInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{52}
 }
-func (m *AddRepoRequest) XXX_Unmarshal(b []byte) error {
+func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HostInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddRepoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HostInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2723,91 +3285,92 @@ func (m *AddRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *AddRepoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddRepoRequest.Merge(m, src)
+func (m *HostInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostInfo.Merge(m, src)
 }
-func (m *AddRepoRequest) XXX_Size() int {
+func (m *HostInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddRepoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddRepoRequest.DiscardUnknown(m)
+func (m *HostInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddRepoRequest proto.InternalMessageInfo
+var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 
-func (m *AddRepoRequest) GetName() string {
+func (m *HostInfo) GetHostname() string {
 	if m != nil {
-		return m.Name
+		return m.Hostname
 	}
 	return ""
 }
 
-type AddRepoReply struct {
-	ErrorString          string   `protobuf:"bytes,1,opt,name=errorString,proto3" json:"errorString,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *HostInfo) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
 }
 
-func (m *AddRepoReply) Reset()         { *m = AddRepoReply{} }
-func (m *AddRepoReply) String() string { return proto.CompactTextString(m) }
-func (*AddRepoReply) ProtoMessage()    {}
-func (*AddRepoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{45}
-}
-func (m *AddRepoReply) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AddRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AddRepoReply.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *HostInfo) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
 	}
+	return nil
 }
-func (m *AddRepoReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddRepoReply.Merge(m, src)
+
+func (m *HostInfo) GetResources() map[string]string {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
 }
-func (m *AddRepoReply) XXX_Size() int {
-	return m.Size()
+
+func (m *HostInfo) GetLastOffered() string {
+	if m != nil {
+		return m.LastOffered
+	}
+	return ""
 }
-func (m *AddRepoReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddRepoReply.DiscardUnknown(m)
+
+func (m *HostInfo) GetTasks() []*ShortTaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
 }
 
-var xxx_messageInfo_AddRepoReply proto.InternalMessageInfo
+func (m *HostInfo) GetEnvironmentIds() []string {
+	if m != nil {
+		return m.EnvironmentIds
+	}
+	return nil
+}
 
-func (m *AddRepoReply) GetErrorString() string {
+func (m *HostInfo) GetState() string {
 	if m != nil {
-		return m.ErrorString
+		return m.State
 	}
 	return ""
 }
 
-type RemoveRepoRequest struct {
-	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+type GetWorkflowTemplatesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveRepoRequest) Reset()         { *m = RemoveRepoRequest{} }
-func (m *RemoveRepoRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveRepoRequest) ProtoMessage()    {}
-func (*RemoveRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{46}
+func (m *GetWorkflowTemplatesRequest) Reset()         { *m = GetWorkflowTemplatesRequest{} }
+func (m *GetWorkflowTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWorkflowTemplatesRequest) ProtoMessage()    {}
+func (*GetWorkflowTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{53}
 }
-func (m *RemoveRepoRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetWorkflowTemplatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetWorkflowTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveRepoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetWorkflowTemplatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2817,45 +3380,39 @@ func (m *RemoveRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *RemoveRepoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRepoRequest.Merge(m, src)
+func (m *GetWorkflowTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowTemplatesRequest.Merge(m, src)
 }
-func (m *RemoveRepoRequest) XXX_Size() int {
+func (m *GetWorkflowTemplatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveRepoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRepoRequest.DiscardUnknown(m)
+func (m *GetWorkflowTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowTemplatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveRepoRequest proto.InternalMessageInfo
-
-func (m *RemoveRepoRequest) GetIndex() int32 {
-	if m != nil {
-		return m.Index
-	}
-	return 0
-}
+var xxx_messageInfo_GetWorkflowTemplatesRequest proto.InternalMessageInfo
 
-type RemoveRepoReply struct {
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	NewDefaultRepo       string   `protobuf:"bytes,2,opt,name=newDefaultRepo,proto3" json:"newDefaultRepo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type WorkflowTemplateInfo struct {
+	Repo                 string                   `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Template             string                   `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Parameters           []*WorkflowParameterInfo `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *RemoveRepoReply) Reset()         { *m = RemoveRepoReply{} }
-func (m *RemoveRepoReply) String() string { return proto.CompactTextString(m) }
-func (*RemoveRepoReply) ProtoMessage()    {}
-func (*RemoveRepoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{47}
+func (m *WorkflowTemplateInfo) Reset()         { *m = WorkflowTemplateInfo{} }
+func (m *WorkflowTemplateInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTemplateInfo) ProtoMessage()    {}
+func (*WorkflowTemplateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{54}
 }
-func (m *RemoveRepoReply) XXX_Unmarshal(b []byte) error {
+func (m *WorkflowTemplateInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkflowTemplateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveRepoReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkflowTemplateInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2865,51 +3422,63 @@ func (m *RemoveRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *RemoveRepoReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRepoReply.Merge(m, src)
+func (m *WorkflowTemplateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateInfo.Merge(m, src)
 }
-func (m *RemoveRepoReply) XXX_Size() int {
+func (m *WorkflowTemplateInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveRepoReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRepoReply.DiscardUnknown(m)
+func (m *WorkflowTemplateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveRepoReply proto.InternalMessageInfo
+var xxx_messageInfo_WorkflowTemplateInfo proto.InternalMessageInfo
 
-func (m *RemoveRepoReply) GetOk() bool {
+func (m *WorkflowTemplateInfo) GetRepo() string {
 	if m != nil {
-		return m.Ok
+		return m.Repo
 	}
-	return false
+	return ""
 }
 
-func (m *RemoveRepoReply) GetNewDefaultRepo() string {
+func (m *WorkflowTemplateInfo) GetTemplate() string {
 	if m != nil {
-		return m.NewDefaultRepo
+		return m.Template
 	}
 	return ""
 }
 
-type RefreshReposRequest struct {
-	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+func (m *WorkflowTemplateInfo) GetParameters() []*WorkflowParameterInfo {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+type WorkflowParameterInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultValue         string   `protobuf:"bytes,3,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
+	Required             bool     `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Description          string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	AllowedValues        []string `protobuf:"bytes,6,rep,name=allowedValues,proto3" json:"allowedValues,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RefreshReposRequest) Reset()         { *m = RefreshReposRequest{} }
-func (m *RefreshReposRequest) String() string { return proto.CompactTextString(m) }
-func (*RefreshReposRequest) ProtoMessage()    {}
-func (*RefreshReposRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{48}
+func (m *WorkflowParameterInfo) Reset()         { *m = WorkflowParameterInfo{} }
+func (m *WorkflowParameterInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkflowParameterInfo) ProtoMessage()    {}
+func (*WorkflowParameterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{55}
 }
-func (m *RefreshReposRequest) XXX_Unmarshal(b []byte) error {
+func (m *WorkflowParameterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RefreshReposRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkflowParameterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RefreshReposRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkflowParameterInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2919,44 +3488,79 @@ func (m *RefreshReposRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RefreshReposRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefreshReposRequest.Merge(m, src)
+func (m *WorkflowParameterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowParameterInfo.Merge(m, src)
 }
-func (m *RefreshReposRequest) XXX_Size() int {
+func (m *WorkflowParameterInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *RefreshReposRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefreshReposRequest.DiscardUnknown(m)
+func (m *WorkflowParameterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowParameterInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefreshReposRequest proto.InternalMessageInfo
+var xxx_messageInfo_WorkflowParameterInfo proto.InternalMessageInfo
 
-func (m *RefreshReposRequest) GetIndex() int32 {
+func (m *WorkflowParameterInfo) GetName() string {
 	if m != nil {
-		return m.Index
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-type RefreshReposReply struct {
-	ErrorString          string   `protobuf:"bytes,1,opt,name=errorString,proto3" json:"errorString,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WorkflowParameterInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *RefreshReposReply) Reset()         { *m = RefreshReposReply{} }
-func (m *RefreshReposReply) String() string { return proto.CompactTextString(m) }
-func (*RefreshReposReply) ProtoMessage()    {}
-func (*RefreshReposReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{49}
+func (m *WorkflowParameterInfo) GetDefaultValue() string {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return ""
 }
-func (m *RefreshReposReply) XXX_Unmarshal(b []byte) error {
+
+func (m *WorkflowParameterInfo) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *WorkflowParameterInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *WorkflowParameterInfo) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
+type GetWorkflowTemplatesReply struct {
+	WorkflowTemplates    []*WorkflowTemplateInfo `protobuf:"bytes,1,rep,name=workflowTemplates,proto3" json:"workflowTemplates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetWorkflowTemplatesReply) Reset()         { *m = GetWorkflowTemplatesReply{} }
+func (m *GetWorkflowTemplatesReply) String() string { return proto.CompactTextString(m) }
+func (*GetWorkflowTemplatesReply) ProtoMessage()    {}
+func (*GetWorkflowTemplatesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{56}
+}
+func (m *GetWorkflowTemplatesReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RefreshReposReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetWorkflowTemplatesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RefreshReposReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetWorkflowTemplatesReply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2966,44 +3570,43 @@ func (m *RefreshReposReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *RefreshReposReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefreshReposReply.Merge(m, src)
+func (m *GetWorkflowTemplatesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowTemplatesReply.Merge(m, src)
 }
-func (m *RefreshReposReply) XXX_Size() int {
+func (m *GetWorkflowTemplatesReply) XXX_Size() int {
 	return m.Size()
 }
-func (m *RefreshReposReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefreshReposReply.DiscardUnknown(m)
+func (m *GetWorkflowTemplatesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowTemplatesReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefreshReposReply proto.InternalMessageInfo
+var xxx_messageInfo_GetWorkflowTemplatesReply proto.InternalMessageInfo
 
-func (m *RefreshReposReply) GetErrorString() string {
+func (m *GetWorkflowTemplatesReply) GetWorkflowTemplates() []*WorkflowTemplateInfo {
 	if m != nil {
-		return m.ErrorString
+		return m.WorkflowTemplates
 	}
-	return ""
+	return nil
 }
 
-type SetDefaultRepoRequest struct {
-	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+type ListReposRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetDefaultRepoRequest) Reset()         { *m = SetDefaultRepoRequest{} }
-func (m *SetDefaultRepoRequest) String() string { return proto.CompactTextString(m) }
-func (*SetDefaultRepoRequest) ProtoMessage()    {}
-func (*SetDefaultRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{50}
+func (m *ListReposRequest) Reset()         { *m = ListReposRequest{} }
+func (m *ListReposRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReposRequest) ProtoMessage()    {}
+func (*ListReposRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{57}
 }
-func (m *SetDefaultRepoRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListReposRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetDefaultRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListReposRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetDefaultRepoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListReposRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3013,44 +3616,38 @@ func (m *SetDefaultRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *SetDefaultRepoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetDefaultRepoRequest.Merge(m, src)
+func (m *ListReposRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReposRequest.Merge(m, src)
 }
-func (m *SetDefaultRepoRequest) XXX_Size() int {
+func (m *ListReposRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetDefaultRepoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetDefaultRepoRequest.DiscardUnknown(m)
+func (m *ListReposRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReposRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetDefaultRepoRequest proto.InternalMessageInfo
-
-func (m *SetDefaultRepoRequest) GetIndex() int32 {
-	if m != nil {
-		return m.Index
-	}
-	return 0
-}
+var xxx_messageInfo_ListReposRequest proto.InternalMessageInfo
 
-type SetDefaultRepoReply struct {
-	ErrorString          string   `protobuf:"bytes,1,opt,name=errorString,proto3" json:"errorString,omitempty"`
+type RepoInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Default              bool     `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetDefaultRepoReply) Reset()         { *m = SetDefaultRepoReply{} }
-func (m *SetDefaultRepoReply) String() string { return proto.CompactTextString(m) }
-func (*SetDefaultRepoReply) ProtoMessage()    {}
-func (*SetDefaultRepoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{51}
+func (m *RepoInfo) Reset()         { *m = RepoInfo{} }
+func (m *RepoInfo) String() string { return proto.CompactTextString(m) }
+func (*RepoInfo) ProtoMessage()    {}
+func (*RepoInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{58}
 }
-func (m *SetDefaultRepoReply) XXX_Unmarshal(b []byte) error {
+func (m *RepoInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetDefaultRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RepoInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetDefaultRepoReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RepoInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3060,1883 +3657,1556 @@ func (m *SetDefaultRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SetDefaultRepoReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetDefaultRepoReply.Merge(m, src)
+func (m *RepoInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoInfo.Merge(m, src)
 }
-func (m *SetDefaultRepoReply) XXX_Size() int {
+func (m *RepoInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetDefaultRepoReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetDefaultRepoReply.DiscardUnknown(m)
+func (m *RepoInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetDefaultRepoReply proto.InternalMessageInfo
+var xxx_messageInfo_RepoInfo proto.InternalMessageInfo
 
-func (m *SetDefaultRepoReply) GetErrorString() string {
+func (m *RepoInfo) GetName() string {
 	if m != nil {
-		return m.ErrorString
+		return m.Name
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("o2control.StatusUpdate_Level", StatusUpdate_Level_name, StatusUpdate_Level_value)
-	proto.RegisterEnum("o2control.ControlEnvironmentRequest_Optype", ControlEnvironmentRequest_Optype_name, ControlEnvironmentRequest_Optype_value)
-	proto.RegisterEnum("o2control.EnvironmentOperation_Optype", EnvironmentOperation_Optype_name, EnvironmentOperation_Optype_value)
-	proto.RegisterType((*Event_MesosHeartbeat)(nil), "o2control.Event_MesosHeartbeat")
-	proto.RegisterType((*StatusRequest)(nil), "o2control.StatusRequest")
-	proto.RegisterType((*StatusReply)(nil), "o2control.StatusReply")
-	proto.RegisterType((*StatusUpdate)(nil), "o2control.StatusUpdate")
-	proto.RegisterType((*GetFrameworkInfoRequest)(nil), "o2control.GetFrameworkInfoRequest")
-	proto.RegisterType((*Version)(nil), "o2control.Version")
-	proto.RegisterType((*GetFrameworkInfoReply)(nil), "o2control.GetFrameworkInfoReply")
-	proto.RegisterType((*TeardownRequest)(nil), "o2control.TeardownRequest")
-	proto.RegisterType((*TeardownReply)(nil), "o2control.TeardownReply")
-	proto.RegisterType((*GetEnvironmentsRequest)(nil), "o2control.GetEnvironmentsRequest")
-	proto.RegisterType((*GetEnvironmentsReply)(nil), "o2control.GetEnvironmentsReply")
-	proto.RegisterType((*EnvironmentInfo)(nil), "o2control.EnvironmentInfo")
-	proto.RegisterType((*NewEnvironmentRequest)(nil), "o2control.NewEnvironmentRequest")
-	proto.RegisterType((*NewEnvironmentReply)(nil), "o2control.NewEnvironmentReply")
-	proto.RegisterType((*GetEnvironmentRequest)(nil), "o2control.GetEnvironmentRequest")
-	proto.RegisterType((*GetEnvironmentReply)(nil), "o2control.GetEnvironmentReply")
-	proto.RegisterType((*ControlEnvironmentRequest)(nil), "o2control.ControlEnvironmentRequest")
-	proto.RegisterType((*ControlEnvironmentReply)(nil), "o2control.ControlEnvironmentReply")
-	proto.RegisterType((*ModifyEnvironmentRequest)(nil), "o2control.ModifyEnvironmentRequest")
-	proto.RegisterType((*EnvironmentOperation)(nil), "o2control.EnvironmentOperation")
-	proto.RegisterType((*ModifyEnvironmentReply)(nil), "o2control.ModifyEnvironmentReply")
-	proto.RegisterType((*DestroyEnvironmentRequest)(nil), "o2control.DestroyEnvironmentRequest")
-	proto.RegisterType((*DestroyEnvironmentReply)(nil), "o2control.DestroyEnvironmentReply")
-	proto.RegisterType((*ShortTaskInfo)(nil), "o2control.ShortTaskInfo")
-	proto.RegisterType((*TaskDeploymentInfo)(nil), "o2control.TaskDeploymentInfo")
-	proto.RegisterType((*GetTasksRequest)(nil), "o2control.GetTasksRequest")
-	proto.RegisterType((*GetTasksReply)(nil), "o2control.GetTasksReply")
-	proto.RegisterType((*GetTaskRequest)(nil), "o2control.GetTaskRequest")
-	proto.RegisterType((*GetTaskReply)(nil), "o2control.GetTaskReply")
-	proto.RegisterType((*TaskClassInfo)(nil), "o2control.TaskClassInfo")
-	proto.RegisterType((*CommandInfo)(nil), "o2control.CommandInfo")
-	proto.RegisterType((*ChannelInfo)(nil), "o2control.ChannelInfo")
-	proto.RegisterType((*TaskInfo)(nil), "o2control.TaskInfo")
-	proto.RegisterType((*CleanupTasksRequest)(nil), "o2control.CleanupTasksRequest")
-	proto.RegisterType((*CleanupTasksReply)(nil), "o2control.CleanupTasksReply")
-	proto.RegisterType((*GetRolesRequest)(nil), "o2control.GetRolesRequest")
-	proto.RegisterType((*RoleInfo)(nil), "o2control.RoleInfo")
-	proto.RegisterType((*GetRolesReply)(nil), "o2control.GetRolesReply")
-	proto.RegisterType((*GetWorkflowTemplatesRequest)(nil), "o2control.GetWorkflowTemplatesRequest")
-	proto.RegisterType((*WorkflowTemplateInfo)(nil), "o2control.WorkflowTemplateInfo")
-	proto.RegisterType((*GetWorkflowTemplatesReply)(nil), "o2control.GetWorkflowTemplatesReply")
-	proto.RegisterType((*ListReposRequest)(nil), "o2control.ListReposRequest")
-	proto.RegisterType((*RepoInfo)(nil), "o2control.RepoInfo")
-	proto.RegisterType((*ListReposReply)(nil), "o2control.ListReposReply")
-	proto.RegisterType((*AddRepoRequest)(nil), "o2control.AddRepoRequest")
-	proto.RegisterType((*AddRepoReply)(nil), "o2control.AddRepoReply")
-	proto.RegisterType((*RemoveRepoRequest)(nil), "o2control.RemoveRepoRequest")
-	proto.RegisterType((*RemoveRepoReply)(nil), "o2control.RemoveRepoReply")
-	proto.RegisterType((*RefreshReposRequest)(nil), "o2control.RefreshReposRequest")
-	proto.RegisterType((*RefreshReposReply)(nil), "o2control.RefreshReposReply")
-	proto.RegisterType((*SetDefaultRepoRequest)(nil), "o2control.SetDefaultRepoRequest")
-	proto.RegisterType((*SetDefaultRepoReply)(nil), "o2control.SetDefaultRepoReply")
-}
-
-func init() { proto.RegisterFile("protos/o2control.proto", fileDescriptor_2aa6aa9a1f02efa9) }
-
-var fileDescriptor_2aa6aa9a1f02efa9 = []byte{
-	// 2159 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xd7, 0xc8, 0x92, 0x25, 0x3d, 0xc9, 0xb2, 0xdc, 0x76, 0xec, 0xb1, 0xd6, 0xeb, 0x78, 0x9b,
-	0x54, 0x36, 0xd9, 0x5d, 0x9c, 0xc5, 0x0b, 0x6c, 0x2a, 0x2c, 0x04, 0x5b, 0x56, 0x1c, 0x41, 0x6c,
-	0xa5, 0x46, 0x4a, 0x52, 0x6c, 0x15, 0x15, 0xc6, 0x9a, 0x96, 0xa5, 0xf5, 0x68, 0x5a, 0xcc, 0x1f,
-	0x3b, 0x3e, 0x70, 0xe3, 0x46, 0x51, 0x7b, 0xe0, 0xc2, 0x95, 0xe2, 0xcc, 0xf7, 0xe0, 0x42, 0x01,
-	0xdf, 0x80, 0x0a, 0x55, 0x9c, 0xf8, 0x10, 0x54, 0xff, 0x99, 0x99, 0x9e, 0xd1, 0xc8, 0x76, 0x15,
-	0x37, 0xbd, 0xd7, 0xbf, 0xf7, 0xba, 0xdf, 0x9f, 0x7e, 0xef, 0xf5, 0x08, 0xd6, 0xa7, 0x2e, 0xf5,
-	0xa9, 0xf7, 0x88, 0xee, 0x0d, 0xa8, 0xe3, 0xbb, 0xd4, 0xde, 0xe5, 0x0c, 0x54, 0x89, 0x18, 0x78,
-	0x1d, 0xd6, 0xda, 0x17, 0xc4, 0xf1, 0xdf, 0x1e, 0x13, 0x8f, 0x7a, 0xcf, 0x89, 0xe9, 0xfa, 0xa7,
-	0xc4, 0xf4, 0xf1, 0x32, 0x2c, 0xf5, 0x7c, 0xd3, 0x0f, 0x3c, 0x83, 0xfc, 0x3a, 0x20, 0x9e, 0x8f,
-	0x4f, 0xa1, 0x1a, 0x32, 0xa6, 0xf6, 0x15, 0x5a, 0x83, 0xa2, 0xe7, 0x9b, 0x3e, 0xd1, 0xb5, 0x1d,
-	0xed, 0x41, 0xc5, 0x10, 0x04, 0xfa, 0x31, 0x2c, 0x79, 0x1c, 0xf4, 0x6a, 0x6a, 0x99, 0x3e, 0xf1,
-	0xf4, 0xfc, 0xce, 0xc2, 0x83, 0xea, 0xde, 0xc6, 0x6e, 0x7c, 0x82, 0x9e, 0xb2, 0x6e, 0x24, 0xd1,
-	0xf8, 0x6f, 0x1a, 0xd4, 0xd4, 0x75, 0xf4, 0x05, 0x14, 0x6d, 0x72, 0x41, 0x6c, 0xbe, 0x4b, 0x7d,
-	0xef, 0xc3, 0x39, 0x7a, 0x76, 0x5f, 0x30, 0x90, 0x21, 0xb0, 0xa8, 0x03, 0xf5, 0x49, 0xc2, 0x18,
-	0x3d, 0xbf, 0xa3, 0x3d, 0xa8, 0xee, 0xdd, 0x55, 0xa4, 0xb3, 0x6c, 0x7e, 0x9e, 0x33, 0x52, 0x82,
-	0xf8, 0xfb, 0x50, 0xe4, 0xaa, 0x51, 0x05, 0x8a, 0x87, 0xed, 0x83, 0x57, 0x47, 0x8d, 0x1c, 0x2a,
-	0x43, 0xa1, 0x73, 0xf2, 0xac, 0xdb, 0xd0, 0x50, 0x15, 0x4a, 0x6f, 0xf6, 0x8d, 0x93, 0xce, 0xc9,
-	0x51, 0x23, 0xcf, 0x10, 0x6d, 0xc3, 0xe8, 0x1a, 0x8d, 0x85, 0x83, 0x12, 0x14, 0xb9, 0x7e, 0xbc,
-	0x09, 0x1b, 0x47, 0xc4, 0x7f, 0xe6, 0x9a, 0x13, 0x72, 0x49, 0xdd, 0xf3, 0x8e, 0x33, 0xa4, 0xa1,
-	0x3b, 0xff, 0xac, 0x41, 0xe9, 0x35, 0x71, 0xbd, 0x31, 0x75, 0x98, 0x2f, 0x27, 0xe6, 0x37, 0xd4,
-	0xe5, 0x56, 0x16, 0x0d, 0x41, 0x70, 0xee, 0xd8, 0xa1, 0x2e, 0x3f, 0x3d, 0xe3, 0x32, 0x82, 0x71,
-	0xa7, 0xa6, 0x3f, 0x18, 0xe9, 0x0b, 0x82, 0xcb, 0x09, 0xc6, 0x3d, 0x0d, 0xc6, 0xb6, 0xa5, 0x17,
-	0x44, 0x34, 0x38, 0x81, 0x76, 0xa0, 0x3a, 0x75, 0xa9, 0x15, 0x0c, 0xfc, 0x13, 0x73, 0x42, 0xf4,
-	0x22, 0x5f, 0x53, 0x59, 0x68, 0x1b, 0xe0, 0x42, 0x1c, 0xa2, 0xe7, 0xbb, 0xfa, 0x22, 0x07, 0x28,
-	0x1c, 0xfc, 0x6d, 0x1e, 0xee, 0xcc, 0x5a, 0xc0, 0xe2, 0xbf, 0x03, 0xd5, 0x61, 0xc4, 0xb5, 0x64,
-	0x16, 0xa8, 0x2c, 0xf4, 0x19, 0xac, 0x10, 0xe7, 0x62, 0xec, 0x52, 0x67, 0x42, 0x1c, 0xdf, 0x6b,
-	0xd1, 0xc0, 0xf1, 0xa5, 0x2d, 0xb3, 0x0b, 0xec, 0x24, 0xbe, 0xe9, 0x9d, 0x4b, 0x98, 0x30, 0x4e,
-	0xe1, 0xc4, 0xf9, 0x56, 0x50, 0xf3, 0x6d, 0x1b, 0x60, 0x44, 0xbd, 0x50, 0x79, 0x51, 0x48, 0xc5,
-	0x1c, 0x84, 0xa1, 0x36, 0x76, 0x3c, 0xdf, 0x74, 0x06, 0x84, 0xbb, 0x40, 0x58, 0x98, 0xe0, 0xa1,
-	0xcf, 0xa0, 0x24, 0x2d, 0xd6, 0x4b, 0x3c, 0x4f, 0x90, 0x92, 0x27, 0x32, 0x44, 0x46, 0x08, 0xc1,
-	0x0f, 0x61, 0xb9, 0x4f, 0x4c, 0xd7, 0xa2, 0x97, 0x8e, 0x0c, 0x25, 0x5a, 0x87, 0x45, 0x97, 0x98,
-	0x1e, 0x75, 0xa4, 0x17, 0x24, 0xc5, 0xae, 0x50, 0x0c, 0x9d, 0xda, 0x57, 0x58, 0x87, 0xf5, 0x23,
-	0xe2, 0xb7, 0x15, 0xdb, 0xc3, 0x6c, 0x78, 0x07, 0x6b, 0x33, 0x2b, 0xb7, 0xf3, 0xf2, 0x4f, 0xa0,
-	0xa6, 0x3a, 0x53, 0x5e, 0xb8, 0xa6, 0x9a, 0xea, 0xf1, 0x32, 0x0f, 0x5f, 0x02, 0x8f, 0xff, 0xa9,
-	0xc1, 0x72, 0x0a, 0x81, 0xea, 0x90, 0x1f, 0x87, 0x9b, 0xe5, 0xc7, 0x3c, 0x8f, 0x06, 0x2e, 0x31,
-	0x7d, 0x62, 0xbd, 0x19, 0x11, 0x87, 0xc7, 0xb0, 0x62, 0xa8, 0xac, 0x38, 0x3a, 0x0b, 0x6a, 0x74,
-	0x76, 0xa1, 0xc8, 0x23, 0xa8, 0x17, 0xf8, 0xa1, 0x74, 0xf5, 0xf6, 0x8e, 0xa8, 0xeb, 0xf7, 0x4d,
-	0x4f, 0x64, 0x94, 0x80, 0xa1, 0x26, 0x94, 0x5d, 0x4a, 0x7d, 0x83, 0xda, 0x61, 0xb2, 0x46, 0x34,
-	0xfa, 0x04, 0x1a, 0x83, 0xc0, 0x75, 0x89, 0xe3, 0x1b, 0x81, 0x73, 0x12, 0x4c, 0x4e, 0x89, 0xc8,
-	0xd7, 0x25, 0x63, 0x86, 0x8f, 0x5b, 0x70, 0xe7, 0x84, 0x5c, 0x2a, 0x56, 0x85, 0x91, 0xfa, 0x04,
-	0x1a, 0xcc, 0x6d, 0x43, 0x9b, 0x5e, 0xf6, 0xc9, 0x64, 0x6a, 0xc7, 0xf5, 0x6b, 0x86, 0x8f, 0x7b,
-	0xb0, 0x9a, 0x56, 0xc2, 0x22, 0xf2, 0x15, 0x54, 0x15, 0xff, 0x71, 0xe9, 0xeb, 0xdd, 0xad, 0xc2,
-	0xf1, 0xc7, 0xfc, 0x3a, 0x65, 0x9c, 0x2c, 0xe5, 0x72, 0xfc, 0x5b, 0x0d, 0x56, 0xd3, 0xc8, 0xff,
-	0x7b, 0x7b, 0xf4, 0x08, 0xca, 0xa1, 0x9d, 0xb2, 0x26, 0xae, 0x2a, 0xa2, 0xcc, 0xcf, 0x5c, 0x26,
-	0x02, 0xe1, 0xbf, 0x6b, 0xb0, 0xd9, 0x12, 0xcb, 0x37, 0x1f, 0x1a, 0x3d, 0x85, 0x82, 0x7f, 0x35,
-	0x25, 0x5c, 0x75, 0x7d, 0xef, 0x53, 0x45, 0xf5, 0x5c, 0x1d, 0xbb, 0xdd, 0x29, 0x13, 0x31, 0xb8,
-	0x20, 0x36, 0x61, 0x51, 0xd0, 0xac, 0xc8, 0x9e, 0x74, 0xbb, 0x2f, 0x1b, 0x39, 0x84, 0xa0, 0xde,
-	0xeb, 0xef, 0x1b, 0xfd, 0xb7, 0xfb, 0xad, 0x7e, 0xe7, 0x75, 0xa7, 0xff, 0x8b, 0x86, 0x86, 0x56,
-	0x60, 0xa9, 0xd7, 0xef, 0xbe, 0x8c, 0x59, 0x79, 0xb4, 0x04, 0x95, 0x56, 0xf7, 0xe4, 0x59, 0xe7,
-	0xe8, 0x95, 0xd1, 0x6e, 0x2c, 0xb0, 0x6a, 0x6c, 0xb4, 0x7b, 0xed, 0x7e, 0xa3, 0x80, 0x6a, 0x50,
-	0x3e, 0xea, 0xbe, 0x15, 0xb5, 0xb9, 0x88, 0xcf, 0x61, 0x23, 0xeb, 0x30, 0xcc, 0xb7, 0x69, 0x73,
-	0xa2, 0xa4, 0xce, 0xab, 0x49, 0x9d, 0x95, 0x88, 0x0b, 0x73, 0x12, 0xf1, 0x0f, 0x1a, 0xe8, 0xc7,
-	0xd4, 0x1a, 0x0f, 0xaf, 0x6e, 0xe5, 0x3d, 0xa0, 0x53, 0xe2, 0x9a, 0xfe, 0x98, 0x3a, 0xe1, 0x3d,
-	0xbe, 0x9b, 0x1d, 0xd9, 0x6e, 0x88, 0x33, 0x14, 0x11, 0x74, 0x1f, 0xea, 0x2e, 0x19, 0x50, 0x67,
-	0x38, 0x3e, 0x0b, 0x5c, 0xb2, 0x6f, 0xdb, 0xfc, 0x5c, 0x65, 0x23, 0xc5, 0xc5, 0x7f, 0xd2, 0x60,
-	0x2d, 0x4b, 0x19, 0x7a, 0x22, 0xe3, 0x27, 0x9a, 0xed, 0xfd, 0x1b, 0xf6, 0x4e, 0x84, 0x4e, 0xdc,
-	0x5d, 0x5b, 0x54, 0xd9, 0x7c, 0x78, 0x77, 0x05, 0x8d, 0xbf, 0x97, 0x11, 0xd6, 0x65, 0xa8, 0x1a,
-	0xed, 0xe3, 0xee, 0xeb, 0xf6, 0x5b, 0xa3, 0xfb, 0x82, 0x45, 0xac, 0x06, 0xe5, 0xfd, 0xc3, 0x43,
-	0x41, 0x15, 0xf0, 0xef, 0x34, 0x58, 0xcf, 0xf0, 0x1c, 0x0b, 0xd3, 0xcf, 0xa1, 0x31, 0x34, 0xc7,
-	0x36, 0xb1, 0xba, 0xb1, 0xb7, 0xb4, 0xdb, 0x79, 0x6b, 0x46, 0x50, 0x06, 0x21, 0x3f, 0x1b, 0x73,
-	0xb5, 0x90, 0xe1, 0x0e, 0x6c, 0x1e, 0x12, 0xcf, 0x77, 0xe9, 0x6d, 0xe2, 0xb8, 0x05, 0x95, 0x73,
-	0x42, 0xa6, 0x7d, 0x5e, 0xf9, 0xf2, 0x3c, 0x02, 0x31, 0x03, 0x13, 0xd8, 0xc8, 0x52, 0xc5, 0x0c,
-	0xfb, 0x19, 0xac, 0x0c, 0x6c, 0x62, 0x3a, 0x81, 0x80, 0x72, 0xa6, 0xbc, 0xe1, 0x5b, 0xea, 0x5d,
-	0x4a, 0x63, 0x8c, 0x59, 0x31, 0xfc, 0x1f, 0x0d, 0x96, 0x12, 0x35, 0x16, 0x21, 0x28, 0x38, 0x2c,
-	0x38, 0xe2, 0xa0, 0xfc, 0x37, 0xeb, 0x5c, 0x36, 0x1d, 0x9c, 0x13, 0x4b, 0x9e, 0x53, 0x52, 0x8c,
-	0xcf, 0x2a, 0x72, 0xc7, 0x92, 0x6e, 0x90, 0x14, 0xe3, 0x8b, 0x81, 0x4d, 0x76, 0x61, 0x49, 0xc5,
-	0x5e, 0x2b, 0xaa, 0x37, 0x65, 0x0b, 0x2a, 0x03, 0xdb, 0xf4, 0x3c, 0xa5, 0xf3, 0xc6, 0x0c, 0xd4,
-	0x86, 0xba, 0x45, 0xa6, 0x36, 0xbd, 0x0a, 0x4b, 0x95, 0xec, 0xbe, 0xea, 0x8c, 0xc7, 0x0e, 0x7f,
-	0x98, 0x00, 0x19, 0x29, 0x21, 0x56, 0x28, 0xd1, 0x2c, 0x8c, 0xa5, 0x23, 0x1b, 0x03, 0x14, 0x8b,
-	0x23, 0x1a, 0xe9, 0x50, 0x32, 0xcf, 0x18, 0x30, 0x0c, 0x7c, 0x48, 0xb2, 0x15, 0x3a, 0x1c, 0x12,
-	0x37, 0x32, 0x3c, 0x24, 0xd9, 0xa0, 0x41, 0xde, 0x91, 0x41, 0xe0, 0x53, 0xb6, 0x28, 0xac, 0x57,
-	0x38, 0x78, 0x05, 0x96, 0x8f, 0x88, 0x2f, 0x03, 0x20, 0x7a, 0xfa, 0x53, 0x58, 0x8a, 0x59, 0x2c,
-	0xbe, 0x51, 0x3b, 0xd4, 0x6e, 0xd5, 0x0e, 0xf1, 0x03, 0xa8, 0x4b, 0x05, 0xca, 0xa4, 0x21, 0xe3,
-	0xa2, 0xa9, 0x71, 0xc1, 0x5f, 0x42, 0x2d, 0x42, 0xb2, 0x9d, 0x3e, 0x86, 0x02, 0x5b, 0x91, 0xc9,
-	0xb3, 0x9a, 0xf2, 0x28, 0xdf, 0x83, 0x03, 0x70, 0x1b, 0x96, 0x18, 0xa7, 0xc5, 0xa2, 0x32, 0x37,
-	0x4b, 0x58, 0xfb, 0x17, 0xe2, 0xc7, 0xd4, 0x22, 0x51, 0xfb, 0x8f, 0x59, 0xf8, 0x37, 0x50, 0x6d,
-	0xd1, 0xc9, 0xc4, 0x74, 0x2c, 0xae, 0xa4, 0x01, 0x0b, 0xc4, 0xb9, 0xe0, 0x66, 0x56, 0x0c, 0xf6,
-	0x93, 0x27, 0xc8, 0x88, 0xd8, 0xb6, 0xcc, 0x33, 0x41, 0x30, 0xee, 0x85, 0x69, 0x07, 0xd1, 0x65,
-	0xe3, 0x04, 0x4b, 0x1b, 0xd3, 0x3d, 0x0b, 0xc4, 0x38, 0x53, 0xe0, 0x3a, 0x62, 0x06, 0x3b, 0x60,
-	0xe0, 0x11, 0x57, 0x66, 0x1a, 0xff, 0x8d, 0x8f, 0xa1, 0xda, 0x1a, 0x99, 0x8e, 0x43, 0xec, 0xb9,
-	0x36, 0x20, 0xa5, 0x35, 0x55, 0x64, 0xc9, 0xe2, 0xde, 0x74, 0xcf, 0x88, 0x1f, 0x67, 0x39, 0xa3,
-	0xf0, 0x7f, 0xf3, 0x50, 0x8e, 0xae, 0xcd, 0x0f, 0xa1, 0xe2, 0xb1, 0xe0, 0xf0, 0x0c, 0x15, 0xfe,
-	0x9c, 0x1f, 0xb8, 0x18, 0xca, 0xe4, 0x06, 0xa1, 0x57, 0x65, 0xaf, 0xd5, 0x53, 0x71, 0x88, 0xbc,
-	0x6e, 0xc4, 0x50, 0xf4, 0x53, 0x58, 0x1e, 0x3b, 0xa7, 0x34, 0x70, 0x2c, 0x69, 0x92, 0xa7, 0x2f,
-	0xf0, 0x74, 0x59, 0x57, 0x4b, 0x40, 0x6c, 0xad, 0x91, 0x86, 0xa3, 0x03, 0x68, 0xd0, 0xc0, 0x4f,
-	0xaa, 0x28, 0x5c, 0xab, 0x62, 0x06, 0x8f, 0x1e, 0xb3, 0x90, 0x47, 0x01, 0xe5, 0xce, 0x4e, 0x89,
-	0xc7, 0xab, 0x86, 0x0a, 0x65, 0x17, 0x8f, 0x65, 0xd6, 0x4b, 0xd3, 0x1f, 0xc9, 0x3b, 0x1f, 0xd1,
-	0x2c, 0xde, 0xc4, 0xb9, 0xe8, 0x58, 0xfc, 0xa6, 0x57, 0x0c, 0x41, 0xe0, 0x47, 0xb0, 0x9a, 0x2c,
-	0x69, 0x22, 0xd7, 0x75, 0x28, 0x89, 0xec, 0xf6, 0x64, 0x22, 0x85, 0x24, 0xfe, 0xbd, 0x06, 0x2b,
-	0x33, 0x45, 0x10, 0x3d, 0x81, 0xea, 0xf9, 0xd8, 0xb6, 0x89, 0xd5, 0xbf, 0xd5, 0x1d, 0x53, 0xc1,
-	0xe8, 0x2b, 0xa8, 0xb9, 0x81, 0xe3, 0x8c, 0x9d, 0xb3, 0xb0, 0x6a, 0x5f, 0x2f, 0x9c, 0x40, 0xe3,
-	0x16, 0xbf, 0xfb, 0x6c, 0x7a, 0x8a, 0x0e, 0x1f, 0x59, 0xaa, 0x29, 0x96, 0x32, 0xdf, 0x4c, 0x4d,
-	0x7f, 0xd4, 0x9b, 0x92, 0x41, 0xd8, 0x23, 0x43, 0x1a, 0xff, 0x45, 0x83, 0x72, 0x38, 0x80, 0xcd,
-	0xab, 0xd5, 0xb2, 0xf6, 0xe6, 0xb3, 0x6b, 0x6f, 0x62, 0xf4, 0x6e, 0x42, 0x79, 0x18, 0xd8, 0x36,
-	0x0f, 0x83, 0xa8, 0x56, 0x11, 0xad, 0x7a, 0xb6, 0x98, 0xf0, 0x2c, 0x7a, 0x08, 0x45, 0xd6, 0xb4,
-	0x3d, 0x7d, 0x91, 0x3b, 0x20, 0x73, 0x38, 0x14, 0x08, 0xfc, 0x84, 0x57, 0x37, 0x69, 0x34, 0xf3,
-	0x7f, 0x24, 0xab, 0xdd, 0x28, 0xfb, 0x21, 0x7c, 0x70, 0x44, 0xfc, 0x37, 0xa9, 0x89, 0x3b, 0x2a,
-	0x9c, 0xcf, 0x60, 0x2d, 0xbd, 0x16, 0x7a, 0xc5, 0x25, 0x53, 0x1a, 0x7a, 0x85, 0xfd, 0xe6, 0xe9,
-	0x16, 0x4e, 0xf2, 0xd2, 0xa5, 0x21, 0x8d, 0xbf, 0x81, 0xcd, 0xec, 0x6d, 0xd8, 0x71, 0x8f, 0x61,
-	0x25, 0x3d, 0xf2, 0x67, 0x8d, 0x11, 0x59, 0x07, 0x31, 0x66, 0x25, 0x31, 0x82, 0xc6, 0x8b, 0xb1,
-	0xc7, 0x1a, 0x39, 0x8d, 0xec, 0x78, 0x0c, 0x65, 0x46, 0xcf, 0x8d, 0xa8, 0x0e, 0x25, 0x8b, 0x0c,
-	0xcd, 0xc0, 0xf6, 0x65, 0x59, 0x0c, 0x49, 0xfc, 0x23, 0xa8, 0x2b, 0xda, 0x42, 0xef, 0x32, 0x2a,
-	0xcb, 0xbb, 0x72, 0x0f, 0x43, 0x20, 0xf0, 0x3d, 0xa8, 0xef, 0x5b, 0x16, 0xe3, 0x86, 0xd9, 0x98,
-	0xb1, 0x39, 0xfe, 0x1c, 0x6a, 0x11, 0x4a, 0xbe, 0x34, 0x89, 0xeb, 0x52, 0xb7, 0xe7, 0xbb, 0x63,
-	0xe7, 0x2c, 0x7c, 0x69, 0x2a, 0x2c, 0xfc, 0x10, 0x56, 0x0c, 0x32, 0xa1, 0x17, 0x44, 0x55, 0xbd,
-	0x06, 0xc5, 0xb1, 0x63, 0x91, 0x77, 0xe1, 0xa7, 0x0b, 0x4e, 0xe0, 0x0e, 0x2c, 0xab, 0x50, 0x39,
-	0x5c, 0x53, 0xd1, 0x90, 0xca, 0x46, 0x9e, 0x9e, 0xb3, 0x61, 0xd5, 0x21, 0x97, 0x87, 0xc2, 0x60,
-	0x06, 0x93, 0xe1, 0x4b, 0x71, 0xf1, 0xa7, 0xb0, 0x6a, 0x90, 0xa1, 0x4b, 0xbc, 0x91, 0xea, 0xdb,
-	0x39, 0xfb, 0xfe, 0x80, 0x1d, 0x51, 0x05, 0xdf, 0xce, 0xb2, 0xef, 0xc2, 0x9d, 0x1e, 0xf1, 0x95,
-	0x5d, 0xaf, 0xdf, 0xe5, 0x4b, 0x58, 0x4d, 0xc3, 0x6f, 0xb5, 0xcf, 0xde, 0xb7, 0x35, 0x28, 0xc9,
-	0xc7, 0x07, 0x6a, 0x41, 0xb5, 0xef, 0x9a, 0x83, 0x73, 0xf1, 0x19, 0x0b, 0xe9, 0x33, 0x5f, 0xb6,
-	0xe4, 0x19, 0x9a, 0xeb, 0x19, 0x2b, 0x6c, 0xc2, 0xcb, 0x7d, 0xae, 0xa1, 0xaf, 0xa1, 0x91, 0xfe,
-	0x3a, 0x83, 0xb0, 0x82, 0x9f, 0xf3, 0xf1, 0xa9, 0xb9, 0x73, 0x2d, 0x86, 0x6b, 0x47, 0x07, 0x50,
-	0x0e, 0xbf, 0x5e, 0x20, 0xf5, 0x81, 0x99, 0xfa, 0xfa, 0xd1, 0xd4, 0x33, 0xd7, 0x84, 0x8e, 0x37,
-	0xbc, 0x32, 0xaa, 0x9f, 0x35, 0xd0, 0x47, 0xc9, 0xad, 0x33, 0x3e, 0x86, 0x34, 0xef, 0x5e, 0x07,
-	0x11, 0x8a, 0xfb, 0x50, 0x4f, 0x3e, 0xce, 0x91, 0x6a, 0x52, 0xe6, 0xe3, 0xbf, 0xb9, 0x7d, 0x0d,
-	0x22, 0xd2, 0x9a, 0xdc, 0x0f, 0xed, 0xcc, 0x3d, 0x4a, 0x96, 0xd6, 0x8c, 0x07, 0x3b, 0xce, 0xa1,
-	0x5f, 0x01, 0x9a, 0x7d, 0x71, 0xa2, 0x7b, 0xb7, 0x79, 0x1d, 0x37, 0xf1, 0x0d, 0x28, 0xb1, 0xc3,
-	0x2f, 0x61, 0x65, 0xe6, 0xad, 0x84, 0xbe, 0xa3, 0x88, 0xce, 0x7b, 0x83, 0x36, 0x3f, 0xba, 0x1e,
-	0x14, 0x19, 0x30, 0xfb, 0x64, 0x49, 0x18, 0x30, 0xf7, 0x71, 0x94, 0x30, 0x60, 0xce, 0xbb, 0x47,
-	0xe4, 0x5a, 0x38, 0x2a, 0x27, 0x72, 0x2d, 0x35, 0x52, 0x27, 0x72, 0x2d, 0x31, 0x5b, 0xe3, 0x1c,
-	0x7a, 0x0a, 0x25, 0xc9, 0x42, 0x9b, 0xb3, 0xb0, 0x50, 0xc3, 0x46, 0xd6, 0x92, 0x50, 0x70, 0x02,
-	0x35, 0x75, 0xaa, 0x40, 0xdb, 0x73, 0xdf, 0x5c, 0x42, 0xd5, 0xb5, 0x6f, 0xb2, 0xc8, 0x28, 0xde,
-	0x21, 0xd3, 0x46, 0xa9, 0xb3, 0x42, 0xda, 0xa8, 0xb8, 0xa5, 0xe2, 0x1c, 0x1a, 0xf2, 0xef, 0x82,
-	0x33, 0x2d, 0x0c, 0xdd, 0x4f, 0xca, 0xcc, 0x6b, 0xa5, 0xcd, 0x7b, 0x37, 0xe2, 0xc4, 0x3e, 0x6d,
-	0xa8, 0x44, 0x0d, 0x07, 0x7d, 0xa0, 0x08, 0xa5, 0x9b, 0x5a, 0x73, 0x33, 0x7b, 0x31, 0x8a, 0x81,
-	0x6c, 0x2a, 0x89, 0x18, 0x24, 0xdb, 0x51, 0x22, 0x06, 0x6a, 0x0f, 0xc2, 0x39, 0xf4, 0x1c, 0x20,
-	0x6e, 0x1c, 0x68, 0x2b, 0xd1, 0xe5, 0x52, 0xad, 0xa7, 0xd9, 0x9c, 0xb3, 0x1a, 0x45, 0x53, 0x6d,
-	0x05, 0x89, 0x68, 0x66, 0x34, 0x94, 0xe6, 0xd6, 0xdc, 0xf5, 0xa8, 0x36, 0x24, 0x8b, 0x7e, 0xa2,
-	0x36, 0x64, 0xb6, 0x8f, 0x44, 0x6d, 0xc8, 0xe8, 0x18, 0x38, 0x77, 0xf0, 0xf8, 0xaf, 0xef, 0xb7,
-	0xb5, 0x7f, 0xbc, 0xdf, 0xd6, 0xfe, 0xf5, 0x7e, 0x5b, 0xfb, 0xe3, 0xbf, 0xb7, 0x73, 0x80, 0x07,
-	0xa3, 0xdd, 0x01, 0x71, 0x9d, 0x5d, 0xd3, 0x1e, 0x0f, 0xc8, 0x2e, 0xdd, 0xdb, 0x0d, 0x35, 0xb8,
-	0xd3, 0x81, 0x47, 0xdc, 0x0b, 0xe2, 0x7e, 0x9d, 0x9f, 0x9e, 0x9e, 0x2e, 0xf2, 0x7f, 0x72, 0xbe,
-	0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x51, 0xba, 0xac, 0xe3, 0x19, 0x00, 0x00,
+func (m *RepoInfo) GetDefault() bool {
+	if m != nil {
+		return m.Default
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ControlClient is the client API for Control service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ControlClient interface {
-	TrackStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (Control_TrackStatusClient, error)
-	GetFrameworkInfo(ctx context.Context, in *GetFrameworkInfoRequest, opts ...grpc.CallOption) (*GetFrameworkInfoReply, error)
-	Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownReply, error)
-	GetEnvironments(ctx context.Context, in *GetEnvironmentsRequest, opts ...grpc.CallOption) (*GetEnvironmentsReply, error)
-	NewEnvironment(ctx context.Context, in *NewEnvironmentRequest, opts ...grpc.CallOption) (*NewEnvironmentReply, error)
-	GetEnvironment(ctx context.Context, in *GetEnvironmentRequest, opts ...grpc.CallOption) (*GetEnvironmentReply, error)
-	ControlEnvironment(ctx context.Context, in *ControlEnvironmentRequest, opts ...grpc.CallOption) (*ControlEnvironmentReply, error)
-	ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error)
-	DestroyEnvironment(ctx context.Context, in *DestroyEnvironmentRequest, opts ...grpc.CallOption) (*DestroyEnvironmentReply, error)
-	GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksReply, error)
-	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskReply, error)
-	CleanupTasks(ctx context.Context, in *CleanupTasksRequest, opts ...grpc.CallOption) (*CleanupTasksReply, error)
-	GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error)
-	GetWorkflowTemplates(ctx context.Context, in *GetWorkflowTemplatesRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatesReply, error)
-	ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposReply, error)
-	AddRepo(ctx context.Context, in *AddRepoRequest, opts ...grpc.CallOption) (*AddRepoReply, error)
-	RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (*RemoveRepoReply, error)
-	RefreshRepos(ctx context.Context, in *RefreshReposRequest, opts ...grpc.CallOption) (*RefreshReposReply, error)
-	SetDefaultRepo(ctx context.Context, in *SetDefaultRepoRequest, opts ...grpc.CallOption) (*SetDefaultRepoReply, error)
+type ListReposReply struct {
+	Repos                []*RepoInfo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-type controlClient struct {
-	cc *grpc.ClientConn
+func (m *ListReposReply) Reset()         { *m = ListReposReply{} }
+func (m *ListReposReply) String() string { return proto.CompactTextString(m) }
+func (*ListReposReply) ProtoMessage()    {}
+func (*ListReposReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{59}
 }
-
-func NewControlClient(cc *grpc.ClientConn) ControlClient {
-	return &controlClient{cc}
+func (m *ListReposReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *controlClient) TrackStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (Control_TrackStatusClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Control_serviceDesc.Streams[0], "/o2control.Control/TrackStatus", opts...)
-	if err != nil {
-		return nil, err
+func (m *ListReposReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListReposReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &controlTrackStatusClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
 }
-
-type Control_TrackStatusClient interface {
-	Recv() (*StatusReply, error)
-	grpc.ClientStream
+func (m *ListReposReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReposReply.Merge(m, src)
 }
-
-type controlTrackStatusClient struct {
-	grpc.ClientStream
+func (m *ListReposReply) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *controlTrackStatusClient) Recv() (*StatusReply, error) {
-	m := new(StatusReply)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *ListReposReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReposReply.DiscardUnknown(m)
 }
 
-func (c *controlClient) GetFrameworkInfo(ctx context.Context, in *GetFrameworkInfoRequest, opts ...grpc.CallOption) (*GetFrameworkInfoReply, error) {
-	out := new(GetFrameworkInfoReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/GetFrameworkInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_ListReposReply proto.InternalMessageInfo
 
-func (c *controlClient) Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownReply, error) {
-	out := new(TeardownReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/Teardown", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListReposReply) GetRepos() []*RepoInfo {
+	if m != nil {
+		return m.Repos
 	}
-	return out, nil
+	return nil
 }
 
-func (c *controlClient) GetEnvironments(ctx context.Context, in *GetEnvironmentsRequest, opts ...grpc.CallOption) (*GetEnvironmentsReply, error) {
-	out := new(GetEnvironmentsReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/GetEnvironments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AddRepoRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *controlClient) NewEnvironment(ctx context.Context, in *NewEnvironmentRequest, opts ...grpc.CallOption) (*NewEnvironmentReply, error) {
-	out := new(NewEnvironmentReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/NewEnvironment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddRepoRequest) Reset()         { *m = AddRepoRequest{} }
+func (m *AddRepoRequest) String() string { return proto.CompactTextString(m) }
+func (*AddRepoRequest) ProtoMessage()    {}
+func (*AddRepoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{60}
 }
-
-func (c *controlClient) GetEnvironment(ctx context.Context, in *GetEnvironmentRequest, opts ...grpc.CallOption) (*GetEnvironmentReply, error) {
-	out := new(GetEnvironmentReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/GetEnvironment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddRepoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *controlClient) ControlEnvironment(ctx context.Context, in *ControlEnvironmentRequest, opts ...grpc.CallOption) (*ControlEnvironmentReply, error) {
-	out := new(ControlEnvironmentReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/ControlEnvironment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRepoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *controlClient) ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error) {
-	out := new(ModifyEnvironmentReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/ModifyEnvironment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddRepoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRepoRequest.Merge(m, src)
 }
-
-func (c *controlClient) DestroyEnvironment(ctx context.Context, in *DestroyEnvironmentRequest, opts ...grpc.CallOption) (*DestroyEnvironmentReply, error) {
-	out := new(DestroyEnvironmentReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/DestroyEnvironment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddRepoRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *controlClient) GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksReply, error) {
-	out := new(GetTasksReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/GetTasks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddRepoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRepoRequest.DiscardUnknown(m)
 }
 
-func (c *controlClient) GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskReply, error) {
-	out := new(GetTaskReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/GetTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddRepoRequest proto.InternalMessageInfo
+
+func (m *AddRepoRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *controlClient) CleanupTasks(ctx context.Context, in *CleanupTasksRequest, opts ...grpc.CallOption) (*CleanupTasksReply, error) {
-	out := new(CleanupTasksReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/CleanupTasks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AddRepoReply struct {
+	ErrorString          string   `protobuf:"bytes,1,opt,name=errorString,proto3" json:"errorString,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *controlClient) GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error) {
-	out := new(GetRolesReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/GetRoles", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddRepoReply) Reset()         { *m = AddRepoReply{} }
+func (m *AddRepoReply) String() string { return proto.CompactTextString(m) }
+func (*AddRepoReply) ProtoMessage()    {}
+func (*AddRepoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{61}
+}
+func (m *AddRepoReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRepoReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AddRepoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRepoReply.Merge(m, src)
+}
+func (m *AddRepoReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddRepoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRepoReply.DiscardUnknown(m)
 }
 
-func (c *controlClient) GetWorkflowTemplates(ctx context.Context, in *GetWorkflowTemplatesRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatesReply, error) {
-	out := new(GetWorkflowTemplatesReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/GetWorkflowTemplates", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddRepoReply proto.InternalMessageInfo
+
+func (m *AddRepoReply) GetErrorString() string {
+	if m != nil {
+		return m.ErrorString
 	}
-	return out, nil
+	return ""
 }
 
-func (c *controlClient) ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposReply, error) {
-	out := new(ListReposReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/ListRepos", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RemoveRepoRequest struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *controlClient) AddRepo(ctx context.Context, in *AddRepoRequest, opts ...grpc.CallOption) (*AddRepoReply, error) {
-	out := new(AddRepoReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/AddRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveRepoRequest) Reset()         { *m = RemoveRepoRequest{} }
+func (m *RemoveRepoRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveRepoRequest) ProtoMessage()    {}
+func (*RemoveRepoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{62}
+}
+func (m *RemoveRepoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveRepoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RemoveRepoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRepoRequest.Merge(m, src)
+}
+func (m *RemoveRepoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveRepoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRepoRequest.DiscardUnknown(m)
 }
 
-func (c *controlClient) RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (*RemoveRepoReply, error) {
-	out := new(RemoveRepoReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/RemoveRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveRepoRequest proto.InternalMessageInfo
+
+func (m *RemoveRepoRequest) GetIndex() int32 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-func (c *controlClient) RefreshRepos(ctx context.Context, in *RefreshReposRequest, opts ...grpc.CallOption) (*RefreshReposReply, error) {
-	out := new(RefreshReposReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/RefreshRepos", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RemoveRepoReply struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	NewDefaultRepo       string   `protobuf:"bytes,2,opt,name=newDefaultRepo,proto3" json:"newDefaultRepo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *controlClient) SetDefaultRepo(ctx context.Context, in *SetDefaultRepoRequest, opts ...grpc.CallOption) (*SetDefaultRepoReply, error) {
-	out := new(SetDefaultRepoReply)
-	err := c.cc.Invoke(ctx, "/o2control.Control/SetDefaultRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveRepoReply) Reset()         { *m = RemoveRepoReply{} }
+func (m *RemoveRepoReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveRepoReply) ProtoMessage()    {}
+func (*RemoveRepoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{63}
+}
+func (m *RemoveRepoReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveRepoReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// ControlServer is the server API for Control service.
-type ControlServer interface {
-	TrackStatus(*StatusRequest, Control_TrackStatusServer) error
-	GetFrameworkInfo(context.Context, *GetFrameworkInfoRequest) (*GetFrameworkInfoReply, error)
-	Teardown(context.Context, *TeardownRequest) (*TeardownReply, error)
-	GetEnvironments(context.Context, *GetEnvironmentsRequest) (*GetEnvironmentsReply, error)
-	NewEnvironment(context.Context, *NewEnvironmentRequest) (*NewEnvironmentReply, error)
-	GetEnvironment(context.Context, *GetEnvironmentRequest) (*GetEnvironmentReply, error)
-	ControlEnvironment(context.Context, *ControlEnvironmentRequest) (*ControlEnvironmentReply, error)
-	ModifyEnvironment(context.Context, *ModifyEnvironmentRequest) (*ModifyEnvironmentReply, error)
-	DestroyEnvironment(context.Context, *DestroyEnvironmentRequest) (*DestroyEnvironmentReply, error)
-	GetTasks(context.Context, *GetTasksRequest) (*GetTasksReply, error)
-	GetTask(context.Context, *GetTaskRequest) (*GetTaskReply, error)
-	CleanupTasks(context.Context, *CleanupTasksRequest) (*CleanupTasksReply, error)
-	GetRoles(context.Context, *GetRolesRequest) (*GetRolesReply, error)
-	GetWorkflowTemplates(context.Context, *GetWorkflowTemplatesRequest) (*GetWorkflowTemplatesReply, error)
-	ListRepos(context.Context, *ListReposRequest) (*ListReposReply, error)
-	AddRepo(context.Context, *AddRepoRequest) (*AddRepoReply, error)
-	RemoveRepo(context.Context, *RemoveRepoRequest) (*RemoveRepoReply, error)
-	RefreshRepos(context.Context, *RefreshReposRequest) (*RefreshReposReply, error)
-	SetDefaultRepo(context.Context, *SetDefaultRepoRequest) (*SetDefaultRepoReply, error)
+func (m *RemoveRepoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRepoReply.Merge(m, src)
 }
-
-func RegisterControlServer(s *grpc.Server, srv ControlServer) {
-	s.RegisterService(&_Control_serviceDesc, srv)
+func (m *RemoveRepoReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveRepoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRepoReply.DiscardUnknown(m)
 }
 
-func _Control_TrackStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StatusRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_RemoveRepoReply proto.InternalMessageInfo
+
+func (m *RemoveRepoReply) GetOk() bool {
+	if m != nil {
+		return m.Ok
 	}
-	return srv.(ControlServer).TrackStatus(m, &controlTrackStatusServer{stream})
+	return false
 }
 
-type Control_TrackStatusServer interface {
-	Send(*StatusReply) error
-	grpc.ServerStream
+func (m *RemoveRepoReply) GetNewDefaultRepo() string {
+	if m != nil {
+		return m.NewDefaultRepo
+	}
+	return ""
 }
 
-type controlTrackStatusServer struct {
-	grpc.ServerStream
+type RefreshReposRequest struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *controlTrackStatusServer) Send(m *StatusReply) error {
-	return x.ServerStream.SendMsg(m)
+func (m *RefreshReposRequest) Reset()         { *m = RefreshReposRequest{} }
+func (m *RefreshReposRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshReposRequest) ProtoMessage()    {}
+func (*RefreshReposRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{64}
 }
-
-func _Control_GetFrameworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFrameworkInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).GetFrameworkInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/GetFrameworkInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).GetFrameworkInfo(ctx, req.(*GetFrameworkInfoRequest))
+func (m *RefreshReposRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshReposRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshReposRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RefreshReposRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshReposRequest.Merge(m, src)
+}
+func (m *RefreshReposRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshReposRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshReposRequest.DiscardUnknown(m)
 }
 
-func _Control_Teardown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TeardownRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).Teardown(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/Teardown",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).Teardown(ctx, req.(*TeardownRequest))
+var xxx_messageInfo_RefreshReposRequest proto.InternalMessageInfo
+
+func (m *RefreshReposRequest) GetIndex() int32 {
+	if m != nil {
+		return m.Index
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Control_GetEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEnvironmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).GetEnvironments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/GetEnvironments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).GetEnvironments(ctx, req.(*GetEnvironmentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type RefreshReposReply struct {
+	ErrorString          string   `protobuf:"bytes,1,opt,name=errorString,proto3" json:"errorString,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _Control_NewEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewEnvironmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).NewEnvironment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/NewEnvironment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).NewEnvironment(ctx, req.(*NewEnvironmentRequest))
+func (m *RefreshReposReply) Reset()         { *m = RefreshReposReply{} }
+func (m *RefreshReposReply) String() string { return proto.CompactTextString(m) }
+func (*RefreshReposReply) ProtoMessage()    {}
+func (*RefreshReposReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{65}
+}
+func (m *RefreshReposReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshReposReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshReposReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RefreshReposReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshReposReply.Merge(m, src)
+}
+func (m *RefreshReposReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshReposReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshReposReply.DiscardUnknown(m)
 }
 
-func _Control_GetEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEnvironmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).GetEnvironment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/GetEnvironment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).GetEnvironment(ctx, req.(*GetEnvironmentRequest))
+var xxx_messageInfo_RefreshReposReply proto.InternalMessageInfo
+
+func (m *RefreshReposReply) GetErrorString() string {
+	if m != nil {
+		return m.ErrorString
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Control_ControlEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ControlEnvironmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).ControlEnvironment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/ControlEnvironment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).ControlEnvironment(ctx, req.(*ControlEnvironmentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SetDefaultRepoRequest struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _Control_ModifyEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyEnvironmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).ModifyEnvironment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/ModifyEnvironment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).ModifyEnvironment(ctx, req.(*ModifyEnvironmentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SetDefaultRepoRequest) Reset()         { *m = SetDefaultRepoRequest{} }
+func (m *SetDefaultRepoRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDefaultRepoRequest) ProtoMessage()    {}
+func (*SetDefaultRepoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{66}
 }
-
-func _Control_DestroyEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DestroyEnvironmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).DestroyEnvironment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/DestroyEnvironment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).DestroyEnvironment(ctx, req.(*DestroyEnvironmentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SetDefaultRepoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Control_GetTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTasksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).GetTasks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/GetTasks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).GetTasks(ctx, req.(*GetTasksRequest))
+func (m *SetDefaultRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDefaultRepoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Control_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).GetTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/GetTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).GetTask(ctx, req.(*GetTaskRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SetDefaultRepoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDefaultRepoRequest.Merge(m, src)
 }
-
-func _Control_CleanupTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CleanupTasksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).CleanupTasks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/CleanupTasks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).CleanupTasks(ctx, req.(*CleanupTasksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SetDefaultRepoRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Control_GetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRolesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).GetRoles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/GetRoles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).GetRoles(ctx, req.(*GetRolesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SetDefaultRepoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDefaultRepoRequest.DiscardUnknown(m)
 }
 
-func _Control_GetWorkflowTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkflowTemplatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).GetWorkflowTemplates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/GetWorkflowTemplates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).GetWorkflowTemplates(ctx, req.(*GetWorkflowTemplatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_SetDefaultRepoRequest proto.InternalMessageInfo
 
-func _Control_ListRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReposRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).ListRepos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/ListRepos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).ListRepos(ctx, req.(*ListReposRequest))
+func (m *SetDefaultRepoRequest) GetIndex() int32 {
+	if m != nil {
+		return m.Index
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Control_AddRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).AddRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/AddRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).AddRepo(ctx, req.(*AddRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SetDefaultRepoReply struct {
+	ErrorString          string   `protobuf:"bytes,1,opt,name=errorString,proto3" json:"errorString,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _Control_RemoveRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).RemoveRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/RemoveRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).RemoveRepo(ctx, req.(*RemoveRepoRequest))
+func (m *SetDefaultRepoReply) Reset()         { *m = SetDefaultRepoReply{} }
+func (m *SetDefaultRepoReply) String() string { return proto.CompactTextString(m) }
+func (*SetDefaultRepoReply) ProtoMessage()    {}
+func (*SetDefaultRepoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{67}
+}
+func (m *SetDefaultRepoReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDefaultRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDefaultRepoReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *SetDefaultRepoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDefaultRepoReply.Merge(m, src)
+}
+func (m *SetDefaultRepoReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDefaultRepoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDefaultRepoReply.DiscardUnknown(m)
 }
 
-func _Control_RefreshRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshReposRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).RefreshRepos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/RefreshRepos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).RefreshRepos(ctx, req.(*RefreshReposRequest))
+var xxx_messageInfo_SetDefaultRepoReply proto.InternalMessageInfo
+
+func (m *SetDefaultRepoReply) GetErrorString() string {
+	if m != nil {
+		return m.ErrorString
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Control_SetDefaultRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDefaultRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).SetDefaultRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/o2control.Control/SetDefaultRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).SetDefaultRepo(ctx, req.(*SetDefaultRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterEnum("o2control.StatusUpdate_Level", StatusUpdate_Level_name, StatusUpdate_Level_value)
+	proto.RegisterEnum("o2control.ControlEnvironmentRequest_Optype", ControlEnvironmentRequest_Optype_name, ControlEnvironmentRequest_Optype_value)
+	proto.RegisterEnum("o2control.EnvironmentOperation_Optype", EnvironmentOperation_Optype_name, EnvironmentOperation_Optype_value)
+	proto.RegisterType((*Event_MesosHeartbeat)(nil), "o2control.Event_MesosHeartbeat")
+	proto.RegisterType((*StatusRequest)(nil), "o2control.StatusRequest")
+	proto.RegisterType((*StatusReply)(nil), "o2control.StatusReply")
+	proto.RegisterType((*StatusUpdate)(nil), "o2control.StatusUpdate")
+	proto.RegisterType((*GetFrameworkInfoRequest)(nil), "o2control.GetFrameworkInfoRequest")
+	proto.RegisterType((*Version)(nil), "o2control.Version")
+	proto.RegisterType((*GetFrameworkInfoReply)(nil), "o2control.GetFrameworkInfoReply")
+	proto.RegisterType((*TeardownRequest)(nil), "o2control.TeardownRequest")
+	proto.RegisterType((*TeardownReply)(nil), "o2control.TeardownReply")
+	proto.RegisterType((*GetEnvironmentsRequest)(nil), "o2control.GetEnvironmentsRequest")
+	proto.RegisterType((*GetEnvironmentsReply)(nil), "o2control.GetEnvironmentsReply")
+	proto.RegisterType((*EnvironmentInfo)(nil), "o2control.EnvironmentInfo")
+	proto.RegisterType((*NewEnvironmentRequest)(nil), "o2control.NewEnvironmentRequest")
+	proto.RegisterMapType((map[string]string)(nil), "o2control.NewEnvironmentRequest.VarsEntry")
+	proto.RegisterType((*NewEnvironmentReply)(nil), "o2control.NewEnvironmentReply")
+	proto.RegisterType((*GetEnvironmentRequest)(nil), "o2control.GetEnvironmentRequest")
+	proto.RegisterType((*GetEnvironmentReply)(nil), "o2control.GetEnvironmentReply")
+	proto.RegisterType((*EnvironmentEvent)(nil), "o2control.EnvironmentEvent")
+	proto.RegisterType((*ControlEnvironmentRequest)(nil), "o2control.ControlEnvironmentRequest")
+	proto.RegisterType((*ControlEnvironmentReply)(nil), "o2control.ControlEnvironmentReply")
+	proto.RegisterType((*ModifyEnvironmentRequest)(nil), "o2control.ModifyEnvironmentRequest")
+	proto.RegisterType((*EnvironmentOperation)(nil), "o2control.EnvironmentOperation")
+	proto.RegisterType((*IteratorRange)(nil), "o2control.IteratorRange")
+	proto.RegisterType((*ModifyEnvironmentReply)(nil), "o2control.ModifyEnvironmentReply")
+	proto.RegisterType((*DestroyEnvironmentRequest)(nil), "o2control.DestroyEnvironmentRequest")
+	proto.RegisterType((*DestroyEnvironmentReply)(nil), "o2control.DestroyEnvironmentReply")
+	proto.RegisterType((*DeploymentFailure)(nil), "o2control.DeploymentFailure")
+	proto.RegisterType((*DescriptorExplanation)(nil), "o2control.DescriptorExplanation")
+	proto.RegisterType((*OfferRejection)(nil), "o2control.OfferRejection")
+	proto.RegisterType((*ShortTaskInfo)(nil), "o2control.ShortTaskInfo")
+	proto.RegisterType((*TaskDeploymentInfo)(nil), "o2control.TaskDeploymentInfo")
+	proto.RegisterType((*GetTasksRequest)(nil), "o2control.GetTasksRequest")
+	proto.RegisterType((*GetTasksReply)(nil), "o2control.GetTasksReply")
+	proto.RegisterType((*GetTaskRequest)(nil), "o2control.GetTaskRequest")
+	proto.RegisterType((*GetTaskReply)(nil), "o2control.GetTaskReply")
+	proto.RegisterType((*GetTaskClassRequest)(nil), "o2control.GetTaskClassRequest")
+	proto.RegisterType((*GetTaskClassReply)(nil), "o2control.GetTaskClassReply")
+	proto.RegisterType((*TaskClassInfo)(nil), "o2control.TaskClassInfo")
+	proto.RegisterType((*CustomResourceInfo)(nil), "o2control.CustomResourceInfo")
+	proto.RegisterType((*CommandInfo)(nil), "o2control.CommandInfo")
+	proto.RegisterType((*ChannelInfo)(nil), "o2control.ChannelInfo")
+	proto.RegisterType((*TaskInfo)(nil), "o2control.TaskInfo")
+	proto.RegisterType((*CleanupTasksRequest)(nil), "o2control.CleanupTasksRequest")
+	proto.RegisterType((*CleanupTasksReply)(nil), "o2control.CleanupTasksReply")
+	proto.RegisterType((*GetRolesRequest)(nil), "o2control.GetRolesRequest")
+	proto.RegisterType((*RoleInfo)(nil), "o2control.RoleInfo")
+	proto.RegisterType((*GetRolesReply)(nil), "o2control.GetRolesReply")
+	proto.RegisterType((*GetHostsRequest)(nil), "o2control.GetHostsRequest")
+	proto.RegisterType((*GetHostsReply)(nil), "o2control.GetHostsReply")
+	proto.RegisterType((*GetHostRequest)(nil), "o2control.GetHostRequest")
+	proto.RegisterType((*GetHostReply)(nil), "o2control.GetHostReply")
+	proto.RegisterType((*SetHostStateRequest)(nil), "o2control.SetHostStateRequest")
+	proto.RegisterType((*SetHostStateReply)(nil), "o2control.SetHostStateReply")
+	proto.RegisterType((*HostInfo)(nil), "o2control.HostInfo")
+	proto.RegisterMapType((map[string]string)(nil), "o2control.HostInfo.AttributesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "o2control.HostInfo.ResourcesEntry")
+	proto.RegisterType((*GetWorkflowTemplatesRequest)(nil), "o2control.GetWorkflowTemplatesRequest")
+	proto.RegisterType((*WorkflowTemplateInfo)(nil), "o2control.WorkflowTemplateInfo")
+	proto.RegisterType((*WorkflowParameterInfo)(nil), "o2control.WorkflowParameterInfo")
+	proto.RegisterType((*GetWorkflowTemplatesReply)(nil), "o2control.GetWorkflowTemplatesReply")
+	proto.RegisterType((*ListReposRequest)(nil), "o2control.ListReposRequest")
+	proto.RegisterType((*RepoInfo)(nil), "o2control.RepoInfo")
+	proto.RegisterType((*ListReposReply)(nil), "o2control.ListReposReply")
+	proto.RegisterType((*AddRepoRequest)(nil), "o2control.AddRepoRequest")
+	proto.RegisterType((*AddRepoReply)(nil), "o2control.AddRepoReply")
+	proto.RegisterType((*RemoveRepoRequest)(nil), "o2control.RemoveRepoRequest")
+	proto.RegisterType((*RemoveRepoReply)(nil), "o2control.RemoveRepoReply")
+	proto.RegisterType((*RefreshReposRequest)(nil), "o2control.RefreshReposRequest")
+	proto.RegisterType((*RefreshReposReply)(nil), "o2control.RefreshReposReply")
+	proto.RegisterType((*SetDefaultRepoRequest)(nil), "o2control.SetDefaultRepoRequest")
+	proto.RegisterType((*SetDefaultRepoReply)(nil), "o2control.SetDefaultRepoReply")
 }
 
-var _Control_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "o2control.Control",
-	HandlerType: (*ControlServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetFrameworkInfo",
-			Handler:    _Control_GetFrameworkInfo_Handler,
-		},
-		{
-			MethodName: "Teardown",
-			Handler:    _Control_Teardown_Handler,
-		},
-		{
-			MethodName: "GetEnvironments",
-			Handler:    _Control_GetEnvironments_Handler,
-		},
-		{
-			MethodName: "NewEnvironment",
-			Handler:    _Control_NewEnvironment_Handler,
-		},
-		{
-			MethodName: "GetEnvironment",
-			Handler:    _Control_GetEnvironment_Handler,
-		},
-		{
-			MethodName: "ControlEnvironment",
-			Handler:    _Control_ControlEnvironment_Handler,
-		},
-		{
-			MethodName: "ModifyEnvironment",
-			Handler:    _Control_ModifyEnvironment_Handler,
-		},
-		{
-			MethodName: "DestroyEnvironment",
-			Handler:    _Control_DestroyEnvironment_Handler,
-		},
-		{
-			MethodName: "GetTasks",
-			Handler:    _Control_GetTasks_Handler,
-		},
-		{
-			MethodName: "GetTask",
-			Handler:    _Control_GetTask_Handler,
-		},
-		{
-			MethodName: "CleanupTasks",
-			Handler:    _Control_CleanupTasks_Handler,
-		},
-		{
-			MethodName: "GetRoles",
-			Handler:    _Control_GetRoles_Handler,
-		},
-		{
-			MethodName: "GetWorkflowTemplates",
-			Handler:    _Control_GetWorkflowTemplates_Handler,
-		},
-		{
-			MethodName: "ListRepos",
-			Handler:    _Control_ListRepos_Handler,
-		},
-		{
-			MethodName: "AddRepo",
-			Handler:    _Control_AddRepo_Handler,
-		},
-		{
-			MethodName: "RemoveRepo",
-			Handler:    _Control_RemoveRepo_Handler,
-		},
-		{
-			MethodName: "RefreshRepos",
-			Handler:    _Control_RefreshRepos_Handler,
-		},
-		{
-			MethodName: "SetDefaultRepo",
-			Handler:    _Control_SetDefaultRepo_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "TrackStatus",
-			Handler:       _Control_TrackStatus_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "protos/o2control.proto",
+func init() { proto.RegisterFile("protos/o2control.proto", fileDescriptor_2aa6aa9a1f02efa9) }
+
+var fileDescriptor_2aa6aa9a1f02efa9 = []byte{
+	// 2915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
+	0x11, 0xd6, 0xe2, 0x41, 0x00, 0x0d, 0x12, 0x04, 0x87, 0x12, 0xb9, 0x5c, 0xd3, 0x34, 0x3d, 0x51,
+	0xc9, 0xf2, 0x23, 0xb4, 0x8b, 0x8e, 0x63, 0x45, 0x91, 0x2d, 0x53, 0x24, 0x44, 0x21, 0xb1, 0x08,
+	0xd5, 0x12, 0x96, 0x2a, 0xae, 0x4a, 0x94, 0x25, 0x30, 0x20, 0xd7, 0x5c, 0xec, 0xc2, 0xb3, 0x0b,
+	0x48, 0x3c, 0xb8, 0x72, 0xc9, 0x21, 0x55, 0x79, 0x1d, 0x92, 0x43, 0xee, 0x39, 0xe7, 0x96, 0x9c,
+	0x92, 0x7b, 0x72, 0x49, 0x25, 0x87, 0xfc, 0x80, 0x94, 0x53, 0x95, 0x3f, 0x90, 0x73, 0xaa, 0x52,
+	0xf3, 0xda, 0x9d, 0x5d, 0x2c, 0x48, 0xca, 0xb9, 0xa1, 0x7b, 0xbe, 0xee, 0x99, 0xe9, 0xee, 0xe9,
+	0xee, 0x99, 0x05, 0xac, 0x8c, 0x68, 0x10, 0x05, 0xe1, 0xdb, 0xc1, 0x76, 0x2f, 0xf0, 0x23, 0x1a,
+	0x78, 0x5b, 0x9c, 0x81, 0x6a, 0x31, 0x03, 0xaf, 0xc0, 0xd5, 0xd6, 0x84, 0xf8, 0xd1, 0xd3, 0x87,
+	0x24, 0x0c, 0xc2, 0x07, 0xc4, 0xa1, 0xd1, 0x11, 0x71, 0x22, 0xbc, 0x08, 0x0b, 0x87, 0x91, 0x13,
+	0x8d, 0x43, 0x9b, 0x7c, 0x3e, 0x26, 0x61, 0x84, 0x8f, 0xa0, 0xae, 0x18, 0x23, 0xef, 0x0c, 0x5d,
+	0x85, 0x72, 0x18, 0x39, 0x11, 0x31, 0x8d, 0x4d, 0xe3, 0x66, 0xcd, 0x16, 0x04, 0xfa, 0x00, 0x16,
+	0x42, 0x0e, 0xfa, 0x64, 0xd4, 0x77, 0x22, 0x12, 0x9a, 0x85, 0xcd, 0xe2, 0xcd, 0xfa, 0xf6, 0xea,
+	0x56, 0xb2, 0x82, 0x43, 0x6d, 0xdc, 0x4e, 0xa3, 0xf1, 0x5f, 0x0d, 0x98, 0xd7, 0xc7, 0xd1, 0xbb,
+	0x50, 0xf6, 0xc8, 0x84, 0x78, 0x7c, 0x96, 0xc6, 0xf6, 0xcb, 0x33, 0xf4, 0x6c, 0x7d, 0xcc, 0x40,
+	0xb6, 0xc0, 0xa2, 0x36, 0x34, 0x86, 0xa9, 0xcd, 0x98, 0x85, 0x4d, 0xe3, 0x66, 0x7d, 0xfb, 0x15,
+	0x4d, 0x3a, 0x6f, 0xcf, 0x0f, 0xae, 0xd8, 0x19, 0x41, 0xfc, 0x0d, 0x28, 0x73, 0xd5, 0xa8, 0x06,
+	0xe5, 0xbd, 0xd6, 0xbd, 0x4f, 0xf6, 0x9b, 0x57, 0x50, 0x15, 0x4a, 0xed, 0x83, 0xfb, 0x9d, 0xa6,
+	0x81, 0xea, 0x50, 0x79, 0xb2, 0x63, 0x1f, 0xb4, 0x0f, 0xf6, 0x9b, 0x05, 0x86, 0x68, 0xd9, 0x76,
+	0xc7, 0x6e, 0x16, 0xef, 0x55, 0xa0, 0xcc, 0xf5, 0xe3, 0x35, 0x58, 0xdd, 0x27, 0xd1, 0x7d, 0xea,
+	0x0c, 0xc9, 0xb3, 0x80, 0x9e, 0xb6, 0xfd, 0x41, 0xa0, 0xcc, 0xf9, 0x5b, 0x03, 0x2a, 0x8f, 0x09,
+	0x0d, 0xdd, 0xc0, 0x67, 0xb6, 0x1c, 0x3a, 0x9f, 0x05, 0x94, 0xef, 0xb2, 0x6c, 0x0b, 0x82, 0x73,
+	0x5d, 0x3f, 0xa0, 0x66, 0x41, 0x72, 0x5d, 0x5f, 0x70, 0x47, 0x4e, 0xd4, 0x3b, 0x31, 0x8b, 0x82,
+	0xcb, 0x09, 0xc6, 0x3d, 0x1a, 0xbb, 0x5e, 0xdf, 0x2c, 0x09, 0x6f, 0x70, 0x02, 0x6d, 0x42, 0x7d,
+	0x44, 0x83, 0xfe, 0xb8, 0x17, 0x1d, 0x38, 0x43, 0x62, 0x96, 0xf9, 0x98, 0xce, 0x42, 0x1b, 0x00,
+	0x13, 0xb1, 0x88, 0xc3, 0x88, 0x9a, 0x73, 0x1c, 0xa0, 0x71, 0xf0, 0x2f, 0x0b, 0x70, 0x6d, 0x7a,
+	0x07, 0xcc, 0xff, 0x9b, 0x50, 0x1f, 0xc4, 0xdc, 0xbe, 0x8c, 0x02, 0x9d, 0x85, 0xde, 0x82, 0x25,
+	0xe2, 0x4f, 0x5c, 0x1a, 0xf8, 0x43, 0xe2, 0x47, 0xe1, 0x6e, 0x30, 0xf6, 0x23, 0xb9, 0x97, 0xe9,
+	0x01, 0xb6, 0x92, 0xc8, 0x09, 0x4f, 0x25, 0x4c, 0x6c, 0x4e, 0xe3, 0x24, 0xf1, 0x56, 0xd2, 0xe3,
+	0x6d, 0x03, 0xe0, 0x24, 0x08, 0x95, 0xf2, 0xb2, 0x90, 0x4a, 0x38, 0x08, 0xc3, 0xbc, 0xeb, 0x87,
+	0x91, 0xe3, 0xf7, 0x08, 0x37, 0x81, 0xd8, 0x61, 0x8a, 0x87, 0xde, 0x82, 0x8a, 0xdc, 0xb1, 0x59,
+	0xe1, 0x71, 0x82, 0xb4, 0x38, 0x91, 0x2e, 0xb2, 0x15, 0x04, 0xbf, 0x0e, 0x8b, 0x5d, 0xe2, 0xd0,
+	0x7e, 0xf0, 0xcc, 0x97, 0xae, 0x44, 0x2b, 0x30, 0x47, 0x89, 0x13, 0x06, 0xbe, 0xb4, 0x82, 0xa4,
+	0xd8, 0x11, 0x4a, 0xa0, 0x23, 0xef, 0x0c, 0x9b, 0xb0, 0xb2, 0x4f, 0xa2, 0x96, 0xb6, 0x77, 0x15,
+	0x0d, 0xcf, 0xe1, 0xea, 0xd4, 0xc8, 0xe5, 0xac, 0xfc, 0x21, 0xcc, 0xeb, 0xc6, 0x94, 0x07, 0xce,
+	0xd2, 0x43, 0x3d, 0x19, 0xe6, 0xee, 0x4b, 0xe1, 0xf1, 0x7f, 0x0d, 0x58, 0xcc, 0x20, 0x50, 0x03,
+	0x0a, 0xae, 0x9a, 0xac, 0xe0, 0xf2, 0x38, 0xea, 0x51, 0xe2, 0x44, 0xa4, 0xff, 0xe4, 0x84, 0xf8,
+	0xdc, 0x87, 0x35, 0x5b, 0x67, 0x25, 0xde, 0x29, 0xea, 0xde, 0xd9, 0x82, 0x32, 0xf7, 0xa0, 0x59,
+	0xe2, 0x8b, 0x32, 0xf5, 0xd3, 0x7b, 0x12, 0xd0, 0xa8, 0xeb, 0x84, 0x22, 0xa2, 0x04, 0x0c, 0x59,
+	0x50, 0xa5, 0x41, 0x10, 0xd9, 0x81, 0xa7, 0x82, 0x35, 0xa6, 0xd1, 0x1b, 0xd0, 0xec, 0x8d, 0x29,
+	0x25, 0x7e, 0x64, 0x8f, 0xfd, 0x83, 0xf1, 0xf0, 0x88, 0x88, 0x78, 0x5d, 0xb0, 0xa7, 0xf8, 0x0c,
+	0x3b, 0xf6, 0x9d, 0x89, 0xe3, 0x7a, 0xce, 0x91, 0x47, 0x1e, 0xb0, 0x70, 0x30, 0x2b, 0x9b, 0xc5,
+	0x9b, 0x35, 0x7b, 0x8a, 0x8f, 0xff, 0x64, 0xc0, 0xb5, 0x03, 0xf2, 0x4c, 0x33, 0x81, 0x72, 0xeb,
+	0x1b, 0xd0, 0x64, 0x36, 0x1e, 0x78, 0xc1, 0xb3, 0x2e, 0x19, 0x8e, 0xbc, 0x24, 0xd9, 0x4d, 0xf1,
+	0xd1, 0x87, 0x50, 0x9a, 0x38, 0x54, 0x59, 0xff, 0x0d, 0x6d, 0xa3, 0xb9, 0xba, 0xb7, 0x1e, 0x3b,
+	0x34, 0x6c, 0xf9, 0x11, 0x3d, 0xb3, 0xb9, 0x9c, 0xf5, 0x3e, 0xd4, 0x62, 0x16, 0x6a, 0x42, 0xf1,
+	0x94, 0x9c, 0xc9, 0xb9, 0xd8, 0x4f, 0x66, 0xde, 0x89, 0xe3, 0x8d, 0x89, 0x34, 0xbd, 0x20, 0x6e,
+	0x17, 0x6e, 0x19, 0xf8, 0x10, 0x96, 0xb3, 0x33, 0xb0, 0xb8, 0xb9, 0x03, 0x75, 0xcd, 0xcb, 0x5c,
+	0xd5, 0xf9, 0x41, 0xa1, 0xc3, 0xf1, 0x6b, 0xfc, 0xd0, 0xe7, 0x98, 0x24, 0x13, 0x18, 0xf8, 0x8f,
+	0x06, 0x2c, 0x67, 0x91, 0xff, 0xf7, 0xf4, 0xe8, 0x6d, 0xa8, 0x2a, 0x03, 0xcb, 0xcc, 0xbd, 0xac,
+	0x89, 0xb2, 0x68, 0xe0, 0x32, 0x31, 0x08, 0xbd, 0x07, 0x95, 0x13, 0x37, 0x8c, 0x02, 0x7a, 0x66,
+	0x16, 0xb9, 0x03, 0x5e, 0xca, 0x9f, 0x8a, 0x27, 0x65, 0x5b, 0x61, 0xf1, 0xaf, 0x0d, 0x68, 0x66,
+	0x47, 0xd1, 0x3a, 0xd4, 0x22, 0x77, 0x48, 0xc2, 0xc8, 0x19, 0x8e, 0xe4, 0x4e, 0x13, 0x06, 0x73,
+	0x04, 0x61, 0x30, 0xe5, 0x08, 0x4e, 0x88, 0xb8, 0xf5, 0xc8, 0x23, 0x27, 0x3a, 0x91, 0x07, 0x20,
+	0xa6, 0x59, 0x72, 0x60, 0xc1, 0xdd, 0x56, 0xa9, 0x59, 0x52, 0xc8, 0x84, 0xca, 0x90, 0x84, 0xa1,
+	0x73, 0xac, 0x42, 0x5d, 0x91, 0xf8, 0x6f, 0x06, 0xac, 0xed, 0x8a, 0xc5, 0x5f, 0xec, 0x02, 0x74,
+	0x17, 0x4a, 0xd1, 0xd9, 0x48, 0x44, 0x46, 0x63, 0xfb, 0x4d, 0x6d, 0xe3, 0x33, 0x75, 0x6c, 0x75,
+	0x46, 0x4c, 0xc4, 0xe6, 0x82, 0xd8, 0x81, 0x39, 0x41, 0xb3, 0xc2, 0x76, 0xd0, 0xe9, 0x3c, 0x6a,
+	0x5e, 0x41, 0x08, 0x1a, 0x87, 0xdd, 0x1d, 0xbb, 0xfb, 0x74, 0x67, 0xb7, 0xdb, 0x7e, 0xdc, 0xee,
+	0x7e, 0xaf, 0x69, 0xa0, 0x25, 0x58, 0x38, 0xec, 0x76, 0x1e, 0x25, 0xac, 0x02, 0x5a, 0x80, 0xda,
+	0x6e, 0xe7, 0xe0, 0x7e, 0x7b, 0xff, 0x13, 0xbb, 0xd5, 0x2c, 0xb2, 0x0a, 0x68, 0xb7, 0x0e, 0x5b,
+	0xdd, 0x66, 0x09, 0xcd, 0x43, 0x75, 0xbf, 0xf3, 0x54, 0xd4, 0xc3, 0x32, 0x3e, 0x85, 0xd5, 0xbc,
+	0xc5, 0xb0, 0x48, 0xc9, 0x6e, 0x27, 0x4e, 0x24, 0x05, 0x3d, 0x91, 0xe4, 0x1d, 0xfe, 0x62, 0xfe,
+	0xe1, 0xc7, 0xbf, 0x32, 0xc0, 0x7c, 0x18, 0xf4, 0xdd, 0xc1, 0xd9, 0xa5, 0xac, 0x07, 0xc1, 0x88,
+	0x50, 0x27, 0x72, 0x03, 0x5f, 0x9d, 0xde, 0x57, 0xf2, 0x83, 0xa7, 0xa3, 0x70, 0xb6, 0x26, 0x82,
+	0x6e, 0x40, 0x83, 0x92, 0x5e, 0xe0, 0x0f, 0xdc, 0xe3, 0x31, 0x25, 0x3b, 0x9e, 0xc7, 0xd7, 0x55,
+	0xb5, 0x33, 0x5c, 0xfc, 0x1f, 0x03, 0xae, 0xe6, 0x29, 0x43, 0xb7, 0xa5, 0xff, 0x44, 0x83, 0x73,
+	0xe3, 0x82, 0xb9, 0x53, 0xae, 0x53, 0x71, 0xc7, 0x2b, 0x5b, 0x21, 0x89, 0x3b, 0x46, 0xa3, 0x0f,
+	0x61, 0xc1, 0x8d, 0x98, 0x54, 0x40, 0x6d, 0xc7, 0x3f, 0x16, 0x99, 0x39, 0x9d, 0x83, 0xdb, 0xfa,
+	0xb8, 0x9d, 0x86, 0xe3, 0xdd, 0x9c, 0xb0, 0x58, 0x84, 0xba, 0xdd, 0x7a, 0xd8, 0x79, 0xdc, 0x7a,
+	0x6a, 0x77, 0x3e, 0x66, 0x1e, 0x9f, 0x87, 0xea, 0xce, 0xde, 0x9e, 0xa0, 0x4a, 0xa8, 0x09, 0xf3,
+	0x87, 0xad, 0xee, 0xd3, 0x76, 0xb7, 0x65, 0xef, 0x74, 0xb9, 0xe3, 0x3b, 0xb0, 0x90, 0x9a, 0x84,
+	0xf7, 0x29, 0xe4, 0xd8, 0xf5, 0x55, 0xa7, 0xc3, 0x09, 0x96, 0xf0, 0x88, 0xdf, 0x97, 0xbd, 0x01,
+	0xfb, 0xc9, 0x4e, 0x0d, 0xcf, 0x71, 0x21, 0x3f, 0xd0, 0x35, 0x5b, 0x52, 0xf8, 0xa7, 0x06, 0xac,
+	0xe4, 0x38, 0x97, 0x45, 0xd2, 0x77, 0xa1, 0x39, 0x70, 0x5c, 0x8f, 0xf4, 0x3b, 0x89, 0x43, 0x8d,
+	0xcb, 0x39, 0x74, 0x4a, 0x50, 0xc6, 0x49, 0x61, 0x3a, 0x2c, 0xf5, 0xfa, 0x86, 0xdb, 0xb0, 0xb6,
+	0x47, 0xc2, 0x88, 0x06, 0x97, 0x09, 0xb5, 0x75, 0xa8, 0x9d, 0x12, 0x32, 0xea, 0xf2, 0x82, 0x58,
+	0xe0, 0x41, 0x92, 0x30, 0x30, 0x81, 0xd5, 0x3c, 0x55, 0x6c, 0x63, 0xdf, 0x81, 0xa5, 0x9e, 0x47,
+	0x1c, 0x7f, 0x2c, 0xa0, 0x9c, 0x29, 0x53, 0xea, 0xba, 0x7e, 0xdc, 0xb3, 0x18, 0x7b, 0x5a, 0x0c,
+	0xff, 0x08, 0x96, 0xf6, 0xc8, 0xc8, 0x0b, 0xce, 0x98, 0xfa, 0xfb, 0x8e, 0xeb, 0x8d, 0x29, 0x41,
+	0xf7, 0xa0, 0xde, 0x27, 0x61, 0x8f, 0xba, 0xa3, 0x28, 0xa0, 0xca, 0x68, 0x9b, 0x9a, 0xea, 0xbd,
+	0x78, 0xb4, 0xf5, 0x7c, 0xe4, 0x39, 0xbe, 0xb0, 0x9a, 0x2e, 0xc4, 0xce, 0x41, 0x30, 0x18, 0x10,
+	0x1a, 0xda, 0xa4, 0x47, 0xdc, 0x09, 0x51, 0xde, 0xcc, 0x70, 0xf1, 0xcf, 0x0c, 0xb8, 0x96, 0xab,
+	0x2e, 0x95, 0x44, 0x8d, 0x4c, 0x12, 0x65, 0x49, 0xd9, 0x09, 0x4f, 0x77, 0x3d, 0x27, 0x0c, 0xa5,
+	0x57, 0x12, 0x06, 0xfa, 0x16, 0x00, 0x25, 0x9f, 0x91, 0x9e, 0xf0, 0xb9, 0xa8, 0x00, 0x6b, 0xda,
+	0xf2, 0x3b, 0x6c, 0x09, 0xb6, 0x42, 0xd8, 0x1a, 0x18, 0xff, 0x00, 0x1a, 0xe9, 0x51, 0x96, 0x97,
+	0xf9, 0x92, 0xe3, 0x6e, 0x4b, 0x91, 0x6c, 0x81, 0xac, 0xb3, 0xf4, 0xb5, 0xd3, 0xa6, 0x68, 0xad,
+	0x05, 0x2c, 0xa6, 0x5a, 0xc0, 0x7f, 0x1b, 0xb0, 0x90, 0x6a, 0x75, 0x10, 0x82, 0x12, 0xd7, 0x20,
+	0x94, 0x97, 0x94, 0xb4, 0x17, 0xf4, 0x4e, 0xa5, 0xd1, 0xaa, 0xb6, 0xa4, 0xb4, 0xda, 0x51, 0x4c,
+	0xd5, 0x8e, 0x15, 0x98, 0x13, 0xf7, 0x26, 0x55, 0x53, 0x04, 0x95, 0x44, 0x69, 0x59, 0x4f, 0x9e,
+	0xeb, 0x50, 0xeb, 0x31, 0x3b, 0x69, 0x0d, 0x70, 0xc2, 0x40, 0x2d, 0x68, 0xf4, 0xe3, 0x88, 0x60,
+	0x2b, 0x94, 0x4d, 0xb0, 0x7e, 0xd5, 0x62, 0x8b, 0xdf, 0x4b, 0x81, 0xec, 0x8c, 0x10, 0xfe, 0xb1,
+	0x01, 0x68, 0x1a, 0x96, 0xb2, 0x99, 0x91, 0xb1, 0x99, 0x09, 0x15, 0xe7, 0x98, 0x01, 0xd5, 0x41,
+	0x53, 0xa4, 0xee, 0x83, 0x62, 0xda, 0x07, 0x1b, 0x00, 0xe4, 0x39, 0xe9, 0x8d, 0xa3, 0x80, 0xc6,
+	0x15, 0x55, 0xe3, 0xe0, 0x25, 0x58, 0xdc, 0x27, 0x91, 0x0c, 0x78, 0xd1, 0x5a, 0xdf, 0x85, 0x85,
+	0x84, 0xc5, 0xce, 0x53, 0xdc, 0x95, 0x1a, 0x97, 0xea, 0x4a, 0xf1, 0x4d, 0x68, 0x48, 0x05, 0x5a,
+	0xc3, 0x2f, 0xfd, 0x62, 0xe8, 0x7e, 0xc1, 0xef, 0xc3, 0x7c, 0x8c, 0x64, 0x33, 0xbd, 0x06, 0x25,
+	0x36, 0x62, 0x1a, 0x53, 0x4d, 0x4c, 0x3c, 0x07, 0x07, 0xe0, 0x77, 0x79, 0x1b, 0xd5, 0x55, 0x11,
+	0xad, 0xe6, 0x49, 0x85, 0xbd, 0x91, 0x09, 0x7b, 0xfc, 0x05, 0x2c, 0xa5, 0x85, 0xd8, 0x94, 0x1b,
+	0x00, 0x6e, 0x9f, 0xf8, 0x91, 0x3b, 0x70, 0x09, 0x95, 0x32, 0x1a, 0x87, 0x99, 0x96, 0x3c, 0x8f,
+	0x88, 0xdf, 0x17, 0xd5, 0xae, 0x66, 0x2b, 0x12, 0x6d, 0x01, 0xa2, 0x24, 0x0c, 0xbc, 0x09, 0xe9,
+	0xef, 0x91, 0x81, 0xeb, 0xbb, 0xec, 0x38, 0x48, 0xfb, 0xe7, 0x8c, 0xe0, 0x5f, 0x18, 0xb0, 0x10,
+	0x4f, 0x3e, 0x33, 0xb4, 0xd9, 0xd5, 0x41, 0xec, 0xf9, 0x61, 0xd0, 0x27, 0xf1, 0xd5, 0x21, 0x61,
+	0xa1, 0x7d, 0x58, 0xec, 0x8d, 0xc3, 0x28, 0x18, 0xda, 0x24, 0x0c, 0xc6, 0xb4, 0x47, 0xd4, 0x11,
+	0xd6, 0x23, 0x70, 0x37, 0x85, 0xe0, 0x96, 0xcb, 0x4a, 0x61, 0x1b, 0xd0, 0x34, 0x2c, 0x77, 0x51,
+	0x48, 0xeb, 0x99, 0x6a, 0xb2, 0x96, 0xc6, 0x2d, 0x76, 0x51, 0x6b, 0xb1, 0xf1, 0x17, 0x50, 0xdf,
+	0x0d, 0x86, 0x43, 0xc7, 0xef, 0x73, 0x65, 0xbc, 0x50, 0x4d, 0x78, 0xe0, 0xd4, 0x58, 0xa1, 0x9a,
+	0x30, 0xb1, 0xf0, 0x84, 0x78, 0x9e, 0x3c, 0xb9, 0x82, 0xc8, 0x57, 0xc6, 0xdc, 0xe9, 0xd0, 0xe3,
+	0xb1, 0xb8, 0xa7, 0x95, 0xb8, 0x8e, 0x84, 0xc1, 0x16, 0x35, 0x0e, 0x09, 0x95, 0x67, 0x97, 0xff,
+	0xc6, 0x0f, 0xa1, 0xbe, 0x7b, 0xe2, 0xf8, 0x3e, 0xf1, 0x5e, 0x68, 0x2f, 0x3c, 0x3e, 0xe9, 0x31,
+	0x89, 0x92, 0xbc, 0xc1, 0x28, 0xfc, 0x87, 0x22, 0x54, 0xe3, 0x44, 0xf4, 0x4d, 0xa8, 0x85, 0x2c,
+	0xdc, 0x19, 0x21, 0x23, 0x74, 0xf6, 0x51, 0x48, 0xa0, 0x4c, 0xae, 0xa7, 0x5c, 0x6e, 0x16, 0xa6,
+	0xe4, 0x52, 0x21, 0x61, 0x27, 0x50, 0xf4, 0x11, 0x2c, 0xba, 0xfe, 0x51, 0x30, 0xf6, 0xfb, 0x72,
+	0x4b, 0xca, 0xcf, 0x2b, 0xba, 0x9f, 0x93, 0xdd, 0xda, 0x59, 0x38, 0xba, 0x07, 0xcd, 0x60, 0x1c,
+	0xa5, 0x55, 0x94, 0xce, 0x55, 0x31, 0x85, 0x47, 0xb7, 0x58, 0x3c, 0xc6, 0x0e, 0xe5, 0xc6, 0xce,
+	0x88, 0x27, 0xa3, 0xb6, 0x0e, 0x65, 0xa9, 0x8c, 0x9d, 0x3d, 0x5e, 0x9f, 0x44, 0x16, 0x8d, 0x69,
+	0x7e, 0x2d, 0xf0, 0x27, 0xed, 0xbe, 0x59, 0x91, 0xd7, 0x02, 0x46, 0xe4, 0x45, 0x76, 0xf5, 0x2b,
+	0x45, 0xf6, 0xdb, 0xb0, 0x9c, 0xae, 0xee, 0x22, 0x3d, 0x98, 0x50, 0x11, 0x89, 0x27, 0x94, 0x11,
+	0xa9, 0x48, 0xfc, 0x73, 0x03, 0x96, 0xa6, 0xfa, 0x01, 0x74, 0x1b, 0xea, 0xa7, 0xae, 0xe7, 0x91,
+	0x7e, 0xf7, 0x52, 0xe9, 0x4f, 0x07, 0xa3, 0x3b, 0x30, 0x4f, 0xc7, 0xbe, 0xef, 0xfa, 0xc7, 0xaa,
+	0x81, 0x39, 0x5f, 0x38, 0x85, 0xc6, 0xbb, 0x3c, 0x2d, 0xb3, 0x9b, 0x5b, 0xbc, 0xf8, 0xd8, 0x64,
+	0x86, 0x6e, 0x32, 0x0b, 0xaa, 0x23, 0x27, 0x3a, 0x39, 0x1c, 0x91, 0x9e, 0xaa, 0xb1, 0x8a, 0xc6,
+	0xbf, 0x33, 0xa0, 0xaa, 0x2e, 0x7f, 0xb3, 0xca, 0xa8, 0x2c, 0x8b, 0x85, 0xfc, 0xb2, 0x98, 0x7a,
+	0x9c, 0xb0, 0xa0, 0x3a, 0x18, 0x7b, 0x1e, 0xf7, 0xa7, 0x28, 0x24, 0x31, 0xad, 0x5b, 0xb6, 0x9c,
+	0xb2, 0x2c, 0x7a, 0x1d, 0xca, 0xac, 0x2b, 0x09, 0xcd, 0xb9, 0xcd, 0x62, 0x26, 0xa7, 0xc7, 0x17,
+	0x53, 0x81, 0xc0, 0xb7, 0x79, 0xe1, 0x91, 0x9b, 0x66, 0xf6, 0x8f, 0x65, 0x8d, 0x0b, 0x65, 0x45,
+	0x1d, 0xe3, 0x2f, 0x14, 0xaa, 0x8e, 0x09, 0x75, 0x92, 0x25, 0xd5, 0xf1, 0x97, 0xae, 0x1c, 0x75,
+	0x0c, 0x25, 0xd4, 0x71, 0x04, 0xbe, 0xce, 0x4b, 0x18, 0xe3, 0x2a, 0xf3, 0x23, 0x28, 0xb1, 0x21,
+	0x65, 0x3f, 0xf6, 0x5b, 0x96, 0x2f, 0x81, 0x92, 0xe5, 0x2b, 0xc6, 0xcc, 0xd0, 0x2f, 0x04, 0xef,
+	0xc2, 0xf2, 0xa1, 0x10, 0x64, 0x8f, 0xb2, 0xe4, 0x9c, 0x39, 0xf2, 0xef, 0x77, 0xf8, 0x0e, 0x2c,
+	0xa5, 0x15, 0xbc, 0xd0, 0xf4, 0xff, 0x28, 0x42, 0x55, 0xb1, 0xbe, 0x62, 0xc7, 0xb1, 0x0b, 0xe0,
+	0x44, 0x11, 0x75, 0x8f, 0xc6, 0x51, 0x5c, 0x7f, 0xbe, 0x96, 0x33, 0xe3, 0xd6, 0x4e, 0x8c, 0x12,
+	0xcf, 0x37, 0x9a, 0x18, 0xfa, 0x08, 0x6a, 0x34, 0x3e, 0xe9, 0x22, 0x31, 0xe1, 0x3c, 0x1d, 0xf1,
+	0xc1, 0x16, 0x2a, 0x12, 0x21, 0x56, 0x2d, 0x3d, 0x27, 0x8c, 0x78, 0x4b, 0x4a, 0xfa, 0xea, 0xc1,
+	0x56, 0x63, 0x25, 0xcd, 0xcb, 0xdc, 0xe5, 0x9e, 0xd4, 0x6e, 0x40, 0x43, 0x7b, 0x5a, 0x69, 0xf7,
+	0xd5, 0x43, 0x58, 0x86, 0x9b, 0xf8, 0xa5, 0xaa, 0xf9, 0xc5, 0xfa, 0x00, 0x16, 0x33, 0x1b, 0x7e,
+	0x91, 0xc7, 0x29, 0xeb, 0x0e, 0x34, 0xd2, 0x7b, 0x7d, 0x11, 0x69, 0xfc, 0x32, 0xbc, 0xb4, 0x4f,
+	0xa2, 0x27, 0x99, 0xa7, 0xb6, 0xf8, 0x3c, 0xfc, 0xc4, 0x80, 0xab, 0xd9, 0x41, 0x95, 0x1a, 0x28,
+	0x19, 0x05, 0x2a, 0xec, 0xd8, 0x6f, 0x9e, 0xbc, 0x25, 0x46, 0xe5, 0x15, 0x45, 0xa3, 0x8f, 0x00,
+	0x46, 0x0e, 0x7b, 0x51, 0x8d, 0x08, 0x55, 0xbe, 0xd7, 0x6f, 0x3f, 0x6a, 0x92, 0x47, 0x0a, 0xc4,
+	0xed, 0xab, 0xc9, 0xe0, 0x3f, 0x1b, 0x70, 0x2d, 0x17, 0x75, 0xe9, 0x8a, 0x8d, 0x61, 0xbe, 0x4f,
+	0x06, 0xce, 0xd8, 0x8b, 0x1e, 0x6b, 0x7d, 0x43, 0x8a, 0xc7, 0x2f, 0x48, 0xe4, 0xf3, 0xb1, 0xcb,
+	0x22, 0xa3, 0xc4, 0xbb, 0x8d, 0x98, 0x66, 0x81, 0xa3, 0x6e, 0x63, 0xac, 0x6b, 0x93, 0x81, 0xa3,
+	0xb1, 0xd0, 0x75, 0x58, 0x70, 0x3c, 0x2f, 0x78, 0x46, 0xfa, 0x8f, 0xc5, 0xc5, 0x7a, 0x8e, 0xc7,
+	0x41, 0x9a, 0x89, 0x3f, 0x83, 0xb5, 0x7c, 0x9b, 0xb3, 0x03, 0xf9, 0x10, 0x96, 0xb2, 0x0f, 0x9f,
+	0x79, 0x57, 0xec, 0x3c, 0xa7, 0xd8, 0xd3, 0x92, 0x18, 0x41, 0xf3, 0x63, 0x97, 0xe7, 0x9a, 0x20,
+	0x76, 0xea, 0x2d, 0xa8, 0x32, 0x7a, 0xa6, 0xed, 0x4c, 0xa8, 0x48, 0x9b, 0xc8, 0x86, 0x4b, 0x91,
+	0xf8, 0xdb, 0xd0, 0xd0, 0xb4, 0xa9, 0x74, 0xcb, 0xa8, 0xbc, 0x74, 0x2b, 0xe7, 0xb0, 0x05, 0x82,
+	0xe5, 0xc7, 0x9d, 0x7e, 0x9f, 0x71, 0xb5, 0xdc, 0x95, 0x9d, 0x1c, 0xbf, 0x03, 0xf3, 0x31, 0x4a,
+	0x3e, 0xce, 0x13, 0x4a, 0x03, 0x7a, 0x18, 0x51, 0xd7, 0x3f, 0x96, 0x50, 0x9d, 0x85, 0x5f, 0x87,
+	0x25, 0x9b, 0x0c, 0x83, 0x09, 0xd1, 0x55, 0x5f, 0x85, 0xb2, 0xeb, 0xf7, 0xc9, 0x73, 0xf5, 0x06,
+	0xc2, 0x09, 0xdc, 0x86, 0x45, 0x1d, 0x2a, 0xdf, 0xc6, 0x02, 0x71, 0x79, 0xa8, 0xda, 0x85, 0xe0,
+	0x94, 0x9d, 0x65, 0x9f, 0x3c, 0xdb, 0x13, 0x1b, 0x66, 0x30, 0x19, 0x42, 0x19, 0x2e, 0x7e, 0x13,
+	0x96, 0x6d, 0x32, 0xa0, 0x24, 0x3c, 0xd1, 0x6d, 0x3b, 0x63, 0xde, 0xf7, 0x60, 0x29, 0x0d, 0xbe,
+	0xdc, 0xce, 0xbe, 0x0e, 0xd7, 0x0e, 0x49, 0xa4, 0xcd, 0x7a, 0xfe, 0x2c, 0xef, 0xc3, 0x72, 0x16,
+	0x7e, 0xa9, 0x79, 0xb6, 0x7f, 0xdf, 0x80, 0x8a, 0x7c, 0x3b, 0x44, 0xbb, 0x50, 0xef, 0x52, 0xa7,
+	0x77, 0x2a, 0xbe, 0xfc, 0x21, 0x73, 0xea, 0x63, 0xa0, 0x5c, 0x83, 0xb5, 0x92, 0x33, 0xc2, 0x5e,
+	0x3f, 0xae, 0xbc, 0x63, 0xa0, 0x4f, 0xa1, 0x99, 0xfd, 0xa0, 0x85, 0xf4, 0x2c, 0x3d, 0xe3, 0x7b,
+	0x9d, 0xb5, 0x79, 0x2e, 0x86, 0x6b, 0x47, 0xf7, 0xa0, 0xaa, 0x3e, 0xf8, 0x20, 0xfd, 0xb5, 0x3b,
+	0xf3, 0xc1, 0xc8, 0x32, 0x73, 0xc7, 0x84, 0x8e, 0x27, 0xbc, 0xf2, 0xeb, 0x5f, 0x82, 0xd0, 0xab,
+	0xe9, 0xa9, 0x73, 0xbe, 0x1f, 0x59, 0xaf, 0x9c, 0x07, 0x11, 0x8a, 0xbb, 0xd0, 0x48, 0x7f, 0x29,
+	0x40, 0x9b, 0x17, 0x7d, 0xa6, 0xb0, 0x36, 0xce, 0x41, 0xc4, 0x5a, 0xd3, 0xf3, 0xa1, 0xcd, 0x99,
+	0x4b, 0xc9, 0xd3, 0x9a, 0xf3, 0xf5, 0x00, 0x5f, 0x41, 0x3f, 0x04, 0x34, 0xfd, 0x60, 0x8c, 0xae,
+	0x5f, 0xe6, 0x71, 0xdb, 0xc2, 0x17, 0xa0, 0xc4, 0x0c, 0xdf, 0x87, 0xa5, 0xa9, 0x77, 0x44, 0xa4,
+	0x57, 0xfc, 0x59, 0x4f, 0xc8, 0xd6, 0xab, 0xe7, 0x83, 0xe2, 0x0d, 0x4c, 0x3f, 0xe7, 0xa5, 0x36,
+	0x30, 0xf3, 0xe1, 0xd0, 0xc2, 0x17, 0xa0, 0xe2, 0x58, 0x53, 0xcf, 0x1a, 0xa9, 0x58, 0xcb, 0x3c,
+	0x7f, 0x58, 0x66, 0xee, 0x98, 0xd0, 0x71, 0x17, 0x2a, 0x92, 0x85, 0xd6, 0xa6, 0x61, 0x4a, 0xc3,
+	0x6a, 0xde, 0x90, 0x50, 0x70, 0x10, 0x3f, 0x78, 0x88, 0x97, 0xb8, 0x8d, 0x69, 0xa8, 0xfe, 0xa0,
+	0x61, 0xad, 0xcf, 0x1c, 0x8f, 0xf5, 0xe9, 0xd7, 0x96, 0x94, 0xbe, 0x9c, 0x1b, 0x90, 0x75, 0xee,
+	0xfb, 0x67, 0x6c, 0x24, 0xde, 0x82, 0x67, 0x8d, 0xa4, 0x5f, 0x46, 0x2c, 0x33, 0x77, 0x4c, 0xd7,
+	0xc1, 0xfb, 0xee, 0xac, 0x0e, 0xbd, 0x3f, 0xb7, 0xcc, 0xdc, 0x31, 0xdd, 0xd0, 0x8c, 0x95, 0x35,
+	0xb4, 0xd6, 0x93, 0x5b, 0xab, 0x79, 0x43, 0xb1, 0x61, 0xf4, 0x06, 0x39, 0x65, 0x98, 0x9c, 0xd6,
+	0xdb, 0x5a, 0x9f, 0x39, 0x2e, 0xf4, 0x0d, 0xf8, 0xf7, 0xe6, 0xa9, 0x3a, 0x8f, 0x6e, 0xa4, 0x97,
+	0x30, 0xab, 0xf9, 0xb2, 0xae, 0x5f, 0x88, 0x13, 0xf3, 0xb4, 0xa0, 0x16, 0x57, 0x65, 0xa4, 0x7f,
+	0x95, 0xcb, 0x56, 0x7e, 0x6b, 0x2d, 0x7f, 0x30, 0xb6, 0x9f, 0xac, 0xbc, 0x29, 0xfb, 0xa5, 0x6b,
+	0xb6, 0xb5, 0x9a, 0x37, 0x24, 0x14, 0x3c, 0x00, 0x48, 0xaa, 0x2b, 0x5a, 0x4f, 0xb5, 0x02, 0x99,
+	0xfa, 0x6c, 0x59, 0x33, 0x46, 0x63, 0x4f, 0xe8, 0xf5, 0x32, 0xe5, 0x89, 0x9c, 0xaa, 0x6b, 0xad,
+	0xcf, 0x1c, 0x8f, 0x13, 0x68, 0xba, 0x32, 0xa6, 0x12, 0x68, 0x6e, 0x8d, 0xb5, 0x36, 0xce, 0x41,
+	0x70, 0xad, 0xf7, 0x6e, 0xfd, 0xe5, 0xcb, 0x0d, 0xe3, 0xef, 0x5f, 0x6e, 0x18, 0xff, 0xfc, 0x72,
+	0xc3, 0xf8, 0xcd, 0xbf, 0x36, 0xae, 0x00, 0xee, 0x9d, 0x6c, 0xf5, 0x08, 0xf5, 0xb7, 0x1c, 0xcf,
+	0xed, 0x91, 0xad, 0x60, 0x7b, 0x4b, 0x69, 0xa0, 0xa3, 0x5e, 0x48, 0xe8, 0x84, 0xd0, 0x4f, 0x0b,
+	0xa3, 0xa3, 0xa3, 0x39, 0xfe, 0x0f, 0xa1, 0x77, 0xff, 0x37, 0x00, 0xf6, 0x38, 0xf5, 0xe5, 0x3b,
+	0x24, 0x00, 0x00,
 }
 
-func (m *Event_MesosHeartbeat) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ControlClient is the client API for Control service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ControlClient interface {
+	TrackStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (Control_TrackStatusClient, error)
+	GetFrameworkInfo(ctx context.Context, in *GetFrameworkInfoRequest, opts ...grpc.CallOption) (*GetFrameworkInfoReply, error)
+	Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownReply, error)
+	GetEnvironments(ctx context.Context, in *GetEnvironmentsRequest, opts ...grpc.CallOption) (*GetEnvironmentsReply, error)
+	NewEnvironment(ctx context.Context, in *NewEnvironmentRequest, opts ...grpc.CallOption) (*NewEnvironmentReply, error)
+	GetEnvironment(ctx context.Context, in *GetEnvironmentRequest, opts ...grpc.CallOption) (*GetEnvironmentReply, error)
+	ControlEnvironment(ctx context.Context, in *ControlEnvironmentRequest, opts ...grpc.CallOption) (*ControlEnvironmentReply, error)
+	ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error)
+	DestroyEnvironment(ctx context.Context, in *DestroyEnvironmentRequest, opts ...grpc.CallOption) (*DestroyEnvironmentReply, error)
+	GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksReply, error)
+	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskReply, error)
+	GetTaskClass(ctx context.Context, in *GetTaskClassRequest, opts ...grpc.CallOption) (*GetTaskClassReply, error)
+	CleanupTasks(ctx context.Context, in *CleanupTasksRequest, opts ...grpc.CallOption) (*CleanupTasksReply, error)
+	GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error)
+	GetHosts(ctx context.Context, in *GetHostsRequest, opts ...grpc.CallOption) (*GetHostsReply, error)
+	GetHost(ctx context.Context, in *GetHostRequest, opts ...grpc.CallOption) (*GetHostReply, error)
+	SetHostState(ctx context.Context, in *SetHostStateRequest, opts ...grpc.CallOption) (*SetHostStateReply, error)
+	GetWorkflowTemplates(ctx context.Context, in *GetWorkflowTemplatesRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatesReply, error)
+	ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposReply, error)
+	AddRepo(ctx context.Context, in *AddRepoRequest, opts ...grpc.CallOption) (*AddRepoReply, error)
+	RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (*RemoveRepoReply, error)
+	RefreshRepos(ctx context.Context, in *RefreshReposRequest, opts ...grpc.CallOption) (*RefreshReposReply, error)
+	SetDefaultRepo(ctx context.Context, in *SetDefaultRepoRequest, opts ...grpc.CallOption) (*SetDefaultRepoReply, error)
 }
 
-func (m *Event_MesosHeartbeat) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type controlClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func NewControlClient(cc *grpc.ClientConn) ControlClient {
+	return &controlClient{cc}
+}
+
+func (c *controlClient) TrackStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (Control_TrackStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Control_serviceDesc.Streams[0], "/o2control.Control/TrackStatus", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &controlTrackStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+type Control_TrackStatusClient interface {
+	Recv() (*StatusReply, error)
+	grpc.ClientStream
+}
+
+type controlTrackStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlTrackStatusClient) Recv() (*StatusReply, error) {
+	m := new(StatusReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *StatusReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) GetFrameworkInfo(ctx context.Context, in *GetFrameworkInfoRequest, opts ...grpc.CallOption) (*GetFrameworkInfoReply, error) {
+	out := new(GetFrameworkInfoReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetFrameworkInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StatusReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.State) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
-	}
-	if len(m.StatusUpdates) > 0 {
-		for _, msg := range m.StatusUpdates {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *controlClient) Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownReply, error) {
+	out := new(TeardownReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/Teardown", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *StatusUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) GetEnvironments(ctx context.Context, in *GetEnvironmentsRequest, opts ...grpc.CallOption) (*GetEnvironmentsReply, error) {
+	out := new(GetEnvironmentsReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetEnvironments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StatusUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Level != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Level))
-	}
-	if m.Event != nil {
-		nn1, err1 := m.Event.MarshalTo(dAtA[i:])
-		if err1 != nil {
-			return 0, err1
-		}
-		i += nn1
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *controlClient) NewEnvironment(ctx context.Context, in *NewEnvironmentRequest, opts ...grpc.CallOption) (*NewEnvironmentReply, error) {
+	out := new(NewEnvironmentReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/NewEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *StatusUpdate_MesosHeartbeat) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MesosHeartbeat != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.MesosHeartbeat.Size()))
-		n2, err2 := m.MesosHeartbeat.MarshalTo(dAtA[i:])
-		if err2 != nil {
-			return 0, err2
-		}
-		i += n2
+func (c *controlClient) GetEnvironment(ctx context.Context, in *GetEnvironmentRequest, opts ...grpc.CallOption) (*GetEnvironmentReply, error) {
+	out := new(GetEnvironmentReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
-func (m *GetFrameworkInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+
+func (c *controlClient) ControlEnvironment(ctx context.Context, in *ControlEnvironmentRequest, opts ...grpc.CallOption) (*ControlEnvironmentReply, error) {
+	out := new(ControlEnvironmentReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/ControlEnvironment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetFrameworkInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *controlClient) ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error) {
+	out := new(ModifyEnvironmentReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/ModifyEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *Version) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) DestroyEnvironment(ctx context.Context, in *DestroyEnvironmentRequest, opts ...grpc.CallOption) (*DestroyEnvironmentReply, error) {
+	out := new(DestroyEnvironmentReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/DestroyEnvironment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Version) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Major != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Major))
-	}
-	if m.Minor != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Minor))
-	}
-	if m.Patch != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Patch))
-	}
-	if len(m.Build) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Build)))
-		i += copy(dAtA[i:], m.Build)
-	}
-	if len(m.ProductName) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ProductName)))
-		i += copy(dAtA[i:], m.ProductName)
-	}
-	if len(m.VersionStr) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.VersionStr)))
-		i += copy(dAtA[i:], m.VersionStr)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *controlClient) GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksReply, error) {
+	out := new(GetTasksReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *GetFrameworkInfoReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskReply, error) {
+	out := new(GetTaskReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetFrameworkInfoReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.FrameworkId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.FrameworkId)))
-		i += copy(dAtA[i:], m.FrameworkId)
-	}
-	if m.EnvironmentsCount != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.EnvironmentsCount))
-	}
-	if m.TasksCount != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.TasksCount))
-	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
-	}
-	if m.HostsCount != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.HostsCount))
-	}
-	if len(m.InstanceName) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.InstanceName)))
-		i += copy(dAtA[i:], m.InstanceName)
-	}
-	if m.Version != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Version.Size()))
-		n3, err3 := m.Version.MarshalTo(dAtA[i:])
-		if err3 != nil {
-			return 0, err3
-		}
-		i += n3
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *controlClient) GetTaskClass(ctx context.Context, in *GetTaskClassRequest, opts ...grpc.CallOption) (*GetTaskClassReply, error) {
+	out := new(GetTaskClassReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetTaskClass", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *TeardownRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) CleanupTasks(ctx context.Context, in *CleanupTasksRequest, opts ...grpc.CallOption) (*CleanupTasksReply, error) {
+	out := new(CleanupTasksReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/CleanupTasks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TeardownRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
+func (c *controlClient) GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error) {
+	out := new(GetRolesReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *controlClient) GetHosts(ctx context.Context, in *GetHostsRequest, opts ...grpc.CallOption) (*GetHostsReply, error) {
+	out := new(GetHostsReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetHosts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *TeardownReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) GetHost(ctx context.Context, in *GetHostRequest, opts ...grpc.CallOption) (*GetHostReply, error) {
+	out := new(GetHostReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetHost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TeardownReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *GetEnvironmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) SetHostState(ctx context.Context, in *SetHostStateRequest, opts ...grpc.CallOption) (*SetHostStateReply, error) {
+	out := new(SetHostStateReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/SetHostState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetEnvironmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *controlClient) GetWorkflowTemplates(ctx context.Context, in *GetWorkflowTemplatesRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatesReply, error) {
+	out := new(GetWorkflowTemplatesReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetWorkflowTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *GetEnvironmentsReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposReply, error) {
+	out := new(ListReposReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/ListRepos", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetEnvironmentsReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.FrameworkId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.FrameworkId)))
-		i += copy(dAtA[i:], m.FrameworkId)
-	}
-	if len(m.Environments) > 0 {
-		for _, msg := range m.Environments {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *controlClient) AddRepo(ctx context.Context, in *AddRepoRequest, opts ...grpc.CallOption) (*AddRepoReply, error) {
+	out := new(AddRepoReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/AddRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *EnvironmentInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (*RemoveRepoReply, error) {
+	out := new(RemoveRepoReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/RemoveRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *EnvironmentInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.CreatedWhen) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.CreatedWhen)))
-		i += copy(dAtA[i:], m.CreatedWhen)
-	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
-	}
-	if len(m.Tasks) > 0 {
-		for _, msg := range m.Tasks {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.RootRole) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.RootRole)))
-		i += copy(dAtA[i:], m.RootRole)
-	}
-	if m.CurrentRunNumber != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.CurrentRunNumber))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *controlClient) RefreshRepos(ctx context.Context, in *RefreshReposRequest, opts ...grpc.CallOption) (*RefreshReposReply, error) {
+	out := new(RefreshReposReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/RefreshRepos", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *NewEnvironmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *controlClient) SetDefaultRepo(ctx context.Context, in *SetDefaultRepoRequest, opts ...grpc.CallOption) (*SetDefaultRepoReply, error) {
+	out := new(SetDefaultRepoReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/SetDefaultRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *NewEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkflowTemplate) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.WorkflowTemplate)))
-		i += copy(dAtA[i:], m.WorkflowTemplate)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// ControlServer is the server API for Control service.
+type ControlServer interface {
+	TrackStatus(*StatusRequest, Control_TrackStatusServer) error
+	GetFrameworkInfo(context.Context, *GetFrameworkInfoRequest) (*GetFrameworkInfoReply, error)
+	Teardown(context.Context, *TeardownRequest) (*TeardownReply, error)
+	GetEnvironments(context.Context, *GetEnvironmentsRequest) (*GetEnvironmentsReply, error)
+	NewEnvironment(context.Context, *NewEnvironmentRequest) (*NewEnvironmentReply, error)
+	GetEnvironment(context.Context, *GetEnvironmentRequest) (*GetEnvironmentReply, error)
+	ControlEnvironment(context.Context, *ControlEnvironmentRequest) (*ControlEnvironmentReply, error)
+	ModifyEnvironment(context.Context, *ModifyEnvironmentRequest) (*ModifyEnvironmentReply, error)
+	DestroyEnvironment(context.Context, *DestroyEnvironmentRequest) (*DestroyEnvironmentReply, error)
+	GetTasks(context.Context, *GetTasksRequest) (*GetTasksReply, error)
+	GetTask(context.Context, *GetTaskRequest) (*GetTaskReply, error)
+	GetTaskClass(context.Context, *GetTaskClassRequest) (*GetTaskClassReply, error)
+	CleanupTasks(context.Context, *CleanupTasksRequest) (*CleanupTasksReply, error)
+	GetRoles(context.Context, *GetRolesRequest) (*GetRolesReply, error)
+	GetHosts(context.Context, *GetHostsRequest) (*GetHostsReply, error)
+	GetHost(context.Context, *GetHostRequest) (*GetHostReply, error)
+	SetHostState(context.Context, *SetHostStateRequest) (*SetHostStateReply, error)
+	GetWorkflowTemplates(context.Context, *GetWorkflowTemplatesRequest) (*GetWorkflowTemplatesReply, error)
+	ListRepos(context.Context, *ListReposRequest) (*ListReposReply, error)
+	AddRepo(context.Context, *AddRepoRequest) (*AddRepoReply, error)
+	RemoveRepo(context.Context, *RemoveRepoRequest) (*RemoveRepoReply, error)
+	RefreshRepos(context.Context, *RefreshReposRequest) (*RefreshReposReply, error)
+	SetDefaultRepo(context.Context, *SetDefaultRepoRequest) (*SetDefaultRepoReply, error)
 }
 
-func (m *NewEnvironmentReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterControlServer(s *grpc.Server, srv ControlServer) {
+	s.RegisterService(&_Control_serviceDesc, srv)
 }
 
-func (m *NewEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Environment != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Environment.Size()))
-		n4, err4 := m.Environment.MarshalTo(dAtA[i:])
-		if err4 != nil {
-			return 0, err4
-		}
-		i += n4
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func _Control_TrackStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(ControlServer).TrackStatus(m, &controlTrackStatusServer{stream})
 }
 
-func (m *GetEnvironmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Control_TrackStatusServer interface {
+	Send(*StatusReply) error
+	grpc.ServerStream
 }
 
-func (m *GetEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type controlTrackStatusServer struct {
+	grpc.ServerStream
 }
 
-func (m *GetEnvironmentReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *controlTrackStatusServer) Send(m *StatusReply) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *GetEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Environment != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Environment.Size()))
-		n5, err5 := m.Environment.MarshalTo(dAtA[i:])
-		if err5 != nil {
-			return 0, err5
-		}
-		i += n5
+func _Control_GetFrameworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFrameworkInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Workflow != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Workflow.Size()))
-		n6, err6 := m.Workflow.MarshalTo(dAtA[i:])
-		if err6 != nil {
-			return 0, err6
-		}
-		i += n6
+	if interceptor == nil {
+		return srv.(ControlServer).GetFrameworkInfo(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetFrameworkInfo",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetFrameworkInfo(ctx, req.(*GetFrameworkInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ControlEnvironmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_Teardown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeardownRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ControlEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if interceptor == nil {
+		return srv.(ControlServer).Teardown(ctx, in)
 	}
-	if m.Type != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Type))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/Teardown",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).Teardown(ctx, req.(*TeardownRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ControlEnvironmentReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_GetEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ControlServer).GetEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetEnvironments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetEnvironments(ctx, req.(*GetEnvironmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ControlEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func _Control_NewEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
+	if interceptor == nil {
+		return srv.(ControlServer).NewEnvironment(ctx, in)
 	}
-	if m.CurrentRunNumber != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.CurrentRunNumber))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/NewEnvironment",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).NewEnvironment(ctx, req.(*NewEnvironmentRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyEnvironmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_GetEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ControlServer).GetEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetEnvironment(ctx, req.(*GetEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func _Control_ControlEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Operations) > 0 {
-		for _, msg := range m.Operations {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(ControlServer).ControlEnvironment(ctx, in)
 	}
-	if m.ReconfigureAll {
-		dAtA[i] = 0x18
-		i++
-		if m.ReconfigureAll {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/ControlEnvironment",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ControlEnvironment(ctx, req.(*ControlEnvironmentRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EnvironmentOperation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_ModifyEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyEnvironmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ControlServer).ModifyEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/ModifyEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ModifyEnvironment(ctx, req.(*ModifyEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EnvironmentOperation) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Type))
+func _Control_DestroyEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RoleName) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.RoleName)))
-		i += copy(dAtA[i:], m.RoleName)
+	if interceptor == nil {
+		return srv.(ControlServer).DestroyEnvironment(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/DestroyEnvironment",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DestroyEnvironment(ctx, req.(*DestroyEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyEnvironmentReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_GetTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTasksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ControlServer).GetTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetTasks(ctx, req.(*GetTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.FailedOperations) > 0 {
-		for _, msg := range m.FailedOperations {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func _Control_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if interceptor == nil {
+		return srv.(ControlServer).GetTask(ctx, in)
 	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetTask",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetTask(ctx, req.(*GetTaskRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DestroyEnvironmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_GetTaskClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskClassRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DestroyEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if interceptor == nil {
+		return srv.(ControlServer).GetTaskClass(ctx, in)
 	}
-	if m.KeepTasks {
-		dAtA[i] = 0x10
-		i++
-		if m.KeepTasks {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetTaskClass",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetTaskClass(ctx, req.(*GetTaskClassRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DestroyEnvironmentReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_CleanupTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupTasksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DestroyEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.CleanupTasksReply != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.CleanupTasksReply.Size()))
-		n7, err7 := m.CleanupTasksReply.MarshalTo(dAtA[i:])
-		if err7 != nil {
-			return 0, err7
-		}
-		i += n7
+	if interceptor == nil {
+		return srv.(ControlServer).CleanupTasks(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/CleanupTasks",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CleanupTasks(ctx, req.(*CleanupTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ShortTaskInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_GetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRolesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ControlServer).GetRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetRoles(ctx, req.(*GetRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ShortTaskInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+func _Control_GetHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Locked {
-		dAtA[i] = 0x10
-		i++
-		if m.Locked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(ControlServer).GetHosts(ctx, in)
 	}
-	if len(m.TaskId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskId)))
-		i += copy(dAtA[i:], m.TaskId)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetHosts",
 	}
-	if len(m.Status) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Status)))
-		i += copy(dAtA[i:], m.Status)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetHosts(ctx, req.(*GetHostsRequest))
 	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ClassName) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ClassName)))
-		i += copy(dAtA[i:], m.ClassName)
+	if interceptor == nil {
+		return srv.(ControlServer).GetHost(ctx, in)
 	}
-	if m.DeploymentInfo != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.DeploymentInfo.Size()))
-		n8, err8 := m.DeploymentInfo.MarshalTo(dAtA[i:])
-		if err8 != nil {
-			return 0, err8
-		}
-		i += n8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetHost",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetHost(ctx, req.(*GetHostRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TaskDeploymentInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_SetHostState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHostStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ControlServer).SetHostState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/SetHostState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetHostState(ctx, req.(*SetHostStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TaskDeploymentInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hostname) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
-	}
-	if len(m.AgentId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.AgentId)))
-		i += copy(dAtA[i:], m.AgentId)
+func _Control_GetWorkflowTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OfferId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.OfferId)))
-		i += copy(dAtA[i:], m.OfferId)
+	if interceptor == nil {
+		return srv.(ControlServer).GetWorkflowTemplates(ctx, in)
 	}
-	if len(m.ExecutorId) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ExecutorId)))
-		i += copy(dAtA[i:], m.ExecutorId)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetWorkflowTemplates",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetWorkflowTemplates(ctx, req.(*GetWorkflowTemplatesRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Control_ListRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReposRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ControlServer).ListRepos(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/ListRepos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ListRepos(ctx, req.(*ListReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTasksReply) Marshal() (dAtA []byte, err error) {
+func _Control_AddRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).AddRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/AddRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).AddRepo(ctx, req.(*AddRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_RemoveRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RemoveRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/RemoveRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RemoveRepo(ctx, req.(*RemoveRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_RefreshRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshReposRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RefreshRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/RefreshRepos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RefreshRepos(ctx, req.(*RefreshReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetDefaultRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetDefaultRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/SetDefaultRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetDefaultRepo(ctx, req.(*SetDefaultRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Control_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "o2control.Control",
+	HandlerType: (*ControlServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFrameworkInfo",
+			Handler:    _Control_GetFrameworkInfo_Handler,
+		},
+		{
+			MethodName: "Teardown",
+			Handler:    _Control_Teardown_Handler,
+		},
+		{
+			MethodName: "GetEnvironments",
+			Handler:    _Control_GetEnvironments_Handler,
+		},
+		{
+			MethodName: "NewEnvironment",
+			Handler:    _Control_NewEnvironment_Handler,
+		},
+		{
+			MethodName: "GetEnvironment",
+			Handler:    _Control_GetEnvironment_Handler,
+		},
+		{
+			MethodName: "ControlEnvironment",
+			Handler:    _Control_ControlEnvironment_Handler,
+		},
+		{
+			MethodName: "ModifyEnvironment",
+			Handler:    _Control_ModifyEnvironment_Handler,
+		},
+		{
+			MethodName: "DestroyEnvironment",
+			Handler:    _Control_DestroyEnvironment_Handler,
+		},
+		{
+			MethodName: "GetTasks",
+			Handler:    _Control_GetTasks_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _Control_GetTask_Handler,
+		},
+		{
+			MethodName: "GetTaskClass",
+			Handler:    _Control_GetTaskClass_Handler,
+		},
+		{
+			MethodName: "CleanupTasks",
+			Handler:    _Control_CleanupTasks_Handler,
+		},
+		{
+			MethodName: "GetRoles",
+			Handler:    _Control_GetRoles_Handler,
+		},
+		{
+			MethodName: "GetHosts",
+			Handler:    _Control_GetHosts_Handler,
+		},
+		{
+			MethodName: "GetHost",
+			Handler:    _Control_GetHost_Handler,
+		},
+		{
+			MethodName: "SetHostState",
+			Handler:    _Control_SetHostState_Handler,
+		},
+		{
+			MethodName: "GetWorkflowTemplates",
+			Handler:    _Control_GetWorkflowTemplates_Handler,
+		},
+		{
+			MethodName: "ListRepos",
+			Handler:    _Control_ListRepos_Handler,
+		},
+		{
+			MethodName: "AddRepo",
+			Handler:    _Control_AddRepo_Handler,
+		},
+		{
+			MethodName: "RemoveRepo",
+			Handler:    _Control_RemoveRepo_Handler,
+		},
+		{
+			MethodName: "RefreshRepos",
+			Handler:    _Control_RefreshRepos_Handler,
+		},
+		{
+			MethodName: "SetDefaultRepo",
+			Handler:    _Control_SetDefaultRepo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TrackStatus",
+			Handler:       _Control_TrackStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "protos/o2control.proto",
+}
+
+func (m *Event_MesosHeartbeat) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4946,30 +5216,18 @@ func (m *GetTasksReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTasksReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *Event_MesosHeartbeat) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tasks) > 0 {
-		for _, msg := range m.Tasks {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *GetTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4979,24 +5237,18 @@ func (m *GetTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.TaskId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskId)))
-		i += copy(dAtA[i:], m.TaskId)
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *GetTaskReply) Marshal() (dAtA []byte, err error) {
+func (m *StatusReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5006,20 +5258,28 @@ func (m *GetTaskReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTaskReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *StatusReply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
+	if len(m.State) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Task.Size()))
-		n9, err9 := m.Task.MarshalTo(dAtA[i:])
-		if err9 != nil {
-			return 0, err9
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if len(m.StatusUpdates) > 0 {
+		for _, msg := range m.StatusUpdates {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n9
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5027,7 +5287,7 @@ func (m *GetTaskReply) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TaskClassInfo) Marshal() (dAtA []byte, err error) {
+func (m *StatusUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5037,22 +5297,22 @@ func (m *TaskClassInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskClassInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *StatusUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
+	if m.Level != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Level))
 	}
-	if len(m.ControlMode) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ControlMode)))
-		i += copy(dAtA[i:], m.ControlMode)
+	if m.Event != nil {
+		nn1, err := m.Event.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn1
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5060,80 +5320,42 @@ func (m *TaskClassInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CommandInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (m *StatusUpdate_MesosHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MesosHeartbeat != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.MesosHeartbeat.Size()))
+		n2, err := m.MesosHeartbeat.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+func (m *GetFrameworkInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *CommandInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetFrameworkInfoRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Env) > 0 {
-		for _, s := range m.Env {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if m.Shell {
-		dAtA[i] = 0x10
-		i++
-		if m.Shell {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if len(m.Arguments) > 0 {
-		for _, s := range m.Arguments {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.User) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.User)))
-		i += copy(dAtA[i:], m.User)
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ChannelInfo) Marshal() (dAtA []byte, err error) {
+func (m *Version) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5143,28 +5365,43 @@ func (m *ChannelInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChannelInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Version) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
+	if m.Major != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Major))
 	}
-	if len(m.Type) > 0 {
-		dAtA[i] = 0x12
+	if m.Minor != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Minor))
 	}
-	if len(m.Target) > 0 {
-		dAtA[i] = 0x1a
+	if m.Patch != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Target)))
-		i += copy(dAtA[i:], m.Target)
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Patch))
+	}
+	if len(m.Build) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Build)))
+		i += copy(dAtA[i:], m.Build)
+	}
+	if len(m.ProductName) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ProductName)))
+		i += copy(dAtA[i:], m.ProductName)
+	}
+	if len(m.VersionStr) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.VersionStr)))
+		i += copy(dAtA[i:], m.VersionStr)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5172,7 +5409,7 @@ func (m *ChannelInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TaskInfo) Marshal() (dAtA []byte, err error) {
+func (m *GetFrameworkInfoReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5182,76 +5419,53 @@ func (m *TaskInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetFrameworkInfoReply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ShortInfo != nil {
+	if len(m.FrameworkId) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.ShortInfo.Size()))
-		n10, err10 := m.ShortInfo.MarshalTo(dAtA[i:])
-		if err10 != nil {
-			return 0, err10
-		}
-		i += n10
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.FrameworkId)))
+		i += copy(dAtA[i:], m.FrameworkId)
 	}
-	if m.ClassInfo != nil {
-		dAtA[i] = 0x12
+	if m.EnvironmentsCount != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.ClassInfo.Size()))
-		n11, err11 := m.ClassInfo.MarshalTo(dAtA[i:])
-		if err11 != nil {
-			return 0, err11
-		}
-		i += n11
+		i = encodeVarintO2Control(dAtA, i, uint64(m.EnvironmentsCount))
 	}
-	if len(m.InboundChannels) > 0 {
-		for _, msg := range m.InboundChannels {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.TasksCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.TasksCount))
 	}
-	if len(m.OutboundChannels) > 0 {
-		for _, msg := range m.OutboundChannels {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
 	}
-	if m.CommandInfo != nil {
-		dAtA[i] = 0x2a
+	if m.HostsCount != 0 {
+		dAtA[i] = 0x28
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.CommandInfo.Size()))
-		n12, err12 := m.CommandInfo.MarshalTo(dAtA[i:])
-		if err12 != nil {
-			return 0, err12
-		}
-		i += n12
+		i = encodeVarintO2Control(dAtA, i, uint64(m.HostsCount))
 	}
-	if len(m.TaskPath) > 0 {
+	if len(m.InstanceName) > 0 {
 		dAtA[i] = 0x32
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskPath)))
-		i += copy(dAtA[i:], m.TaskPath)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.InstanceName)))
+		i += copy(dAtA[i:], m.InstanceName)
 	}
-	if len(m.EnvId) > 0 {
+	if m.Version != nil {
 		dAtA[i] = 0x3a
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.EnvId)))
-		i += copy(dAtA[i:], m.EnvId)
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Version.Size()))
+		n3, err := m.Version.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5259,7 +5473,7 @@ func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CleanupTasksRequest) Marshal() (dAtA []byte, err error) {
+func (m *TeardownRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5269,25 +5483,16 @@ func (m *CleanupTasksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CleanupTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TeardownRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.TaskIds) > 0 {
-		for _, s := range m.TaskIds {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5295,7 +5500,7 @@ func (m *CleanupTasksRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CleanupTasksReply) Marshal() (dAtA []byte, err error) {
+func (m *TeardownReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5305,42 +5510,18 @@ func (m *CleanupTasksReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CleanupTasksReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *TeardownReply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.KilledTasks) > 0 {
-		for _, msg := range m.KilledTasks {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.RunningTasks) > 0 {
-		for _, msg := range m.RunningTasks {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *GetRolesRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetEnvironmentsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5350,30 +5531,18 @@ func (m *GetRolesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetEnvironmentsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.EnvId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.EnvId)))
-		i += copy(dAtA[i:], m.EnvId)
-	}
-	if len(m.PathSpec) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.PathSpec)))
-		i += copy(dAtA[i:], m.PathSpec)
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *RoleInfo) Marshal() (dAtA []byte, err error) {
+func (m *GetEnvironmentsReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5383,53 +5552,20 @@ func (m *RoleInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RoleInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetEnvironmentsReply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if len(m.FrameworkId) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Status) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Status)))
-		i += copy(dAtA[i:], m.Status)
-	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
-	}
-	if len(m.FullPath) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.FullPath)))
-		i += copy(dAtA[i:], m.FullPath)
-	}
-	if len(m.TaskIds) > 0 {
-		for _, s := range m.TaskIds {
-			dAtA[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.FrameworkId)))
+		i += copy(dAtA[i:], m.FrameworkId)
 	}
-	if len(m.Roles) > 0 {
-		for _, msg := range m.Roles {
-			dAtA[i] = 0x32
+	if len(m.Environments) > 0 {
+		for _, msg := range m.Environments {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -5445,7 +5581,7 @@ func (m *RoleInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetRolesReply) Marshal() (dAtA []byte, err error) {
+func (m *EnvironmentInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5455,14 +5591,32 @@ func (m *GetRolesReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRolesReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *EnvironmentInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Roles) > 0 {
-		for _, msg := range m.Roles {
-			dAtA[i] = 0xa
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.CreatedWhen) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.CreatedWhen)))
+		i += copy(dAtA[i:], m.CreatedWhen)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if len(m.Tasks) > 0 {
+		for _, msg := range m.Tasks {
+			dAtA[i] = 0x22
 			i++
 			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -5472,13 +5626,39 @@ func (m *GetRolesReply) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.RootRole) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.RootRole)))
+		i += copy(dAtA[i:], m.RootRole)
+	}
+	if m.CurrentRunNumber != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.CurrentRunNumber))
+	}
+	if len(m.UnavailableHosts) > 0 {
+		for _, s := range m.UnavailableHosts {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *GetWorkflowTemplatesRequest) Marshal() (dAtA []byte, err error) {
+func (m *NewEnvironmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5488,18 +5668,41 @@ func (m *GetWorkflowTemplatesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetWorkflowTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *NewEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.WorkflowTemplate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.WorkflowTemplate)))
+		i += copy(dAtA[i:], m.WorkflowTemplate)
+	}
+	if len(m.Vars) > 0 {
+		for k, _ := range m.Vars {
+			dAtA[i] = 0x12
+			i++
+			v := m.Vars[k]
+			mapSize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			i = encodeVarintO2Control(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *WorkflowTemplateInfo) Marshal() (dAtA []byte, err error) {
+func (m *NewEnvironmentReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5509,22 +5712,20 @@ func (m *WorkflowTemplateInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowTemplateInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *NewEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Repo) > 0 {
+	if m.Environment != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Repo)))
-		i += copy(dAtA[i:], m.Repo)
-	}
-	if len(m.Template) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Template)))
-		i += copy(dAtA[i:], m.Template)
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Environment.Size()))
+		n4, err := m.Environment.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5532,7 +5733,7 @@ func (m *WorkflowTemplateInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetWorkflowTemplatesReply) Marshal() (dAtA []byte, err error) {
+func (m *GetEnvironmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5542,22 +5743,16 @@ func (m *GetWorkflowTemplatesReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetWorkflowTemplatesReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.WorkflowTemplates) > 0 {
-		for _, msg := range m.WorkflowTemplates {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5565,7 +5760,7 @@ func (m *GetWorkflowTemplatesReply) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListReposRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetEnvironmentReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5575,18 +5770,50 @@ func (m *ListReposRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListReposRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Environment != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Environment.Size()))
+		n5, err := m.Environment.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Workflow != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Workflow.Size()))
+		n6, err := m.Workflow.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.History) > 0 {
+		for _, msg := range m.History {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
+func (m *EnvironmentEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5596,26 +5823,40 @@ func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *EnvironmentEvent) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if len(m.Timestamp) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Timestamp)))
+		i += copy(dAtA[i:], m.Timestamp)
 	}
-	if m.Default {
-		dAtA[i] = 0x10
+	if len(m.Event) > 0 {
+		dAtA[i] = 0x12
 		i++
-		if m.Default {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Event)))
+		i += copy(dAtA[i:], m.Event)
+	}
+	if len(m.RolePath) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.RolePath)))
+		i += copy(dAtA[i:], m.RolePath)
+	}
+	if len(m.TaskId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskId)))
+		i += copy(dAtA[i:], m.TaskId)
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x2a
 		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5623,7 +5864,7 @@ func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListReposReply) Marshal() (dAtA []byte, err error) {
+func (m *ControlEnvironmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5633,22 +5874,21 @@ func (m *ListReposReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListReposReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ControlEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Repos) > 0 {
-		for _, msg := range m.Repos {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Type))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5656,7 +5896,7 @@ func (m *ListReposReply) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AddRepoRequest) Marshal() (dAtA []byte, err error) {
+func (m *ControlEnvironmentReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5666,16 +5906,27 @@ func (m *AddRepoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ControlEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if len(m.Id) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if m.CurrentRunNumber != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.CurrentRunNumber))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5683,7 +5934,7 @@ func (m *AddRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AddRepoReply) Marshal() (dAtA []byte, err error) {
+func (m *ModifyEnvironmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5693,16 +5944,38 @@ func (m *AddRepoReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddRepoReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ModifyEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ErrorString) > 0 {
+	if len(m.Id) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ErrorString)))
-		i += copy(dAtA[i:], m.ErrorString)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Operations) > 0 {
+		for _, msg := range m.Operations {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ReconfigureAll {
+		dAtA[i] = 0x18
+		i++
+		if m.ReconfigureAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5710,7 +5983,7 @@ func (m *AddRepoReply) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RemoveRepoRequest) Marshal() (dAtA []byte, err error) {
+func (m *EnvironmentOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5720,15 +5993,31 @@ func (m *RemoveRepoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EnvironmentOperation) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
+	if m.Type != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Index))
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Type))
+	}
+	if len(m.RoleName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.RoleName)))
+		i += copy(dAtA[i:], m.RoleName)
+	}
+	if m.IteratorRange != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.IteratorRange.Size()))
+		n7, err := m.IteratorRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5736,7 +6025,7 @@ func (m *RemoveRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RemoveRepoReply) Marshal() (dAtA []byte, err error) {
+func (m *IteratorRange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5746,26 +6035,35 @@ func (m *RemoveRepoReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveRepoReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *IteratorRange) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Ok {
+	if m.Begin != 0 {
 		dAtA[i] = 0x8
 		i++
-		if m.Ok {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Begin))
 	}
-	if len(m.NewDefaultRepo) > 0 {
-		dAtA[i] = 0x12
+	if m.End != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.NewDefaultRepo)))
-		i += copy(dAtA[i:], m.NewDefaultRepo)
+		i = encodeVarintO2Control(dAtA, i, uint64(m.End))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5773,7 +6071,7 @@ func (m *RemoveRepoReply) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RefreshReposRequest) Marshal() (dAtA []byte, err error) {
+func (m *ModifyEnvironmentReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5783,15 +6081,34 @@ func (m *RefreshReposRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshReposRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ModifyEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
+	if len(m.FailedOperations) > 0 {
+		for _, msg := range m.FailedOperations {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Index))
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5799,7 +6116,7 @@ func (m *RefreshReposRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RefreshReposReply) Marshal() (dAtA []byte, err error) {
+func (m *DestroyEnvironmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5809,16 +6126,26 @@ func (m *RefreshReposReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshReposReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *DestroyEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ErrorString) > 0 {
+	if len(m.Id) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ErrorString)))
-		i += copy(dAtA[i:], m.ErrorString)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.KeepTasks {
+		dAtA[i] = 0x10
+		i++
+		if m.KeepTasks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5826,7 +6153,7 @@ func (m *RefreshReposReply) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SetDefaultRepoRequest) Marshal() (dAtA []byte, err error) {
+func (m *DestroyEnvironmentReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5836,15 +6163,20 @@ func (m *SetDefaultRepoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetDefaultRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DestroyEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
+	if m.CleanupTasksReply != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Index))
+		i = encodeVarintO2Control(dAtA, i, uint64(m.CleanupTasksReply.Size()))
+		n8, err := m.CleanupTasksReply.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5852,7 +6184,7 @@ func (m *SetDefaultRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SetDefaultRepoReply) Marshal() (dAtA []byte, err error) {
+func (m *DeploymentFailure) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5862,16 +6194,27 @@ func (m *SetDefaultRepoReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetDefaultRepoReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeploymentFailure) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ErrorString) > 0 {
-		dAtA[i] = 0xa
+	if len(m.Descriptors) > 0 {
+		for _, msg := range m.Descriptors {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OffersReceived != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ErrorString)))
-		i += copy(dAtA[i:], m.ErrorString)
+		i = encodeVarintO2Control(dAtA, i, uint64(m.OffersReceived))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5879,1128 +6222,5350 @@ func (m *SetDefaultRepoReply) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintO2Control(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Event_MesosHeartbeat) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DescriptorExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DescriptorExplanation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *StatusReply) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RolePath) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.RolePath)))
+		i += copy(dAtA[i:], m.RolePath)
 	}
-	var l int
-	_ = l
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.TaskClass) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskClass)))
+		i += copy(dAtA[i:], m.TaskClass)
 	}
-	if len(m.StatusUpdates) > 0 {
-		for _, e := range m.StatusUpdates {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Rejections) > 0 {
+		for _, msg := range m.Rejections {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *StatusUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OfferRejection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OfferRejection) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Level != 0 {
-		n += 1 + sovO2Control(uint64(m.Level))
+	if len(m.OfferId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.OfferId)))
+		i += copy(dAtA[i:], m.OfferId)
 	}
-	if m.Event != nil {
-		n += m.Event.Size()
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *StatusUpdate_MesosHeartbeat) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MesosHeartbeat != nil {
-		l = m.MesosHeartbeat.Size()
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	return n
-}
-func (m *GetFrameworkInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ShortTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Version) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShortTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Major != 0 {
-		n += 1 + sovO2Control(uint64(m.Major))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Minor != 0 {
-		n += 1 + sovO2Control(uint64(m.Minor))
+	if m.Locked {
+		dAtA[i] = 0x10
+		i++
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Patch != 0 {
-		n += 1 + sovO2Control(uint64(m.Patch))
+	if len(m.TaskId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskId)))
+		i += copy(dAtA[i:], m.TaskId)
 	}
-	l = len(m.Build)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Status) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
 	}
-	l = len(m.ProductName)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.State) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
 	}
-	l = len(m.VersionStr)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.ClassName) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ClassName)))
+		i += copy(dAtA[i:], m.ClassName)
+	}
+	if m.DeploymentInfo != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.DeploymentInfo.Size()))
+		n9, err := m.DeploymentInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetFrameworkInfoReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TaskDeploymentInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskDeploymentInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FrameworkId)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.EnvironmentsCount != 0 {
-		n += 1 + sovO2Control(uint64(m.EnvironmentsCount))
-	}
-	if m.TasksCount != 0 {
-		n += 1 + sovO2Control(uint64(m.TasksCount))
-	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	if m.HostsCount != 0 {
-		n += 1 + sovO2Control(uint64(m.HostsCount))
+	if len(m.AgentId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.AgentId)))
+		i += copy(dAtA[i:], m.AgentId)
 	}
-	l = len(m.InstanceName)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.OfferId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.OfferId)))
+		i += copy(dAtA[i:], m.OfferId)
 	}
-	if m.Version != nil {
-		l = m.Version.Size()
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.ExecutorId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ExecutorId)))
+		i += copy(dAtA[i:], m.ExecutorId)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TeardownRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TeardownReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetEnvironmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetTasksReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetEnvironmentsReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTasksReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FrameworkId)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if len(m.Environments) > 0 {
-		for _, e := range m.Environments {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Tasks) > 0 {
+		for _, msg := range m.Tasks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *EnvironmentInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.CreatedWhen)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
-		}
-	}
-	l = len(m.RootRole)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.CurrentRunNumber != 0 {
-		n += 1 + sovO2Control(uint64(m.CurrentRunNumber))
+	if len(m.TaskId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskId)))
+		i += copy(dAtA[i:], m.TaskId)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *NewEnvironmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.WorkflowTemplate)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetTaskReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NewEnvironmentReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTaskReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Environment != nil {
-		l = m.Environment.Size()
-		n += 1 + l + sovO2Control(uint64(l))
+	if m.Task != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Task.Size()))
+		n10, err := m.Task.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetEnvironmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetTaskClassRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetEnvironmentReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTaskClassRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Environment != nil {
-		l = m.Environment.Size()
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.Workflow != nil {
-		l = m.Workflow.Size()
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.TaskClass) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskClass)))
+		i += copy(dAtA[i:], m.TaskClass)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ControlEnvironmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovO2Control(uint64(m.Type))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetTaskClassReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ControlEnvironmentReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTaskClassReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Identifier) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Identifier)))
+		i += copy(dAtA[i:], m.Identifier)
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Extends) > 0 {
+		for _, s := range m.Extends {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.CurrentRunNumber != 0 {
-		n += 1 + sovO2Control(uint64(m.CurrentRunNumber))
+	if len(m.ResolvedDefinition) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ResolvedDefinition)))
+		i += copy(dAtA[i:], m.ResolvedDefinition)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ModifyEnvironmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TaskClassInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskClassInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
-		}
+	if len(m.ControlMode) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ControlMode)))
+		i += copy(dAtA[i:], m.ControlMode)
 	}
-	if m.ReconfigureAll {
-		n += 2
+	if len(m.CustomResources) > 0 {
+		for _, msg := range m.CustomResources {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *EnvironmentOperation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CustomResourceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomResourceInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovO2Control(uint64(m.Type))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	l = len(m.RoleName)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ModifyEnvironmentReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommandInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.FailedOperations) > 0 {
-		for _, e := range m.FailedOperations {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *CommandInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if m.Shell {
+		dAtA[i] = 0x10
+		i++
+		if m.Shell {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if len(m.Arguments) > 0 {
+		for _, s := range m.Arguments {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.User) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.User)))
+		i += copy(dAtA[i:], m.User)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DestroyEnvironmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChannelInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.KeepTasks {
-		n += 2
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Target) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DestroyEnvironmentReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CleanupTasksReply != nil {
-		l = m.CleanupTasksReply.Size()
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ShortTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if m.ShortInfo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.ShortInfo.Size()))
+		n11, err := m.ShortInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
 	}
-	if m.Locked {
-		n += 2
+	if m.ClassInfo != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.ClassInfo.Size()))
+		n12, err := m.ClassInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.InboundChannels) > 0 {
+		for _, msg := range m.InboundChannels {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.OutboundChannels) > 0 {
+		for _, msg := range m.OutboundChannels {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if m.CommandInfo != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.CommandInfo.Size()))
+		n13, err := m.CommandInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
 	}
-	l = len(m.ClassName)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.TaskPath) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskPath)))
+		i += copy(dAtA[i:], m.TaskPath)
 	}
-	if m.DeploymentInfo != nil {
-		l = m.DeploymentInfo.Size()
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.EnvId) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.EnvId)))
+		i += copy(dAtA[i:], m.EnvId)
+	}
+	if len(m.CustomResources) > 0 {
+		for _, msg := range m.CustomResources {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TaskDeploymentInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CleanupTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanupTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.OfferId)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.ExecutorId)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.TaskIds) > 0 {
+		for _, s := range m.TaskIds {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CleanupTasksReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetTasksReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CleanupTasksReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
+	if len(m.KilledTasks) > 0 {
+		for _, msg := range m.KilledTasks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *GetTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.RunningTasks) > 0 {
+		for _, msg := range m.RunningTasks {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetTaskReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TaskClassInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.EnvId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.EnvId)))
+		i += copy(dAtA[i:], m.EnvId)
 	}
-	l = len(m.ControlMode)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.PathSpec) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.PathSpec)))
+		i += copy(dAtA[i:], m.PathSpec)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CommandInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RoleInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Env) > 0 {
-		for _, s := range m.Env {
-			l = len(s)
-			n += 1 + l + sovO2Control(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Shell {
-		n += 2
+	if len(m.Status) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.State) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
 	}
-	if len(m.Arguments) > 0 {
-		for _, s := range m.Arguments {
+	if len(m.FullPath) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.FullPath)))
+		i += copy(dAtA[i:], m.FullPath)
+	}
+	if len(m.TaskIds) > 0 {
+		for _, s := range m.TaskIds {
+			dAtA[i] = 0x2a
+			i++
 			l = len(s)
-			n += 1 + l + sovO2Control(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Roles) > 0 {
+		for _, msg := range m.Roles {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ChannelInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRolesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRolesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Roles) > 0 {
+		for _, msg := range m.Roles {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetHostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ShortInfo != nil {
-		l = m.ShortInfo.Size()
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.ClassInfo != nil {
-		l = m.ClassInfo.Size()
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if len(m.InboundChannels) > 0 {
-		for _, e := range m.InboundChannels {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
-		}
-	}
-	if len(m.OutboundChannels) > 0 {
-		for _, e := range m.OutboundChannels {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
-		}
-	}
-	if m.CommandInfo != nil {
-		l = m.CommandInfo.Size()
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.TaskPath)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.EnvId)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CleanupTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetHostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.TaskIds) > 0 {
-		for _, s := range m.TaskIds {
-			l = len(s)
-			n += 1 + l + sovO2Control(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CleanupTasksReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetHostsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHostsReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.KilledTasks) > 0 {
-		for _, e := range m.KilledTasks {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
-		}
-	}
-	if len(m.RunningTasks) > 0 {
-		for _, e := range m.RunningTasks {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Hosts) > 0 {
+		for _, msg := range m.Hosts {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetRolesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EnvId)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.PathSpec)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetHostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RoleInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetHostRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	l = len(m.FullPath)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if len(m.TaskIds) > 0 {
-		for _, s := range m.TaskIds {
-			l = len(s)
-			n += 1 + l + sovO2Control(uint64(l))
-		}
-	}
-	if len(m.Roles) > 0 {
-		for _, e := range m.Roles {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
-		}
+	if len(m.Host) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Host)))
+		i += copy(dAtA[i:], m.Host)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetRolesReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetHostReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHostReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Roles) > 0 {
-		for _, e := range m.Roles {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
+	if m.Host != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Host.Size()))
+		n14, err := m.Host.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n14
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetWorkflowTemplatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SetHostStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WorkflowTemplateInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetHostStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Host) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Host)))
+		i += copy(dAtA[i:], m.Host)
 	}
-	l = len(m.Template)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.State) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetWorkflowTemplatesReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetHostStateReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetHostStateReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.WorkflowTemplates) > 0 {
-		for _, e := range m.WorkflowTemplates {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
+	if m.Host != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Host.Size()))
+		n15, err := m.Host.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n15
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListReposRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *HostInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RepoInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *HostInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
-	if m.Default {
-		n += 2
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.AgentId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.AgentId)))
+		i += copy(dAtA[i:], m.AgentId)
 	}
-	return n
-}
-
-func (m *ListReposReply) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Attributes) > 0 {
+		for k, _ := range m.Attributes {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Attributes[k]
+			mapSize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			i = encodeVarintO2Control(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Repos) > 0 {
-		for _, e := range m.Repos {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Resources) > 0 {
+		for k, _ := range m.Resources {
+			dAtA[i] = 0x22
+			i++
+			v := m.Resources[k]
+			mapSize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			i = encodeVarintO2Control(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.LastOffered) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.LastOffered)))
+		i += copy(dAtA[i:], m.LastOffered)
 	}
-	return n
-}
-
-func (m *AddRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Tasks) > 0 {
+		for _, msg := range m.Tasks {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.EnvironmentIds) > 0 {
+		for _, s := range m.EnvironmentIds {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AddRepoReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetWorkflowTemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ErrorString)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RemoveRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowTemplateInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovO2Control(uint64(m.Index))
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
+	}
+	if len(m.Template) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Template)))
+		i += copy(dAtA[i:], m.Template)
+	}
+	if len(m.Parameters) > 0 {
+		for _, msg := range m.Parameters {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RemoveRepoReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowParameterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowParameterInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Ok {
-		n += 2
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	l = len(m.NewDefaultRepo)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DefaultValue) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.DefaultValue)))
+		i += copy(dAtA[i:], m.DefaultValue)
 	}
-	return n
-}
-
-func (m *RefreshReposRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Required {
+		dAtA[i] = 0x20
+		i++
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovO2Control(uint64(m.Index))
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RefreshReposReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetWorkflowTemplatesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowTemplatesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ErrorString)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.WorkflowTemplates) > 0 {
+		for _, msg := range m.WorkflowTemplates {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *SetDefaultRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListReposRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReposRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovO2Control(uint64(m.Index))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Default {
+		dAtA[i] = 0x10
+		i++
+		if m.Default {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *SetDefaultRepoReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListReposReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReposReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ErrorString)
-	if l > 0 {
-		n += 1 + l + sovO2Control(uint64(l))
+	if len(m.Repos) > 0 {
+		for _, msg := range m.Repos {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintO2Control(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func sovO2Control(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *AddRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AddRepoReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRepoReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorString) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ErrorString)))
+		i += copy(dAtA[i:], m.ErrorString)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RemoveRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RemoveRepoReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRepoReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Ok {
+		dAtA[i] = 0x8
+		i++
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
+	if len(m.NewDefaultRepo) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.NewDefaultRepo)))
+		i += copy(dAtA[i:], m.NewDefaultRepo)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
 }
-func sozO2Control(x uint64) (n int) {
-	return sovO2Control(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RefreshReposRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (m *Event_MesosHeartbeat) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+
+func (m *RefreshReposRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RefreshReposReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshReposReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorString) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ErrorString)))
+		i += copy(dAtA[i:], m.ErrorString)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetDefaultRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDefaultRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetDefaultRepoReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDefaultRepoReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorString) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ErrorString)))
+		i += copy(dAtA[i:], m.ErrorString)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintO2Control(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Event_MesosHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.StatusUpdates) > 0 {
+		for _, e := range m.StatusUpdates {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovO2Control(uint64(m.Level))
+	}
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusUpdate_MesosHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MesosHeartbeat != nil {
+		l = m.MesosHeartbeat.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	return n
+}
+func (m *GetFrameworkInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Version) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Major != 0 {
+		n += 1 + sovO2Control(uint64(m.Major))
+	}
+	if m.Minor != 0 {
+		n += 1 + sovO2Control(uint64(m.Minor))
+	}
+	if m.Patch != 0 {
+		n += 1 + sovO2Control(uint64(m.Patch))
+	}
+	l = len(m.Build)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.ProductName)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.VersionStr)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetFrameworkInfoReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FrameworkId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.EnvironmentsCount != 0 {
+		n += 1 + sovO2Control(uint64(m.EnvironmentsCount))
+	}
+	if m.TasksCount != 0 {
+		n += 1 + sovO2Control(uint64(m.TasksCount))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.HostsCount != 0 {
+		n += 1 + sovO2Control(uint64(m.HostsCount))
+	}
+	l = len(m.InstanceName)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TeardownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TeardownReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEnvironmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEnvironmentsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FrameworkId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Environments) > 0 {
+		for _, e := range m.Environments {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EnvironmentInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.CreatedWhen)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	l = len(m.RootRole)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.CurrentRunNumber != 0 {
+		n += 1 + sovO2Control(uint64(m.CurrentRunNumber))
+	}
+	if len(m.UnavailableHosts) > 0 {
+		for _, s := range m.UnavailableHosts {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NewEnvironmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowTemplate)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Vars) > 0 {
+		for k, v := range m.Vars {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			n += mapEntrySize + 1 + sovO2Control(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NewEnvironmentReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Environment != nil {
+		l = m.Environment.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEnvironmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEnvironmentReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Environment != nil {
+		l = m.Environment.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EnvironmentEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.RolePath)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ControlEnvironmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovO2Control(uint64(m.Type))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ControlEnvironmentReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.CurrentRunNumber != 0 {
+		n += 1 + sovO2Control(uint64(m.CurrentRunNumber))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyEnvironmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.ReconfigureAll {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EnvironmentOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovO2Control(uint64(m.Type))
+	}
+	l = len(m.RoleName)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.IteratorRange != nil {
+		l = m.IteratorRange.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IteratorRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Begin != 0 {
+		n += 1 + sovO2Control(uint64(m.Begin))
+	}
+	if m.End != 0 {
+		n += 1 + sovO2Control(uint64(m.End))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyEnvironmentReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedOperations) > 0 {
+		for _, e := range m.FailedOperations {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DestroyEnvironmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.KeepTasks {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DestroyEnvironmentReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CleanupTasksReply != nil {
+		l = m.CleanupTasksReply.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeploymentFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Descriptors) > 0 {
+		for _, e := range m.Descriptors {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.OffersReceived != 0 {
+		n += 1 + sovO2Control(uint64(m.OffersReceived))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescriptorExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RolePath)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.TaskClass)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Rejections) > 0 {
+		for _, e := range m.Rejections {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OfferRejection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OfferId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShortTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.Locked {
+		n += 2
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.ClassName)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.DeploymentInfo != nil {
+		l = m.DeploymentInfo.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskDeploymentInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.OfferId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTasksReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskClass)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskClassReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Extends) > 0 {
+		for _, s := range m.Extends {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	l = len(m.ResolvedDefinition)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskClassInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.ControlMode)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.CustomResources) > 0 {
+		for _, e := range m.CustomResources {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CustomResourceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommandInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.Shell {
+		n += 2
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Arguments) > 0 {
+		for _, s := range m.Arguments {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChannelInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortInfo != nil {
+		l = m.ShortInfo.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.ClassInfo != nil {
+		l = m.ClassInfo.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.InboundChannels) > 0 {
+		for _, e := range m.InboundChannels {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if len(m.OutboundChannels) > 0 {
+		for _, e := range m.OutboundChannels {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.CommandInfo != nil {
+		l = m.CommandInfo.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.TaskPath)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.EnvId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.CustomResources) > 0 {
+		for _, e := range m.CustomResources {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CleanupTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskIds) > 0 {
+		for _, s := range m.TaskIds {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CleanupTasksReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KilledTasks) > 0 {
+		for _, e := range m.KilledTasks {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if len(m.RunningTasks) > 0 {
+		for _, e := range m.RunningTasks {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EnvId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.PathSpec)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RoleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.FullPath)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.TaskIds) > 0 {
+		for _, s := range m.TaskIds {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRolesReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHostsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hosts) > 0 {
+		for _, e := range m.Hosts {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHostReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Host != nil {
+		l = m.Host.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetHostStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetHostStateReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Host != nil {
+		l = m.Host.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HostInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			n += mapEntrySize + 1 + sovO2Control(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Resources) > 0 {
+		for k, v := range m.Resources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			n += mapEntrySize + 1 + sovO2Control(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.LastOffered)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if len(m.EnvironmentIds) > 0 {
+		for _, s := range m.EnvironmentIds {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkflowTemplatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTemplateInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowParameterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.DefaultValue)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.Required {
+		n += 2
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkflowTemplatesReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WorkflowTemplates) > 0 {
+		for _, e := range m.WorkflowTemplates {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListReposRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.Default {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListReposReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddRepoReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ErrorString)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovO2Control(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveRepoReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	l = len(m.NewDefaultRepo)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshReposRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovO2Control(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshReposReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ErrorString)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetDefaultRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovO2Control(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetDefaultRepoReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ErrorString)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovO2Control(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozO2Control(x uint64) (n int) {
+	return sovO2Control(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Event_MesosHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event_MesosHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event_MesosHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusUpdates = append(m.StatusUpdates, &StatusUpdate{})
+			if err := m.StatusUpdates[len(m.StatusUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= StatusUpdate_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MesosHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Event_MesosHeartbeat{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatusUpdate_MesosHeartbeat{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFrameworkInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFrameworkInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFrameworkInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
+			}
+			m.Major = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Major |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minor", wireType)
+			}
+			m.Minor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minor |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			m.Patch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Patch |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Build", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Build = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProductName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFrameworkInfoReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFrameworkInfoReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameworkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrameworkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvironmentsCount", wireType)
+			}
+			m.EnvironmentsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnvironmentsCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksCount", wireType)
+			}
+			m.TasksCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TasksCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostsCount", wireType)
+			}
+			m.HostsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HostsCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstanceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeardownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeardownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeardownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeardownReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeardownReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeardownReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEnvironmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEnvironmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEnvironmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEnvironmentsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEnvironmentsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameworkId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrameworkId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environments = append(m.Environments, &EnvironmentInfo{})
+			if err := m.Environments[len(m.Environments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnvironmentInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnvironmentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedWhen", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedWhen = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &ShortTaskInfo{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootRole", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootRole = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRunNumber", wireType)
+			}
+			m.CurrentRunNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRunNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableHosts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnavailableHosts = append(m.UnavailableHosts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewEnvironmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewEnvironmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vars == nil {
+				m.Vars = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowO2Control
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipO2Control(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Vars[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewEnvironmentReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewEnvironmentReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Environment == nil {
+				m.Environment = &EnvironmentInfo{}
+			}
+			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEnvironmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEnvironmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEnvironmentReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEnvironmentReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Environment == nil {
+				m.Environment = &EnvironmentInfo{}
+			}
+			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Workflow == nil {
+				m.Workflow = &RoleInfo{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &EnvironmentEvent{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnvironmentEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnvironmentEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnvironmentEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RolePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Event_MesosHeartbeat: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event_MesosHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -7026,7 +11591,7 @@ func (m *Event_MesosHeartbeat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7049,12 +11614,63 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControlEnvironmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControlEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ControlEnvironmentRequest_Optype(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -7080,7 +11696,7 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusReply) Unmarshal(dAtA []byte) error {
+func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7103,15 +11719,15 @@ func (m *StatusReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControlEnvironmentReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControlEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7139,13 +11755,13 @@ func (m *StatusReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7155,26 +11771,43 @@ func (m *StatusReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusUpdates = append(m.StatusUpdates, &StatusUpdate{})
-			if err := m.StatusUpdates[len(m.StatusUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRunNumber", wireType)
+			}
+			m.CurrentRunNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRunNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -7200,7 +11833,7 @@ func (m *StatusReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusUpdate) Unmarshal(dAtA []byte) error {
+func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7223,17 +11856,17 @@ func (m *StatusUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyEnvironmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Level = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7243,14 +11876,27 @@ func (m *StatusUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Level |= StatusUpdate_Level(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MesosHeartbeat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7277,66 +11923,31 @@ func (m *StatusUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Event_MesosHeartbeat{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Operations = append(m.Operations, &EnvironmentOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &StatusUpdate_MesosHeartbeat{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetFrameworkInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconfigureAll", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetFrameworkInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFrameworkInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReconfigureAll = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -7362,7 +11973,7 @@ func (m *GetFrameworkInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Version) Unmarshal(dAtA []byte) error {
+func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7385,17 +11996,17 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnvironmentOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnvironmentOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Major = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7405,16 +12016,16 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Major |= int32(b&0x7F) << shift
+				m.Type |= EnvironmentOperation_Optype(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minor", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleName", wireType)
 			}
-			m.Minor = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7424,16 +12035,29 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Minor |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IteratorRange", wireType)
 			}
-			m.Patch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7443,16 +12067,87 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Patch |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Build", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
 			}
-			var stringLen uint64
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IteratorRange == nil {
+				m.IteratorRange = &IteratorRange{}
+			}
+			if err := m.IteratorRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IteratorRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IteratorRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IteratorRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			m.Begin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7462,29 +12157,16 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Begin |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Build = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProductName", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var stringLen uint64
+			m.End = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7494,27 +12176,14 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.End |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProductName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7542,7 +12211,7 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionStr = string(dAtA[iNdEx:postIndex])
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7569,7 +12238,7 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
+func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7592,17 +12261,17 @@ func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFrameworkInfoReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyEnvironmentReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFrameworkInfoReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrameworkId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedOperations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7612,29 +12281,31 @@ func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrameworkId = string(dAtA[iNdEx:postIndex])
+			m.FailedOperations = append(m.FailedOperations, &EnvironmentOperation{})
+			if err := m.FailedOperations[len(m.FailedOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvironmentsCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.EnvironmentsCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7644,16 +12315,29 @@ func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EnvironmentsCount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TasksCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.TasksCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7663,14 +12347,81 @@ func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TasksCount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DestroyEnvironmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DestroyEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7698,13 +12449,13 @@ func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepTasks", wireType)
 			}
-			m.HostsCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7714,46 +12465,69 @@ func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HostsCount |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstanceName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.KeepTasks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstanceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DestroyEnvironmentReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DestroyEnvironmentReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DestroyEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanupTasksReply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7780,10 +12554,10 @@ func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Version == nil {
-				m.Version = &Version{}
+			if m.CleanupTasksReply == nil {
+				m.CleanupTasksReply = &CleanupTasksReply{}
 			}
-			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CleanupTasksReply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7812,7 +12586,7 @@ func (m *GetFrameworkInfoReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TeardownRequest) Unmarshal(dAtA []byte) error {
+func (m *DeploymentFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7835,17 +12609,17 @@ func (m *TeardownRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TeardownRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeploymentFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TeardownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeploymentFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Descriptors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -7855,24 +12629,45 @@ func (m *TeardownRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Descriptors = append(m.Descriptors, &DescriptorExplanation{})
+			if err := m.Descriptors[len(m.Descriptors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffersReceived", wireType)
+			}
+			m.OffersReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffersReceived |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -7898,7 +12693,7 @@ func (m *TeardownRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TeardownReply) Unmarshal(dAtA []byte) error {
+func (m *DescriptorExplanation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7921,66 +12716,110 @@ func (m *TeardownReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TeardownReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescriptorExplanation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TeardownReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescriptorExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolePath", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetEnvironmentsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			m.RolePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Rejections = append(m.Rejections, &OfferRejection{})
+			if err := m.Rejections[len(m.Rejections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetEnvironmentsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEnvironmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -8006,7 +12845,7 @@ func (m *GetEnvironmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
+func (m *OfferRejection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8029,15 +12868,15 @@ func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetEnvironmentsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: OfferRejection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEnvironmentsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OfferRejection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrameworkId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8065,13 +12904,13 @@ func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrameworkId = string(dAtA[iNdEx:postIndex])
+			m.OfferId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -8081,25 +12920,55 @@ func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Environments = append(m.Environments, &EnvironmentInfo{})
-			if err := m.Environments[len(m.Environments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8126,7 +12995,7 @@ func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
+func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8149,15 +13018,15 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvironmentInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShortTaskInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvironmentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShortTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8185,11 +13054,31 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Locked = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedWhen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8217,11 +13106,11 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedWhen = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8249,13 +13138,13 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -8265,29 +13154,27 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &ShortTaskInfo{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootRole", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8315,13 +13202,13 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootRole = string(dAtA[iNdEx:postIndex])
+			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRunNumber", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentInfo", wireType)
 			}
-			m.CurrentRunNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -8331,11 +13218,28 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentRunNumber |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeploymentInfo == nil {
+				m.DeploymentInfo = &TaskDeploymentInfo{}
+			}
+			if err := m.DeploymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -8361,7 +13265,7 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewEnvironmentRequest) Unmarshal(dAtA []byte) error {
+func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8384,15 +13288,15 @@ func (m *NewEnvironmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewEnvironmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskDeploymentInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskDeploymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8420,67 +13324,45 @@ func (m *NewEnvironmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTemplate = string(dAtA[iNdEx:postIndex])
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NewEnvironmentReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NewEnvironmentReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -8490,85 +13372,27 @@ func (m *NewEnvironmentReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Environment == nil {
-				m.Environment = &EnvironmentInfo{}
-			}
-			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OfferId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetEnvironmentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetEnvironmentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8596,7 +13420,7 @@ func (m *GetEnvironmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8623,7 +13447,7 @@ func (m *GetEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetEnvironmentReply) Unmarshal(dAtA []byte) error {
+func (m *GetTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8646,84 +13470,12 @@ func (m *GetEnvironmentReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetEnvironmentReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Environment == nil {
-				m.Environment = &EnvironmentInfo{}
-			}
-			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Workflow == nil {
-				m.Workflow = &RoleInfo{}
-			}
-			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -8749,7 +13501,7 @@ func (m *GetEnvironmentReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTasksReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8772,17 +13524,17 @@ func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControlEnvironmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTasksReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControlEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -8792,43 +13544,26 @@ func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= ControlEnvironmentRequest_Optype(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Tasks = append(m.Tasks, &ShortTaskInfo{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -8854,7 +13589,7 @@ func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
+func (m *GetTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8877,47 +13612,15 @@ func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControlEnvironmentReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControlEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8938,34 +13641,15 @@ func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.State = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRunNumber", wireType)
-			}
-			m.CurrentRunNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentRunNumber |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -8991,7 +13675,7 @@ func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9014,47 +13698,15 @@ func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyEnvironmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9081,31 +13733,13 @@ func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operations = append(m.Operations, &EnvironmentOperation{})
-			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Task == nil {
+				m.Task = &TaskInfo{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReconfigureAll", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ReconfigureAll = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -9131,7 +13765,7 @@ func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
+func (m *GetTaskClassRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9154,34 +13788,15 @@ func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvironmentOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskClassRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvironmentOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= EnvironmentOperation_Optype(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9209,7 +13824,7 @@ func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleName = string(dAtA[iNdEx:postIndex])
+			m.TaskClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9236,7 +13851,7 @@ func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
+func (m *GetTaskClassReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9259,17 +13874,17 @@ func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyEnvironmentReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskClassReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskClassReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedOperations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9279,29 +13894,27 @@ func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedOperations = append(m.FailedOperations, &EnvironmentOperation{})
-			if err := m.FailedOperations[len(m.FailedOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extends", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9329,11 +13942,11 @@ func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Extends = append(m.Extends, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedDefinition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9361,7 +13974,7 @@ func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.ResolvedDefinition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9388,7 +14001,7 @@ func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
+func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9411,15 +14024,15 @@ func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DestroyEnvironmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskClassInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DestroyEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskClassInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9447,13 +14060,13 @@ func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeepTasks", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlMode", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9463,12 +14076,58 @@ func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.KeepTasks = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControlMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomResources = append(m.CustomResources, &CustomResourceInfo{})
+			if err := m.CustomResources[len(m.CustomResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -9494,7 +14153,7 @@ func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DestroyEnvironmentReply) Unmarshal(dAtA []byte) error {
+func (m *CustomResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9517,17 +14176,17 @@ func (m *DestroyEnvironmentReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DestroyEnvironmentReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DestroyEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanupTasksReply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9537,27 +14196,87 @@ func (m *DestroyEnvironmentReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CleanupTasksReply == nil {
-				m.CleanupTasksReply = &CleanupTasksReply{}
-			}
-			if err := m.CleanupTasksReply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9584,7 +14303,7 @@ func (m *DestroyEnvironmentReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
+func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9607,15 +14326,15 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShortTaskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShortTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9643,11 +14362,11 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9664,10 +14383,10 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Locked = bool(v != 0)
+			m.Shell = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9695,11 +14414,11 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9727,11 +14446,11 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Arguments = append(m.Arguments, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9759,11 +14478,65 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9791,13 +14564,13 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9807,27 +14580,55 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeploymentInfo == nil {
-				m.DeploymentInfo = &TaskDeploymentInfo{}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			if err := m.DeploymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9854,7 +14655,7 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
+func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9864,30 +14665,136 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowO2Control
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShortInfo == nil {
+				m.ShortInfo = &ShortTaskInfo{}
+			}
+			if err := m.ShortInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClassInfo == nil {
+				m.ClassInfo = &TaskClassInfo{}
+			}
+			if err := m.ClassInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InboundChannels = append(m.InboundChannels, &ChannelInfo{})
+			if err := m.InboundChannels[len(m.InboundChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskDeploymentInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskDeploymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9897,29 +14804,31 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hostname = string(dAtA[iNdEx:postIndex])
+			m.OutboundChannels = append(m.OutboundChannels, &ChannelInfo{})
+			if err := m.OutboundChannels[len(m.OutboundChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9929,27 +14838,31 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
+			if m.CommandInfo == nil {
+				m.CommandInfo = &CommandInfo{}
+			}
+			if err := m.CommandInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9977,11 +14890,11 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OfferId = string(dAtA[iNdEx:postIndex])
+			m.TaskPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10009,119 +14922,11 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			m.EnvId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTasksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTasksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTasksReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTasksReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10148,8 +14953,8 @@ func (m *GetTasksReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &ShortTaskInfo{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CustomResources = append(m.CustomResources, &CustomResourceInfo{})
+			if err := m.CustomResources[len(m.CustomResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10178,7 +14983,7 @@ func (m *GetTasksReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *CleanupTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10201,15 +15006,15 @@ func (m *GetTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanupTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanupTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10237,7 +15042,7 @@ func (m *GetTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10264,7 +15069,7 @@ func (m *GetTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
+func (m *CleanupTasksReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10287,15 +15092,15 @@ func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanupTasksReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanupTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KilledTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10322,10 +15127,42 @@ func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &TaskInfo{}
+			m.KilledTasks = append(m.KilledTasks, &ShortTaskInfo{})
+			if err := m.KilledTasks[len(m.KilledTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningTasks = append(m.RunningTasks, &ShortTaskInfo{})
+			if err := m.RunningTasks[len(m.RunningTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10354,7 +15191,7 @@ func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
+func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10377,15 +15214,15 @@ func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskClassInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskClassInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10413,11 +15250,11 @@ func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.EnvId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathSpec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10445,7 +15282,7 @@ func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControlMode = string(dAtA[iNdEx:postIndex])
+			m.PathSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10472,7 +15309,7 @@ func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommandInfo) Unmarshal(dAtA []byte) error {
+func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10495,15 +15332,15 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommandInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommandInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10531,13 +15368,13 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -10547,15 +15384,27 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Shell = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10583,11 +15432,11 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FullPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10615,11 +15464,11 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Arguments = append(m.Arguments, string(dAtA[iNdEx:postIndex]))
+			m.FullPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10647,7 +15496,41 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, &RoleInfo{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10674,7 +15557,7 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
+func (m *GetRolesReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10697,17 +15580,17 @@ func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRolesReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRolesReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -10717,88 +15600,80 @@ func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			m.Roles = append(m.Roles, &RoleInfo{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -10824,7 +15699,7 @@ func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskInfo) Unmarshal(dAtA []byte) error {
+func (m *GetHostsReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10847,15 +15722,15 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHostsReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHostsReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10882,18 +15757,70 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShortInfo == nil {
-				m.ShortInfo = &ShortTaskInfo{}
-			}
-			if err := m.ShortInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hosts = append(m.Hosts, &HostInfo{})
+			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -10903,65 +15830,81 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClassInfo == nil {
-				m.ClassInfo = &TaskClassInfo{}
-			}
-			if err := m.ClassInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InboundChannels = append(m.InboundChannels, &ChannelInfo{})
-			if err := m.InboundChannels[len(m.InboundChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHostReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHostReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHostReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10988,50 +15931,70 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundChannels = append(m.OutboundChannels, &ChannelInfo{})
-			if err := m.OutboundChannels[len(m.OutboundChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Host == nil {
+				m.Host = &HostInfo{}
+			}
+			if err := m.Host.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommandInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommandInfo == nil {
-				m.CommandInfo = &CommandInfo{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetHostStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
 			}
-			if err := m.CommandInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetHostStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetHostStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11059,11 +16022,11 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskPath = string(dAtA[iNdEx:postIndex])
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11091,7 +16054,7 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvId = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11118,7 +16081,7 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CleanupTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *SetHostStateReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11141,17 +16104,17 @@ func (m *CleanupTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CleanupTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetHostStateReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CleanupTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetHostStateReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -11161,23 +16124,27 @@ func (m *CleanupTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
+			if m.Host == nil {
+				m.Host = &HostInfo{}
+			}
+			if err := m.Host.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11204,7 +16171,7 @@ func (m *CleanupTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CleanupTasksReply) Unmarshal(dAtA []byte) error {
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11227,15 +16194,79 @@ func (m *CleanupTasksReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CleanupTasksReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CleanupTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KilledTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11262,14 +16293,107 @@ func (m *CleanupTasksReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KilledTasks = append(m.KilledTasks, &ShortTaskInfo{})
-			if err := m.KilledTasks[len(m.KilledTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowO2Control
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipO2Control(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Attributes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunningTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11296,68 +16420,173 @@ func (m *CleanupTasksReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunningTasks = append(m.RunningTasks, &ShortTaskInfo{})
-			if err := m.RunningTasks[len(m.RunningTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Resources == nil {
+				m.Resources = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowO2Control
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipO2Control(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Resources[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOffered", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			m.LastOffered = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Tasks = append(m.Tasks, &ShortTaskInfo{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRolesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvironmentIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11385,11 +16614,11 @@ func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvId = string(dAtA[iNdEx:postIndex])
+			m.EnvironmentIds = append(m.EnvironmentIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11417,7 +16646,7 @@ func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathSpec = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11444,7 +16673,7 @@ func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoleInfo) Unmarshal(dAtA []byte) error {
+func (m *GetWorkflowTemplatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11467,111 +16696,69 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoleInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkflowTemplatesRequest: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplateInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11599,11 +16786,11 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FullPath = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11631,11 +16818,11 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11662,8 +16849,8 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, &RoleInfo{})
-			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, &WorkflowParameterInfo{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11692,7 +16879,7 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRolesReply) Unmarshal(dAtA []byte) error {
+func (m *WorkflowParameterInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11715,17 +16902,17 @@ func (m *GetRolesReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRolesReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowParameterInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRolesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowParameterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -11735,137 +16922,111 @@ func (m *GetRolesReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, &RoleInfo{})
-			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetWorkflowTemplatesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowTemplatesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkflowTemplateInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.DefaultValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowTemplateInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowTemplateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Required = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11893,11 +17054,11 @@ func (m *WorkflowTemplateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11925,7 +17086,7 @@ func (m *WorkflowTemplateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
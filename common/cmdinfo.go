@@ -86,7 +86,7 @@ func (m *CommandInfo) Copy() *CommandInfo {
 func (m *CommandInfo) Equals(other *CommandInfo) (response bool) {
 	response = true
 	if m == nil || other == nil {
-		return false
+		return m == other
 	}
 
 	if len(m.Env) != len(other.Env) ||
@@ -105,15 +105,15 @@ func (m *CommandInfo) Equals(other *CommandInfo) (response bool) {
 		}
 	}
 	if !((m.Value == nil && other.Value == nil) ||
-		 *m.Value == *other.Value) {
+		 (m.Value != nil && other.Value != nil && *m.Value == *other.Value)) {
 		return false
 	}
 	if !((m.User == nil && other.User == nil) ||
-		 *m.User == *other.User) {
+		 (m.User != nil && other.User != nil && *m.User == *other.User)) {
 		return false
 	}
 	if !((m.Shell == nil && other.Shell == nil) ||
-		 *m.Shell == *other.Shell) {
+		 (m.Shell != nil && other.Shell != nil && *m.Shell == *other.Shell)) {
 		return false
 	}
 	return
@@ -0,0 +1,65 @@
+package common_test
+
+import (
+	"github.com/AliceO2Group/Control/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func strPtr(s string) *string { return &s }
+func boolPtr(b bool) *bool    { return &b }
+
+var _ = Describe("CommandInfo", func() {
+	full := func() *common.CommandInfo {
+		return &common.CommandInfo{
+			Env:       []string{"O2_ROLE=readout"},
+			Shell:     boolPtr(true),
+			Value:     strPtr("readout.exe"),
+			Arguments: []string{"--rate", "10"},
+			User:      strPtr("flp"),
+		}
+	}
+
+	DescribeTable("comparing",
+		func(a *common.CommandInfo, b *common.CommandInfo, expected bool) {
+			Expect(a.Equals(b)).To(Equal(expected))
+			Expect(b.Equals(a)).To(Equal(expected))
+		},
+		Entry("two nil commands", nil, nil, true),
+		Entry("a nil command", full(), nil, false),
+		Entry("equal commands", full(), full(), true),
+		Entry("empty commands", &common.CommandInfo{}, &common.CommandInfo{}, true),
+		Entry("a missing value", full(), func() *common.CommandInfo {
+			c := full()
+			c.Value = nil
+			return c
+		}(), false),
+		Entry("a missing user", full(), func() *common.CommandInfo {
+			c := full()
+			c.User = nil
+			return c
+		}(), false),
+		Entry("a missing shell setting", full(), func() *common.CommandInfo {
+			c := full()
+			c.Shell = nil
+			return c
+		}(), false),
+		Entry("different values", full(), func() *common.CommandInfo {
+			c := full()
+			c.Value = strPtr("stfbuilder")
+			return c
+		}(), false),
+		Entry("different arguments", full(), func() *common.CommandInfo {
+			c := full()
+			c.Arguments = []string{"--rate", "20"}
+			return c
+		}(), false),
+		Entry("different environments", full(), func() *common.CommandInfo {
+			c := full()
+			c.Env = append(c.Env, "VERBOSE=1")
+			return c
+		}(), false),
+	)
+})
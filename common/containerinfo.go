@@ -0,0 +1,136 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// CONTAINER_RUNTIME_ENV is the environment variable through which the core
+// tells its executors which container runtime to run containerized tasks with.
+const CONTAINER_RUNTIME_ENV = "O2_CONTAINER_RUNTIME"
+
+// ContainerInfo describes the container image in which a task runs, instead of
+// running directly on the host. The executor runs it through the container
+// runtime configured in the core, see RuntimeArguments. In a task class it is
+// written as
+//   container:
+//     image: registry.cern.ch/alice/readout:v1.2
+//     volumes:
+//     - host: /data
+//       container: /data
+//       mode: ro                   # or rw, defaults to rw
+//     devices:
+//     - /dev/infiniband/uverbs0
+//     privileged: true
+type ContainerInfo struct {
+	Image      string            `json:"image" yaml:"image"`
+	Volumes    []ContainerVolume `json:"volumes,omitempty" yaml:"volumes,omitempty"`
+	Devices    []string          `json:"devices,omitempty" yaml:"devices,omitempty"`
+	Privileged bool              `json:"privileged,omitempty" yaml:"privileged,omitempty"`
+}
+
+type ContainerVolume struct {
+	Host      string `json:"host" yaml:"host"`
+	Container string `json:"container" yaml:"container"`
+	Mode      string `json:"mode,omitempty" yaml:"mode,omitempty"`
+}
+
+func (m *ContainerInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _containerInfo ContainerInfo
+	aux := _containerInfo{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+	ci := ContainerInfo(aux)
+	if len(ci.Image) == 0 {
+		return fmt.Errorf("container without image")
+	}
+	for i, v := range ci.Volumes {
+		if len(v.Host) == 0 || len(v.Container) == 0 {
+			return fmt.Errorf("container volume %d needs both a host and a container path", i)
+		}
+		switch strings.ToLower(v.Mode) {
+		case "", "rw", "ro":
+		default:
+			return fmt.Errorf("container volume %s: unknown mode %s", v.Container, v.Mode)
+		}
+	}
+	*m = ci
+	return
+}
+
+func (m *ContainerInfo) Copy() *ContainerInfo {
+	if m == nil {
+		return nil
+	}
+	ci := *m
+	ci.Volumes = append([]ContainerVolume{}, m.Volumes...)
+	ci.Devices = append([]string{}, m.Devices...)
+	return &ci
+}
+
+func (m *ContainerInfo) Equals(other *ContainerInfo) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return reflect.DeepEqual(*m, *other)
+}
+
+func (v ContainerVolume) IsReadOnly() bool {
+	return strings.ToLower(v.Mode) == "ro"
+}
+
+// RuntimeArguments returns the arguments with which a Docker-compatible
+// container runtime (e.g. docker or podman) runs command in this container.
+// The container shares the network of the host, so that the task can be
+// reached on its bind and control ports as if it ran on the host.
+func (m *ContainerInfo) RuntimeArguments(name string, env []string, command []string) (args []string) {
+	args = []string{"run", "--rm", "--name", name, "--network", "host"}
+	if m.Privileged {
+		args = append(args, "--privileged")
+	}
+	for _, v := range m.Volumes {
+		volume := v.Host + ":" + v.Container
+		if v.IsReadOnly() {
+			volume += ":ro"
+		}
+		args = append(args, "--volume", volume)
+	}
+	for _, device := range m.Devices {
+		args = append(args, "--device", device)
+	}
+	for _, e := range env {
+		args = append(args, "--env", e)
+	}
+	args = append(args, m.Image)
+	return append(args, command...)
+}
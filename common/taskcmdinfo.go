@@ -30,4 +30,5 @@ type TaskCommandInfo struct {
 	CommandInfo
 	ControlPort uint64                  `json:"controlPort"`
 	ControlMode controlmode.ControlMode `json:"controlMode"`
+	Container   *ContainerInfo          `json:"container,omitempty"`
 }
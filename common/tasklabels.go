@@ -0,0 +1,67 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package common
+
+import (
+	"github.com/mesos/mesos-go/api/v1/lib"
+)
+
+// Labels which the core attaches to each Mesos task at launch. The executor
+// copies them into its status updates, along with the current state of the
+// task, so that the core can rebuild its roster through reconciliation.
+const (
+	TASK_LABEL_CLASS        = "o2.taskClass"
+	TASK_LABEL_HOSTNAME     = "o2.hostname"
+	TASK_LABEL_OFFER_ID     = "o2.offerId"
+	TASK_LABEL_BIND_PORTS   = "o2.bindPorts" // JSON object of channel name to port
+	TASK_LABEL_CONTROL_PORT = "o2.controlPort"
+	TASK_LABEL_STATE        = "o2.state"
+)
+
+// GetTaskLabel returns the value of the label with the given key, if any.
+func GetTaskLabel(labels *mesos.Labels, key string) (value string, ok bool) {
+	for _, label := range labels.GetLabels() {
+		if label.GetKey() == key {
+			return label.GetValue(), true
+		}
+	}
+	return
+}
+
+// WithTaskLabel returns a copy of labels with the label key set to value.
+func WithTaskLabel(labels *mesos.Labels, key string, value string) *mesos.Labels {
+	result := &mesos.Labels{Labels: make([]mesos.Label, 0, len(labels.GetLabels()) + 1)}
+	for _, label := range labels.GetLabels() {
+		if label.GetKey() != key {
+			result.Labels = append(result.Labels, label)
+		}
+	}
+	v := value
+	result.Labels = append(result.Labels, mesos.Label{Key: key, Value: &v})
+	return result
+}
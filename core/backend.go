@@ -0,0 +1,74 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/mesos/mesos-go/api/v1/lib/extras/store"
+)
+
+// schedulerBackend deploys tasks and relays commands to them on behalf of the
+// core. The default backend goes through Mesos, the local backend runs tasks as
+// child processes of the core itself.
+type schedulerBackend interface {
+	// run connects the backend and then handles task deployment requests from
+	// the task manager, until ctx is done or the backend fails.
+	run(ctx context.Context) error
+	killTask(ctx context.Context, receiver controlcommands.MesosCommandTarget) error
+	sendCommand(ctx context.Context, command controlcommands.MesosCommand, receiver controlcommands.MesosCommandTarget) error
+}
+
+func newSchedulerBackend(name string, state *internalState, fidStore store.Singleton) (schedulerBackend, error) {
+	switch name {
+	case "mesos":
+		return &mesosBackend{state: state, fidStore: fidStore}, nil
+	case "local":
+		return newLocalBackend(state)
+	}
+	return nil, fmt.Errorf("unknown scheduler backend %s, expected mesos or local", name)
+}
+
+type mesosBackend struct {
+	state    *internalState
+	fidStore store.Singleton
+}
+
+func (b *mesosBackend) run(ctx context.Context) error {
+	return runSchedulerController(ctx, b.state, b.fidStore)
+}
+
+func (b *mesosBackend) killTask(ctx context.Context, receiver controlcommands.MesosCommandTarget) error {
+	return KillTask(ctx, b.state, receiver)
+}
+
+func (b *mesosBackend) sendCommand(ctx context.Context, command controlcommands.MesosCommand, receiver controlcommands.MesosCommandTarget) error {
+	return SendCommand(ctx, b.state, command, receiver)
+}
@@ -29,14 +29,17 @@ import (
 	"fmt"
 	"github.com/AliceO2Group/Control/common/product"
 	"github.com/AliceO2Group/Control/common/utils"
+	"github.com/AliceO2Group/Control/core/task"
 	"github.com/mesos/mesos-go/api/v1/cmd"
 	"github.com/mesos/mesos-go/api/v1/lib/encoding/codecs"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"golang.org/x/sys/unix"
+	"gopkg.in/yaml.v2"
 	"net/url"
 	"os"
 	"path/filepath"
+	"runtime"
 )
 
 import _ "github.com/spf13/viper/remote"
@@ -48,13 +51,20 @@ func setDefaults() error {
 	}
 	exeDir := filepath.Dir(exe)
 
+	viper.SetDefault("backend", "mesos")
+	viper.SetDefault("containerRuntime", "")
 	viper.SetDefault("controlPort", 47102)
 	viper.SetDefault("coreConfigurationUri", "consul://127.0.0.1:8500") //TODO: TBD
 	viper.SetDefault("defaultRepo", "github.com/AliceO2Group/ControlWorkflows")
 	viper.SetDefault("executor", env("EXEC_BINARY", filepath.Join(exeDir, "o2control-executor")))
 	viper.SetDefault("executorCPU", envFloat("EXEC_CPU", "0.01"))
 	viper.SetDefault("executorMemory", envFloat("EXEC_MEMORY", "64"))
+	viper.SetDefault("executorPolicy", "host")
+	viper.SetDefault("executorIdleTimeout", "10m")
+	viper.SetDefault("executorIdleCheckInterval", "30s")
 	viper.SetDefault("instanceName", fmt.Sprintf("%s instance", product.PRETTY_SHORTNAME))
+	viper.SetDefault("localCPU", float64(runtime.NumCPU()))
+	viper.SetDefault("localMemory", 4096)
 	viper.SetDefault("mesosApiTimeout", envDuration("MESOS_CONNECT_TIMEOUT", "20s"))
 	viper.SetDefault("mesosAuthMode", env("AUTH_MODE", ""))
 	viper.SetDefault("mesosCheckpoint", true)
@@ -67,12 +77,18 @@ func setDefaults() error {
 	viper.SetDefault("mesosFrameworkHostname", "")
 	viper.SetDefault("mesosFrameworkName", env("FRAMEWORK_NAME", product.NAME))
 	viper.SetDefault("mesosFrameworkRole", "")
+	viper.SetDefault("mesosFrameworkRoles", []string{})
 	viper.SetDefault("mesosFrameworkUser", env("FRAMEWORK_USER", "root"))
 	viper.SetDefault("mesosGpuClusterCompat", false)
 	viper.SetDefault("mesosJobRestartDelay", envDuration("JOB_RESTART_DELAY", "5s"))
 	viper.SetDefault("mesosLabels", Labels{})
 	viper.SetDefault("mesosMaxRefuseSeconds", envDuration("MAX_REFUSE_SECONDS", "5s"))
+	viper.SetDefault("mesosDeploymentRefuseSeconds", "1s")
+	viper.SetDefault("mesosOfferWaitTimeout", "30s")
+	viper.SetDefault("mesosSuppressOffersAfter", "30s")
 	viper.SetDefault("mesosPrincipal", "")
+	viper.SetDefault("mesosReservedRole", "")
+	viper.SetDefault("mesosDynamicReservations", false)
 	viper.SetDefault("mesosReviveBurst", envInt("REVIVE_BURST", "3"))
 	viper.SetDefault("mesosReviveWait", envDuration("REVIVE_WAIT", "1s"))
 	viper.SetDefault("mesosResourceTypeMetrics", false)
@@ -82,6 +98,8 @@ func setDefaults() error {
 	viper.SetDefault("metrics.address", env("LIBPROCESS_IP", "127.0.0.1"))
 	viper.SetDefault("metrics.port", envInt("PORT0", "64009"))
 	viper.SetDefault("metrics.path", env("METRICS_API_PATH", "/metrics"))
+	viper.SetDefault("portPools.bind", "9000-29999")
+	viper.SetDefault("portPools.control", "30000-65535")
 	viper.SetDefault("repositoriesPath", "/etc/aliecs.d/repos")
 	viper.SetDefault("summaryMetrics", false)
 	viper.SetDefault("verbose", false)
@@ -92,12 +110,19 @@ func setDefaults() error {
 }
 
 func setFlags() error {
+	pflag.String("backend", viper.GetString("backend"), "Scheduler backend to deploy tasks with: mesos, or local to run tasks as child processes of the core without a Mesos master")
+	pflag.String("containerRuntime", viper.GetString("containerRuntime"), "Path of the Docker-compatible container runtime (e.g. docker or podman) with which executors run the tasks of classes which declare a container image")
 	pflag.Int("controlPort", viper.GetInt("controlPort"), "Port of control server")
 	pflag.String("coreConfigurationUri", viper.GetString("coreConfigurationUri"), "URI of the Consul server or YAML configuration file, used for core configuration.")
 	pflag.String("executor", viper.GetString("executor"), "Full path to executor binary on Mesos agents")
 	pflag.Float64("executorCPU", viper.GetFloat64("executorCPU"), "CPU resources to consume per-executor")
 	pflag.Float64("executorMemory", viper.GetFloat64("executorMemory"), "Memory resources (MB) to consume per-executor")
+	pflag.String("executorPolicy", viper.GetString("executorPolicy"), "Which tasks share an executor on a host: host (all tasks), environment (the tasks of an environment) or task (none)")
+	pflag.Duration("executorIdleTimeout", viper.GetDuration("executorIdleTimeout"), "How long an executor may run without tasks before it is shut down, unless each task has its own executor")
+	pflag.Duration("executorIdleCheckInterval", viper.GetDuration("executorIdleCheckInterval"), "Interval between checks for idle executors")
 	pflag.String("instanceName", viper.GetString("instanceName"), "User-visible name for this AliECS instance.")
+	pflag.Float64("localCPU", viper.GetFloat64("localCPU"), "CPU resources available to tasks with the local backend")
+	pflag.Float64("localMemory", viper.GetFloat64("localMemory"), "Memory resources (MB) available to tasks with the local backend")
 	pflag.Duration("mesosApiTimeout", viper.GetDuration("mesosApiTimeout"), "Mesos scheduler API connection timeout")
 	pflag.String("mesosAuthMode", viper.GetString("mesosAuthMode"), "Method to use for Mesos authentication; specify '"+AuthModeBasic+"' for simple HTTP authentication")
 	pflag.Bool("mesosCheckpoint", viper.GetBool("mesosCheckpoint"), "Enable/disable agent checkpointing for framework tasks (recover from agent failure)")
@@ -107,11 +132,17 @@ func setFlags() error {
 	pflag.String("mesosFrameworkHostname", viper.GetString("mesosFrameworkHostname"), "Framework hostname that is advertised to the master")
 	pflag.String("mesosFrameworkName", viper.GetString("mesosFrameworkName"), "Framework name to register with the Mesos master")
 	pflag.String("mesosFrameworkRole", viper.GetString("mesosFrameworkRole"), "Framework role to register with the Mesos master")
+	pflag.StringSlice("mesosFrameworkRoles", viper.GetStringSlice("mesosFrameworkRoles"), "Framework roles to register with the Mesos master, instead of mesosFrameworkRole")
 	pflag.String("mesosFrameworkUser", viper.GetString("mesosFrameworkUser"), "Framework user to register with the Mesos master")
 	pflag.Bool("mesosGpuClusterCompat", viper.GetBool("mesosGpuClusterCompat"), "When true the framework will receive offers from agents w/ GPU resources.")
 	pflag.Duration("mesosJobRestartDelay", viper.GetDuration("mesosJobRestartDelay"), "Duration between job (internal service) restarts between failures")
 	pflag.Duration("mesosMaxRefuseSeconds", viper.GetDuration("mesosMaxRefuseSeconds"), "Max length of time to refuse future offers")
+	pflag.Duration("mesosDeploymentRefuseSeconds", viper.GetDuration("mesosDeploymentRefuseSeconds"), "Length of time to refuse the offers declined while a deployment waits for more offers")
+	pflag.Duration("mesosOfferWaitTimeout", viper.GetDuration("mesosOfferWaitTimeout"), "How long a deployment waits for offers which can run all of its tasks before it fails")
+	pflag.Duration("mesosSuppressOffersAfter", viper.GetDuration("mesosSuppressOffersAfter"), "Suppress offers after this long without anything to deploy, 0 to never suppress them")
 	pflag.String("mesosPrincipal", viper.GetString("mesosPrincipal"), "Framework principal with which to authenticate")
+	pflag.String("mesosReservedRole", viper.GetString("mesosReservedRole"), "Only run tasks on resources reserved for this framework role, e.g. flp")
+	pflag.Bool("mesosDynamicReservations", viper.GetBool("mesosDynamicReservations"), "Reserve unreserved resources for mesosReservedRole as tasks are launched, and release them when their environment is torn down")
 	pflag.Int("mesosReviveBurst", viper.GetInt("mesosReviveBurst"), "Number of revive messages that may be sent in a burst within revive-wait period")
 	pflag.Duration("mesosReviveWait", viper.GetDuration("mesosReviveWait"), "Wait this long to fully recharge revive-burst quota")
 	pflag.Bool("mesosResourceTypeMetrics", viper.GetBool("mesosResourceTypeMetrics"), "Collect scalar resource metrics per-type")
@@ -121,6 +152,8 @@ func setFlags() error {
 	pflag.String("metrics.address", viper.GetString("metrics.address"), "IP of metrics server")
 	pflag.Int("metrics.port", viper.GetInt("metrics.port"), "Port of metrics server (listens on server.address)")
 	pflag.String("metrics.path", viper.GetString("metrics.path"), "URI path to metrics endpoint")
+	pflag.String("portPools.bind", viper.GetString("portPools.bind"), "Port ranges from which task bind ports are allocated, e.g. 9000-29999")
+	pflag.String("portPools.control", viper.GetString("portPools.control"), "Port ranges from which task control ports are allocated, e.g. 30000-65535")
 	pflag.Bool("summaryMetrics", viper.GetBool("summaryMetrics"), "Collect summary metrics for tasks launched per-offer-cycle, offer processing time, etc.")
 	pflag.String("repositoriesPath", viper.GetString("repositoriesPath"), "Path to git-managed configuration repositories")
 	pflag.Bool("verbose", viper.GetBool("verbose"), "Verbose logging")
@@ -172,6 +205,26 @@ func sanitizeReposPath() {
 	viper.Set("repositoriesPath", sanitizedReposPath)
 }
 
+// portPoolsFromConfig builds the pools of the port allocator. The default pools
+// are set with portPools.bind and portPools.control, while the pools of
+// specific hosts can only be listed in the core configuration, e.g.
+//   portPools:
+//     hosts:
+//     - constraints:
+//       - attribute: machine_id
+//         value: flp001
+//       bind: "10000-19999"
+func portPoolsFromConfig() (pools task.PortPools, err error) {
+	var hostsYaml []byte
+	if hosts := viper.Get("portPools.hosts"); hosts != nil {
+		hostsYaml, err = yaml.Marshal(hosts)
+		if err != nil {
+			return
+		}
+	}
+	return task.ParsePortPools(viper.GetString("portPools.bind"), viper.GetString("portPools.control"), hostsYaml)
+}
+
 // Bind environment variables with the prefix ALIECS
 // e.g. ALIECS_EXECUTORCPU
 func bindEnvironmentVariables() {
@@ -0,0 +1,13 @@
+package confsys
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestConfsys(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Confsys Suite")
+}
@@ -25,6 +25,7 @@
 package confsys
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -62,6 +63,9 @@ func Instance() *Service {
 
 type Service struct {
 	src configuration.Source
+
+	// The host states are stored together, so each change is a read-modify-write
+	hostStatesMu sync.Mutex
 }
 
 /* Expected structure:
@@ -71,6 +75,9 @@ type Service struct {
         - or -
 	run_number: 47102,
 
+	host_states: "{\"flp042\": \"MAINTENANCE\"}",
+	framework_id: "b7e3ac4c-...-0000",
+
 	settings: {
 		log_level: "DEBUG"
 	},
@@ -165,6 +172,74 @@ func (s *Service) NewRunNumber() (runNumber uint32, err error) {
 	}
 }
 
+// GetFrameworkId returns the Mesos framework ID with which the core last
+// subscribed, if any.
+func (s *Service) GetFrameworkId() (frameworkId string, err error) {
+	var exists bool
+	exists, err = s.src.Exists("o2/control/framework_id")
+	if err != nil || !exists {
+		return
+	}
+	frameworkId, err = s.src.Get("o2/control/framework_id")
+	frameworkId = strings.TrimSpace(frameworkId)
+	return
+}
+
+// SetFrameworkId persists the Mesos framework ID, so that after a restart the
+// core can resubscribe as the same framework. An empty ID clears it.
+func (s *Service) SetFrameworkId(frameworkId string) error {
+	return s.src.Put("o2/control/framework_id", frameworkId)
+}
+
+// GetHostStates returns the persisted state of every host which was drained
+// or put under maintenance, keyed by hostname.
+func (s *Service) GetHostStates() (hostStates map[string]string, err error) {
+	s.hostStatesMu.Lock()
+	defer s.hostStatesMu.Unlock()
+	return s.getHostStates()
+}
+
+func (s *Service) getHostStates() (hostStates map[string]string, err error) {
+	hostStates = make(map[string]string)
+	var exists bool
+	exists, err = s.src.Exists("o2/control/host_states")
+	if err != nil || !exists {
+		return
+	}
+	var raw string
+	raw, err = s.src.Get("o2/control/host_states")
+	if err != nil || len(strings.TrimSpace(raw)) == 0 {
+		return
+	}
+	err = json.Unmarshal([]byte(raw), &hostStates)
+	return
+}
+
+// SetHostState persists the state of a host. An empty state clears it.
+// Concurrent calls are serialized, so that none of them loses the changes of
+// another.
+func (s *Service) SetHostState(hostname string, state string) (err error) {
+	s.hostStatesMu.Lock()
+	defer s.hostStatesMu.Unlock()
+
+	var hostStates map[string]string
+	hostStates, err = s.getHostStates()
+	if err != nil {
+		return
+	}
+	if len(state) == 0 {
+		delete(hostStates, hostname)
+	} else {
+		hostStates[hostname] = state
+	}
+	var raw []byte
+	raw, err = json.Marshal(hostStates)
+	if err != nil {
+		return
+	}
+	return s.src.Put("o2/control/host_states", string(raw))
+}
+
 func (s *Service) GetROSource() configuration.ROSource {
 	return s.src
 }
@@ -0,0 +1,75 @@
+package confsys
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("host states", func() {
+	var (
+		dir string
+		uri string
+		svc *Service
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "confsys")
+		Expect(err).NotTo(HaveOccurred())
+		path := filepath.Join(dir, "config.yaml")
+		Expect(ioutil.WriteFile(path, []byte("o2:\n  control: {}\n"), 0644)).To(Succeed())
+		uri = "file://" + path
+		svc, err = newService(uri)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should have none before any is set", func() {
+		Expect(svc.GetHostStates()).To(BeEmpty())
+	})
+
+	It("should persist the state of each host until it is cleared", func() {
+		Expect(svc.SetHostState("flp1", "DRAINING")).To(Succeed())
+		Expect(svc.SetHostState("flp2", "MAINTENANCE")).To(Succeed())
+		Expect(svc.SetHostState("flp1", "MAINTENANCE")).To(Succeed())
+
+		// Another instance, as after a restart of the core
+		restarted, err := newService(uri)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restarted.GetHostStates()).To(Equal(map[string]string{
+			"flp1": "MAINTENANCE",
+			"flp2": "MAINTENANCE",
+		}))
+
+		Expect(restarted.SetHostState("flp2", "")).To(Succeed())
+		Expect(svc.GetHostStates()).To(Equal(map[string]string{"flp1": "MAINTENANCE"}))
+	})
+
+	It("should not lose any of the states set concurrently", func() {
+		const hosts = 50
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		for i := 0; i < hosts; i++ {
+			wg.Add(1)
+			go func(hostname string) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				<-start
+				Expect(svc.SetHostState(hostname, "DRAINING")).To(Succeed())
+			}(fmt.Sprintf("flp%d", i))
+		}
+		close(start)
+		wg.Wait()
+
+		Expect(svc.GetHostStates()).To(HaveLen(hosts))
+	})
+})
@@ -32,6 +32,7 @@ import (
 
 	"fmt"
 	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/core/the"
 	"github.com/looplab/fsm"
 	"github.com/mesos/mesos-go/api/v1/lib/extras/scheduler/callrules"
 	"github.com/mesos/mesos-go/api/v1/lib/extras/store"
@@ -65,6 +66,17 @@ func Run() error {
 	if err != nil {
 		return err
 	}
+	loadHostStates(state.taskman)
+	state.taskman.PortPools, err = portPoolsFromConfig()
+	if err != nil {
+		return fmt.Errorf("bad port pools configuration: %s", err.Error())
+	}
+	if _, err = executorPolicyFromString(viper.GetString("executorPolicy")); err != nil {
+		return err
+	}
+	if err = validateReservationConfig(); err != nil {
+		return fmt.Errorf("bad reservation configuration: %s", err.Error())
+	}
 
 	// TODO(jdef) how to track/handle timeout errors that occur for SUBSCRIBE calls? we should
 	// probably tolerate X number of subsequent subscribe failures before bailing. we'll need
@@ -73,10 +85,27 @@ func Run() error {
 	// store.Singleton is a thread-safe abstraction to load and store and string,
 	// provided by mesos-go.
 	// We also make sure that a log message is printed when the FrameworkID changes.
+	// The frameworkId is also persisted in the configuration store: after a
+	// restart we resubscribe as the same framework, so that reconciliation can
+	// bring the tasks we left running back into the roster.
+	inMemoryFidStore := store.NewInMemorySingleton()
+	if viper.GetDuration("mesosFailoverTimeout") > 0 {
+		frameworkId, err := the.ConfSvc().GetFrameworkId()
+		if err != nil {
+			log.WithError(err).Warning("cannot retrieve persisted frameworkId")
+		} else if len(frameworkId) > 0 {
+			log.WithField("frameworkId", frameworkId).Info("resuming with persisted frameworkId")
+			_ = inMemoryFidStore.Set(frameworkId)
+		}
+	}
 	fidStore := store.DecorateSingleton(
-		store.NewInMemorySingleton(),
+		inMemoryFidStore,
 		store.DoSet().AndThen(func(_ store.Setter, v string, _ error) error {
 			log.WithField("frameworkId", v).Debug("generated new frameworkId")
+			err := the.ConfSvc().SetFrameworkId(v)
+			if err != nil {
+				log.WithError(err).Warning("cannot persist frameworkId")
+			}
 			return nil
 		}))
 
@@ -90,6 +119,11 @@ func Run() error {
 		callMetrics(state.metricsAPI, time.Now, viper.GetBool("summaryMetrics")),
 	).Caller(state.cli)
 
+	state.backend, err = newSchedulerBackend(viper.GetString("backend"), state, fidStore)
+	if err != nil {
+		return err
+	}
+
 	state.sm = fsm.NewFSM(
 		"INITIAL",
 		fsm.Events{
@@ -135,9 +169,9 @@ func Run() error {
 	// We now build the Control server
 	s := NewServer(state, fidStore)
 
-	// Async start of the scheduler controller. This runs in parallel with the grpc server.
+	// Async start of the scheduler backend. This runs in parallel with the grpc server.
 	go func() {
-		err = runSchedulerController(ctx, state, fidStore)
+		err = state.backend.run(ctx)
 		state.RLock()
 		defer state.RUnlock()
 		if state.err != nil {
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/mesostest"
+	"github.com/looplab/fsm"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/extras/scheduler/callrules"
+	"github.com/mesos/mesos-go/api/v1/lib/extras/store"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	"github.com/spf13/viper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const testAgentId = "test-agent-1"
+
+var (
+	master   *mesostest.Master
+	state    *internalState
+	fidStore store.Singleton
+	cancel   context.CancelFunc
+	cfgDir   string
+)
+
+func TestCore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Core Suite")
+}
+
+// The scheduler controller and its metrics are process-wide, so a single core
+// instance runs against the fake Mesos master for the whole suite.
+var _ = BeforeSuite(func() {
+	Expect(setDefaults()).To(Succeed())
+
+	master = mesostest.NewMaster(50 * time.Millisecond)
+	master.AddAgent(mesostest.Agent{
+		Id:       testAgentId,
+		Hostname: "test-host-1",
+		Resources: mesos.Resources{
+			resources.NewCPUs(4).Resource,
+			resources.NewMemory(4096).Resource,
+			portsResource(resources.BuildRanges().Span(31000, 32000).Ranges),
+		},
+		Attributes: []mesos.Attribute{{
+			Name: "machine_id",
+			Type: mesos.TEXT,
+			Text: &mesos.Value_Text{Value: "test1"},
+		}},
+	})
+	master.ExecutorLauncher = master.RunFakeExecutor
+
+	// The scheduler reads its configuration as it runs, so all of it must be
+	// set before it starts.
+	viper.Set("mesosUrl", master.SchedulerURL())
+	viper.Set("executor", "/bin/true")
+	viper.Set("mesosExecutorImage", "")
+	viper.Set("metrics.port", 0)
+	viper.Set("mesosOfferWaitTimeout", time.Second)
+	viper.Set("mesosDeploymentRefuseSeconds", 200*time.Millisecond)
+	viper.Set("executorIdleTimeout", time.Minute)
+
+	// What the core persists, such as host states, goes to a file of its own
+	var err error
+	cfgDir, err = ioutil.TempDir("", "core")
+	Expect(err).NotTo(HaveOccurred())
+	cfgPath := filepath.Join(cfgDir, "config.yaml")
+	Expect(ioutil.WriteFile(cfgPath, []byte("o2:\n  control: {}\n"), 0644)).To(Succeed())
+	viper.Set("globalConfigurationUri", "file://"+cfgPath)
+
+	var ctx context.Context
+	ctx, cancel = context.WithCancel(context.Background())
+	state, err = newInternalState(cancel)
+	Expect(err).NotTo(HaveOccurred())
+
+	fidStore = store.NewInMemorySingleton()
+	state.cli = callrules.New(
+		callrules.WithFrameworkID(store.GetIgnoreErrors(fidStore)),
+	).Caller(state.cli)
+	state.backend, err = newSchedulerBackend("mesos", state, fidStore)
+	Expect(err).NotTo(HaveOccurred())
+
+	state.sm = fsm.NewFSM(
+		"INITIAL",
+		fsm.Events{
+			{Name: "CONNECT", Src: []string{"INITIAL"}, Dst: "CONNECTED"},
+		},
+		fsm.Callbacks{},
+	)
+
+	go state.backend.run(ctx)
+})
+
+var _ = AfterSuite(func() {
+	cancel()
+	master.Close()
+	Expect(os.RemoveAll(cfgDir)).To(Succeed())
+})
@@ -28,10 +28,12 @@ package environment
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/workflow"
 	"github.com/gobwas/glob"
 	"github.com/looplab/fsm"
@@ -51,6 +53,14 @@ type Environment struct {
 	workflow         workflow.Role
 	wfAdapter        *workflow.ParentAdapter
 	currentRunNumber uint32
+	vars             task.VarMap // validated against the workflow template parameters
+
+	// transitionMu serializes environment transitions with the restarts of
+	// failed tasks, so that a restarted task is driven to a stable state.
+	transitionMu     sync.Mutex
+	restarts         map[string]int // restart attempts so far, by role path
+	historyMu        sync.RWMutex
+	history          []HistoryEntry
 }
 
 func newEnvironment() (env *Environment, err error) {
@@ -59,8 +69,15 @@ func newEnvironment() (env *Environment, err error) {
 		id: envId,
 		workflow: nil,
 		ts:  time.Now(),
+		restarts: make(map[string]int),
 	}
-    env.wfAdapter = workflow.NewParentAdapter(func() uuid.Array { return env.Id().Array() })
+    env.wfAdapter = workflow.NewParentAdapter(
+		func() uuid.Array { return env.Id().Array() },
+		// NOTE: not GetCurrentRunNumber, which only returns nonzero once the
+		//       START_ACTIVITY transition is done
+		func() uint32 { return env.currentRunNumber },
+		func() task.VarMap { return env.GetVars() },
+	)
 	env.Sm = fsm.NewFSM(
 		"STANDBY",
 		fsm.Events{
@@ -80,6 +97,10 @@ func newEnvironment() (env *Environment, err error) {
 					"dst":				e.Dst,
 					"environmentId": 	envId,
 				}).Debug("environment.sm entering state")
+				env.recordHistory(HistoryEntry{
+					Event:   e.Event,
+					Message: fmt.Sprintf("%s → %s", e.Src, e.Dst),
+				})
 			},
 			"before_event": env.handlerFunc(),
 		},
@@ -92,7 +113,15 @@ func (env *Environment) TryTransition(t Transition) (err error) {
 	if err != nil {
 		return
 	}
+	env.transitionMu.Lock()
+	defer env.transitionMu.Unlock()
 	err = env.Sm.Event(t.eventName(), t)
+	if err != nil {
+		env.recordHistory(HistoryEntry{
+			Event:   t.eventName(),
+			Message: fmt.Sprintf("transition failed: %s", err.Error()),
+		})
+	}
 	return
 }
 
@@ -162,6 +191,20 @@ func (env *Environment) Workflow() workflow.Role {
 	return env.workflow
 }
 
+// GetVars returns the environment-level vars, which all roles inherit.
+func (env *Environment) GetVars() (vars task.VarMap) {
+	vars = make(task.VarMap)
+	if env == nil {
+		return
+	}
+	env.Mu.RLock()
+	defer env.Mu.RUnlock()
+	for k, v := range env.vars {
+		vars[k] = v
+	}
+	return
+}
+
 func (env *Environment) QueryRoles(pathSpec string) (rs []workflow.Role) {
 	g := glob.MustCompile(pathSpec, workflow.PATH_SEPARATOR_RUNE)
 	rs = env.workflow.GlobFilter(g)
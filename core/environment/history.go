@@ -0,0 +1,73 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"time"
+)
+
+// Maximum number of entries kept in the history of an environment, older
+// entries are dropped first.
+const maxHistoryLength = 1000
+
+// HistoryEntry records a noteworthy event in the lifetime of an environment,
+// such as a state transition or a task restart.
+type HistoryEntry struct {
+	Timestamp time.Time
+	Event     string
+	RolePath  string
+	TaskId    string
+	Message   string
+}
+
+// History returns a copy of the history of the environment, oldest first.
+func (env *Environment) History() (history []HistoryEntry) {
+	if env == nil {
+		return
+	}
+	env.historyMu.RLock()
+	defer env.historyMu.RUnlock()
+	history = make([]HistoryEntry, len(env.history))
+	copy(history, env.history)
+	return
+}
+
+func (env *Environment) recordHistory(entry HistoryEntry) {
+	if env == nil {
+		return
+	}
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+	env.historyMu.Lock()
+	defer env.historyMu.Unlock()
+	env.history = append(env.history, entry)
+	if len(env.history) > maxHistoryLength {
+		env.history = env.history[len(env.history) - maxHistoryLength:]
+	}
+}
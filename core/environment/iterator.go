@@ -0,0 +1,192 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2017-2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// SetIterator changes the range or list of values of an iterator role in a
+// CONFIGURED environment, without going through a full teardown.
+// Tasks are deployed and configured for the new child roles, the tasks of the
+// child roles no longer generated are reset and released, and any remaining
+// task with an outbound channel towards an added or removed role is reset and
+// configured again so that its channels are rewired.
+// The workflow of the environment is only modified once the new tasks are
+// running and the old ones are reset, so that a failure up to that point
+// leaves it untouched.
+func (envs *Manager) SetIterator(environmentId uuid.UUID, iteratorPath string, begin int, end int, values []string) (err error) {
+	env, err := envs.Environment(environmentId)
+	if err != nil {
+		return
+	}
+	env.transitionMu.Lock()
+	defer env.transitionMu.Unlock()
+	if env.CurrentState() != "CONFIGURED" {
+		return fmt.Errorf("cannot modify iterator in environment in state %s", env.CurrentState())
+	}
+
+	envId := env.Id().Array()
+
+	env.Mu.Lock()
+	change, err := workflow.SetIterator(env.workflow, iteratorPath, begin, end, values)
+	env.Mu.Unlock()
+	if err != nil {
+		return
+	}
+	log.WithFields(logrus.Fields{
+			"iterator": iteratorPath,
+			"added": len(change.Added),
+			"removed": len(change.Removed),
+			"environmentId": environmentId.String(),
+		}).
+		Debug("iterator role modification prepared")
+
+	releaseAdded := func() {
+		rlsErr := envs.taskman.ReleaseTasks(envId, change.AddedTasks())
+		if rlsErr != nil {
+			log.WithError(rlsErr).Warning("iterator modification failed, some tasks could not be released")
+		}
+	}
+
+	// 1) Deploy the tasks for the new roles, and wait for them to be running
+	taskDescriptors := make(task.Descriptors, 0)
+	taskClassesRequired := make([]string, 0)
+	for _, role := range change.Added {
+		taskDescriptors = append(taskDescriptors, role.GenerateTaskDescriptors()...)
+		taskClassesRequired = append(taskClassesRequired, role.GetTaskClasses()...)
+	}
+	if len(taskDescriptors) != 0 {
+		err = the.RepoManager().EnsureReposPresent(taskClassesRequired)
+		if err == nil {
+			err = envs.taskman.RefreshClasses(taskClassesRequired)
+		}
+		if err == nil {
+			err = envs.deployTasks(env, taskDescriptors, change.AddedTasks)
+		}
+		if err != nil {
+			releaseAdded()
+			return
+		}
+	}
+
+	// 2) Reset the tasks which are going away or must be rewired
+	removedTasks := change.RemovedTasks()
+	rewiredTasks := change.RewiredTasks()
+	toReset := append(append(task.Tasks{}, removedTasks...), rewiredTasks...)
+	if len(toReset) != 0 {
+		err = envs.taskman.TransitionTasks(toReset, task.CONFIGURED.String(), "RESET", task.STANDBY.String(), nil)
+		if err != nil {
+			releaseAdded()
+			return fmt.Errorf("cannot reset tasks for iterator modification: %s", err.Error())
+		}
+	}
+
+	// 3) Swap in the new child roles, and release the tasks of the old ones so
+	//    that they aren't included in the new bindMap
+	env.Mu.Lock()
+	change.Apply()
+	env.Mu.Unlock()
+	log.WithFields(logrus.Fields{
+			"iterator": iteratorPath,
+			"environmentId": environmentId.String(),
+		}).
+		Debug("iterator role modified")
+
+	err = envs.taskman.ReleaseTasks(envId, removedTasks)
+	if err != nil {
+		return
+	}
+
+	// 4) Configure the new and the rewired tasks, honoring dependencies
+	toConfigure := make(map[*task.Task]bool)
+	for _, taskPtr := range append(change.AddedTasks(), rewiredTasks...) {
+		toConfigure[taskPtr] = true
+	}
+	if len(toConfigure) == 0 {
+		return
+	}
+
+	var stages task.Stages
+	stages, err = workflow.TaskStages(env.Workflow())
+	if err != nil {
+		return
+	}
+	stages = stages.Filtered(func(taskPtr *task.Task) bool {
+		return toConfigure[taskPtr]
+	})
+	return envs.taskman.ConfigureTasks(envId, stages)
+}
+
+// deployTasks acquires tasks for the given descriptors, and waits until all
+// the tasks returned by getTasks are running.
+func (envs *Manager) deployTasks(env *Environment, taskDescriptors task.Descriptors, getTasks func() task.Tasks) (err error) {
+	notify := make(chan task.Status)
+	subscriptionId := uuid.NewUUID().String()
+	env.wfAdapter.SubscribeToStatusChange(subscriptionId, notify)
+	defer env.wfAdapter.UnsubscribeFromStatusChange(subscriptionId)
+
+	err = envs.taskman.AcquireTasks(env.Id().Array(), taskDescriptors)
+	if err != nil {
+		return
+	}
+
+	allActive := func() bool {
+		tasks := getTasks()
+		if len(tasks) != len(taskDescriptors) {
+			return false
+		}
+		for _, taskPtr := range tasks {
+			if taskPtr.GetStatus() != task.ACTIVE {
+				return false
+			}
+		}
+		return true
+	}
+
+	deploymentTimeout := 90 * time.Second
+	timeout := time.After(deploymentTimeout)
+	for !allActive() {
+		log.Debug("waiting for new tasks to become active")
+		select {
+		case <-notify:
+			continue
+		case <-timeout:
+			err = errors.New("task deployment timed out")
+			log.WithFields(logrus.Fields{"error": err.Error(), "timeout": deploymentTimeout.String()}).
+				Error("task deployment error")
+			return
+		}
+	}
+	return
+}
@@ -43,13 +43,17 @@ type Manager struct {
 }
 
 func NewEnvManager(tm *task.Manager) *Manager {
-	return &Manager{
+	envs := &Manager{
 		m:       make(map[uuid.Array]*Environment),
 		taskman: tm,
 	}
+	if tm != nil {
+		tm.SetTaskFailedHandler(envs.handleTaskFailed)
+	}
+	return envs
 }
 
-func (envs *Manager) CreateEnvironment(workflowPath string) (uuid.UUID, error) {
+func (envs *Manager) CreateEnvironment(workflowPath string, userVars map[string]string) (uuid.UUID, error) {
 	envs.mu.Lock()
 	defer envs.mu.Unlock()
 
@@ -57,7 +61,7 @@ func (envs *Manager) CreateEnvironment(workflowPath string) (uuid.UUID, error) {
 	if err != nil {
 		return uuid.NIL, err
 	}
-	env.workflow, err = envs.loadWorkflow(workflowPath, env.wfAdapter)
+	env.workflow, env.vars, err = envs.loadWorkflow(workflowPath, env.wfAdapter, userVars)
 	if err != nil {
 		err = fmt.Errorf("cannot load workflow template: %s", err.Error())
 		return env.id, err
@@ -140,9 +144,9 @@ func (envs *Manager) environment(environmentId uuid.UUID) (env *Environment, err
 	return
 }
 
-func (envs *Manager) loadWorkflow(workflowPath string, parent workflow.Updatable) (root workflow.Role, err error) {
+func (envs *Manager) loadWorkflow(workflowPath string, parent workflow.Updatable, userVars map[string]string) (root workflow.Role, vars task.VarMap, err error) {
 	if strings.Contains(workflowPath, "://") {
-		return nil, errors.New("workflow loading from file not implemented yet")
+		return nil, nil, errors.New("workflow loading from file not implemented yet")
 	}
-	return workflow.Load(the.ConfSvc().GetROSource(), workflowPath, parent, envs.taskman)
+	return workflow.Load(the.ConfSvc().GetROSource(), workflowPath, parent, envs.taskman, userVars)
 }
@@ -0,0 +1,268 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// handleTaskFailed is called by the task manager whenever a task which belongs
+// to an environment fails. If the restart policy of the task allows it, the
+// task is replaced after a backoff delay, see restartTask.
+func (envs *Manager) handleTaskFailed(envId uuid.Array, failedTask *task.Task, status mesos.TaskStatus) {
+	env, err := envs.Environment(envId.UUID())
+	if err != nil {
+		return
+	}
+	role, ok := failedTask.GetParentRole().(workflow.Role)
+	if !ok || role == nil {
+		return
+	}
+	rolePath := role.GetPath()
+	taskId := failedTask.GetTaskId()
+
+	message := status.GetState().String()
+	if len(status.GetMessage()) > 0 {
+		message += ": " + status.GetMessage()
+	}
+	// A lost task is replaced elsewhere, since its host is likely gone too
+	lost := task.IsLost(&status)
+	if lost {
+		env.recordHistory(HistoryEntry{
+			Event:    "TASK_LOST",
+			RolePath: rolePath,
+			TaskId:   taskId,
+			Message:  fmt.Sprintf("lost with host %s (%s): %s",
+				failedTask.GetHostname(), status.GetReason().String(), message),
+		})
+	} else {
+		env.recordHistory(HistoryEntry{
+			Event:    "TASK_FAILED",
+			RolePath: rolePath,
+			TaskId:   taskId,
+			Message:  message,
+		})
+	}
+
+	policy := failedTask.GetRestartPolicy()
+	env.Mu.Lock()
+	restarts := env.restarts[rolePath]
+	shouldRestart := policy.ShouldRestart(status.GetState(), restarts)
+	if shouldRestart {
+		env.restarts[rolePath] = restarts + 1
+	}
+	env.Mu.Unlock()
+
+	if !shouldRestart {
+		if policy.Mode != task.RestartNever {
+			env.recordHistory(HistoryEntry{
+				Event:    "TASK_RESTART_GIVEN_UP",
+				RolePath: rolePath,
+				TaskId:   taskId,
+				Message:  fmt.Sprintf("task was already restarted %d times", restarts),
+			})
+		}
+		return
+	}
+
+	delay := policy.Delay(restarts)
+	env.recordHistory(HistoryEntry{
+		Event:    "TASK_RESTART_SCHEDULED",
+		RolePath: rolePath,
+		TaskId:   taskId,
+		Message:  fmt.Sprintf("restart attempt %d in %s", restarts + 1, delay.String()),
+	})
+	time.Sleep(delay)
+
+	newTask, err := envs.restartTask(env, role, failedTask, !lost)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+				"role": rolePath,
+				"taskId": taskId,
+				"environmentId": env.Id().String(),
+			}).
+			WithError(err).
+			Error("task restart failed")
+		env.recordHistory(HistoryEntry{
+			Event:    "TASK_RESTART_FAILED",
+			RolePath: rolePath,
+			TaskId:   taskId,
+			Message:  err.Error(),
+		})
+		return
+	}
+	if newTask == nil { // the role was already taken care of
+		return
+	}
+	env.recordHistory(HistoryEntry{
+		Event:    "TASK_RESTARTED",
+		RolePath: rolePath,
+		TaskId:   newTask.GetTaskId(),
+		Message:  fmt.Sprintf("replaces task %s, now on host %s", taskId, newTask.GetHostname()),
+	})
+}
+
+// restartTask replaces a failed task of a role with a new one, preferably on
+// the same host and with the same bind ports unless sameHost is false, and
+// drives the new task to the current state of the environment.
+// If the new task did not get the same host and ports, the other tasks with
+// channels towards the role are reconfigured in a CONFIGURED environment.
+// In a RUNNING environment they cannot be reconfigured, so we only warn.
+func (envs *Manager) restartTask(env *Environment, role workflow.Role, failedTask *task.Task, sameHost bool) (newTask *task.Task, err error) {
+	env.transitionMu.Lock()
+	defer env.transitionMu.Unlock()
+
+	envState := env.CurrentState()
+	switch envState {
+	case "STANDBY", "CONFIGURED", "RUNNING":
+	default:
+		return nil, fmt.Errorf("cannot restart task in environment in state %s", envState)
+	}
+
+	envId := env.Id().Array()
+	rolePath := role.GetPath()
+
+	// Another restart or a teardown may have happened while we waited
+	roleTasks := role.GetTasks()
+	if len(roleTasks) != 1 || roleTasks[0] != failedTask {
+		return nil, nil
+	}
+
+	oldHostname := failedTask.GetHostname()
+	oldControlPort := failedTask.GetControlPort()
+	oldBindPorts := make(map[string]uint64)
+	for name, port := range failedTask.GetBindPorts() {
+		oldBindPorts[name] = port
+	}
+
+	err = envs.taskman.DetachTask(envId, failedTask)
+	if err != nil {
+		return
+	}
+
+	taskDescriptors := role.GenerateTaskDescriptors()
+	if len(taskDescriptors) != 1 {
+		return nil, errors.New("cannot generate task descriptor for role " + rolePath)
+	}
+	if sameHost {
+		taskDescriptors[0].PreferredHostname = oldHostname
+		taskDescriptors[0].PreferredBindPorts = oldBindPorts
+		taskDescriptors[0].PreferredControlPort = oldControlPort
+	}
+
+	getTasks := func() (tasks task.Tasks) {
+		tasks = make(task.Tasks, 0)
+		for _, taskPtr := range role.GetTasks() {
+			if taskPtr != nil {
+				tasks = append(tasks, taskPtr)
+			}
+		}
+		return
+	}
+	err = envs.deployTasks(env, taskDescriptors, getTasks)
+	if err != nil {
+		return
+	}
+	tasks := getTasks()
+	if len(tasks) != 1 {
+		return nil, errors.New("no task deployed for role " + rolePath)
+	}
+	newTask = tasks[0]
+
+	if envState == "STANDBY" {
+		return
+	}
+
+	rewired := newTask.GetHostname() != oldHostname
+	for name, port := range newTask.GetBindPorts() {
+		if oldBindPorts[name] != port {
+			rewired = true
+		}
+	}
+	peers := make(task.Tasks, 0)
+	if rewired {
+		peers = workflow.TasksTargetingRoles(env.Workflow(), []string{rolePath}).Filtered(
+			func(taskPtr *task.Task) bool {
+				return taskPtr != newTask
+			})
+	}
+
+	toConfigure := map[*task.Task]bool{newTask: true}
+	if len(peers) != 0 {
+		if envState == "CONFIGURED" {
+			err = envs.taskman.TransitionTasks(peers, task.CONFIGURED.String(), "RESET", task.STANDBY.String(), nil)
+			if err != nil {
+				return newTask, fmt.Errorf("cannot reset tasks to rewire their channels: %s", err.Error())
+			}
+			for _, taskPtr := range peers {
+				toConfigure[taskPtr] = true
+			}
+		} else {
+			env.recordHistory(HistoryEntry{
+				Event:    "TASK_RESTART_WARNING",
+				RolePath: rolePath,
+				TaskId:   newTask.GetTaskId(),
+				Message:  fmt.Sprintf("task moved to %s, %d tasks with channels towards it cannot be rewired while RUNNING",
+					newTask.GetHostname(), len(peers)),
+			})
+		}
+	}
+
+	var stages task.Stages
+	stages, err = workflow.TaskStages(env.Workflow())
+	if err != nil {
+		return
+	}
+	stages = stages.Filtered(func(taskPtr *task.Task) bool {
+		return toConfigure[taskPtr]
+	})
+	err = envs.taskman.ConfigureTasks(envId, stages)
+	if err != nil || envState != "RUNNING" {
+		return
+	}
+
+	args := controlcommands.PropertyMap{
+		"runNumber": strconv.FormatUint(uint64(env.GetCurrentRunNumber()), 10),
+	}
+	err = envs.taskman.TransitionTasks(task.Tasks{newTask},
+		task.CONFIGURED.String(),
+		task.START.String(),
+		task.RUNNING.String(),
+		args)
+	return
+}
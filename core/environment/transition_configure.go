@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/workflow"
 	"github.com/pborman/uuid"
 	"github.com/sirupsen/logrus"
 )
@@ -174,10 +175,14 @@ func (t ConfigureTransition) do(env *Environment) (err error) {
 		return
 	}
 
-	tasks := wf.GetTasks()
+	var stages task.Stages
+	stages, err = workflow.TaskStages(wf)
+	if err != nil {
+		return
+	}
 
-	if len(tasks) != 0 {
-		err = t.taskman.ConfigureTasks(env.Id().Array(), tasks)
+	if len(stages) != 0 {
+		err = t.taskman.ConfigureTasks(env.Id().Array(), stages)
 	}
 
 	return
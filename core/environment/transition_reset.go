@@ -27,6 +27,7 @@ package environment
 import (
 	"errors"
 	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/workflow"
 )
 
 func NewResetTransition(taskman *task.Manager) Transition {
@@ -47,8 +48,14 @@ func (t ResetTransition) do(env *Environment) (err error) {
 		return errors.New("cannot transition in NIL environment")
 	}
 
-	err = t.taskman.TransitionTasks(
-		env.Workflow().GetTasks(),
+	var stages task.Stages
+	stages, err = workflow.TaskStages(env.Workflow())
+	if err != nil {
+		return
+	}
+
+	err = t.taskman.TransitionStages(
+		stages.Reversed(),
 		task.CONFIGURED.String(),
 		task.RESET.String(),
 		task.STANDBY.String(),
@@ -32,6 +32,7 @@ import (
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow"
 )
 
 func NewStartActivityTransition(taskman *task.Manager) Transition {
@@ -65,8 +66,17 @@ func (t StartActivityTransition) do(env *Environment) (err error) {
 		"runNumber": strconv.FormatUint(uint64(runNumber), 10 ),
 	}
 
-	err = t.taskman.TransitionTasks(
-		env.Workflow().GetTasks(),
+	var stages task.Stages
+	stages, err = workflow.TaskStages(env.Workflow())
+	if err != nil {
+		env.currentRunNumber = 0
+		return
+	}
+
+	// Dependencies are started first, so that e.g. consumers are RUNNING
+	// before their producers start.
+	err = t.taskman.TransitionStages(
+		stages,
 		task.CONFIGURED.String(),
 		task.START.String(),
 		task.RUNNING.String(),
@@ -28,6 +28,7 @@ import (
 	"errors"
 	"github.com/AliceO2Group/Control/common/logger/infologger"
 	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/workflow"
 )
 
 func NewStopActivityTransition(taskman *task.Manager) Transition {
@@ -52,8 +53,16 @@ func (t StopActivityTransition) do(env *Environment) (err error) {
 
 	env.currentRunNumber = 0
 
-	err = t.taskman.TransitionTasks(
-		env.Workflow().GetTasks(),
+	var stages task.Stages
+	stages, err = workflow.TaskStages(env.Workflow())
+	if err != nil {
+		return
+	}
+
+	// Dependent tasks are stopped first, so that e.g. producers stop before
+	// their consumers.
+	err = t.taskman.TransitionStages(
+		stages.Reversed(),
 		task.RUNNING.String(),
 		task.STOP.String(),
 		task.CONFIGURED.String(),
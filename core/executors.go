@@ -0,0 +1,290 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/scheduler/calls"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// executorPolicy tells which tasks share an executor. Since an executor crash
+// takes all of its tasks down, the narrower the policy, the fewer the tasks
+// affected, at the cost of the resources of more executors.
+type executorPolicy int
+const (
+	EXECUTOR_PER_HOST executorPolicy = iota
+	EXECUTOR_PER_ENVIRONMENT
+	EXECUTOR_PER_TASK
+)
+
+var _executorPolicyNames = []string{
+	"host",
+	"environment",
+	"task",
+}
+
+func (p executorPolicy) String() string {
+	if p < EXECUTOR_PER_HOST || p > EXECUTOR_PER_TASK {
+		return "host"
+	}
+	return _executorPolicyNames[p]
+}
+
+func executorPolicyFromString(s string) (policy executorPolicy, err error) {
+	for i, v := range _executorPolicyNames {
+		if strings.EqualFold(strings.TrimSpace(s), v) {
+			return executorPolicy(i), nil
+		}
+	}
+	return EXECUTOR_PER_HOST, fmt.Errorf("unknown executor policy %s, expected host, environment or task", s)
+}
+
+// executorScope returns the prefix of the IDs of the executors which may run
+// the task of descriptor, or an empty string if the task needs an executor of
+// its own.
+// Executor IDs carry their scope, e.g. host.<uuid> or env-<environment id>.<uuid>,
+// so that executors are only ever shared by the tasks the policy allows, even
+// when the policy changed since they were launched.
+func (p executorPolicy) executorScope(descriptor *task.Descriptor) string {
+	switch p {
+	case EXECUTOR_PER_ENVIRONMENT:
+		envId := uuid.UUID(descriptor.TaskRole.GetEnvironmentId().UUID())
+		return fmt.Sprintf("env-%s.", envId.String())
+	case EXECUTOR_PER_TASK:
+		return ""
+	}
+	return "host."
+}
+
+// executorAllowsReuse tells whether an idle task in the given executor may be
+// acquired for the role of descriptor. The executor of an environment keeps
+// the tasks it ran after their release, so they may only be acquired again by
+// that environment, whatever the policy now is.
+func executorAllowsReuse(executorId string, descriptor *task.Descriptor) bool {
+	if !strings.HasPrefix(executorId, "env-") {
+		return true
+	}
+	return strings.HasPrefix(executorId, EXECUTOR_PER_ENVIRONMENT.executorScope(descriptor))
+}
+
+// executorAssigner picks the executor of each task launched with an offer,
+// among the executors already running on the agent and those it creates.
+type executorAssigner struct {
+	policy   executorPolicy
+	running  []mesos.ExecutorID
+	assigned map[string]mesos.ExecutorID // scope → executor created for this offer
+}
+
+func newExecutorAssigner(policy executorPolicy, offer *mesos.Offer) *executorAssigner {
+	return &executorAssigner{
+		policy:   policy,
+		running:  append([]mesos.ExecutorID{}, offer.ExecutorIDs...),
+		assigned: make(map[string]mesos.ExecutorID),
+	}
+}
+
+// peek returns the executor the task of descriptor would run in, and whether
+// it is a new executor, whose resources must come from the offer too.
+func (a *executorAssigner) peek(descriptor *task.Descriptor) (executorId mesos.ExecutorID, isNew bool) {
+	scope := a.policy.executorScope(descriptor)
+	if len(scope) > 0 {
+		for _, id := range a.running {
+			// Executors without a scope were launched before executor policies
+			// existed, and they run tasks of any environment.
+			if strings.HasPrefix(id.Value, scope) ||
+				(a.policy == EXECUTOR_PER_HOST && !strings.Contains(id.Value, ".")) {
+				return id, false
+			}
+		}
+		if id, ok := a.assigned[scope]; ok {
+			return id, false
+		}
+	}
+	if len(scope) == 0 {
+		scope = "task."
+	}
+	return mesos.ExecutorID{Value: scope + uuid.NewUUID().String()}, true
+}
+
+// commit records that a task was launched with the executor returned by peek.
+func (a *executorAssigner) commit(descriptor *task.Descriptor, executorId mesos.ExecutorID) {
+	if scope := a.policy.executorScope(descriptor); len(scope) > 0 {
+		a.assigned[scope] = executorId
+	}
+}
+
+// executorIdleTimeout returns how long an executor may run without tasks
+// before it is shut down. An executor of its own task is never reused.
+func executorIdleTimeout(policy executorPolicy) time.Duration {
+	if policy == EXECUTOR_PER_TASK {
+		return 0
+	}
+	return viper.GetDuration("executorIdleTimeout")
+}
+
+// executorTracker keeps the executors of the framework known to the core, i.e.
+// those it launched and those it finds in offers, so that the idle ones can be
+// shut down.
+type executorTracker struct {
+	mu        sync.Mutex
+	executors map[mesos.ExecutorID]*trackedExecutor
+}
+
+type trackedExecutor struct {
+	agentId   mesos.AgentID
+	info      *mesos.ExecutorInfo // nil for those found in offers only
+	addedAt   time.Time
+	seen      bool      // whether it ever ran a task of the roster
+	idleSince time.Time // zero while the executor has tasks
+}
+
+// executorLaunchGrace is how long an executor may wait for its first task to
+// enter the roster, which only happens once the whole deployment is done.
+const executorLaunchGrace = 5 * time.Minute
+
+func newExecutorTracker() *executorTracker {
+	return &executorTracker{
+		executors: make(map[mesos.ExecutorID]*trackedExecutor),
+	}
+}
+
+// Add tracks an executor, along with the ExecutorInfo it was launched with if
+// info is not nil.
+func (et *executorTracker) Add(executorId mesos.ExecutorID, agentId mesos.AgentID, info *mesos.ExecutorInfo) {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	ex, ok := et.executors[executorId]
+	if !ok {
+		ex = &trackedExecutor{agentId: agentId, addedAt: time.Now()}
+		et.executors[executorId] = ex
+	}
+	if ex.info == nil {
+		ex.info = info
+	}
+}
+
+// Info returns the ExecutorInfo an executor was launched with, which all of
+// its tasks must carry, or nil if it was not launched by this core.
+func (et *executorTracker) Info(executorId mesos.ExecutorID) *mesos.ExecutorInfo {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	if ex, ok := et.executors[executorId]; ok {
+		return ex.info
+	}
+	return nil
+}
+
+func (et *executorTracker) Remove(executorId mesos.ExecutorID) {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	delete(et.executors, executorId)
+}
+
+// RemoveAgent forgets all the executors of an agent, e.g. when it is lost.
+func (et *executorTracker) RemoveAgent(agentId mesos.AgentID) {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	for id, ex := range et.executors {
+		if ex.agentId == agentId {
+			delete(et.executors, id)
+		}
+	}
+}
+
+// Idle returns the executors which ran none of the given tasks for at least
+// timeout, and forgets them.
+func (et *executorTracker) Idle(tasks task.Tasks, now time.Time, timeout time.Duration) (idle map[mesos.ExecutorID]mesos.AgentID) {
+	inUse := make(map[string]struct{})
+	for _, t := range tasks {
+		inUse[t.GetExecutorId()] = struct{}{}
+	}
+
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	idle = make(map[mesos.ExecutorID]mesos.AgentID)
+	for id, ex := range et.executors {
+		if _, ok := inUse[id.Value]; ok {
+			ex.seen = true
+			ex.idleSince = time.Time{}
+			continue
+		}
+		if !ex.seen && now.Sub(ex.addedAt) < executorLaunchGrace {
+			continue
+		}
+		if ex.idleSince.IsZero() {
+			ex.idleSince = now
+		}
+		if now.Sub(ex.idleSince) >= timeout {
+			idle[id] = ex.agentId
+			delete(et.executors, id)
+		}
+	}
+	return
+}
+
+// shutdownIdleExecutors periodically shuts down the executors which have had
+// no tasks for longer than the idle timeout of the executor policy.
+func shutdownIdleExecutors(ctx context.Context, state *internalState) {
+	ticker := time.NewTicker(viper.GetDuration("executorIdleCheckInterval"))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			doShutdownIdleExecutors(ctx, state, time.Now())
+		}
+	}
+}
+
+func doShutdownIdleExecutors(ctx context.Context, state *internalState, now time.Time) {
+	policy, _ := executorPolicyFromString(viper.GetString("executorPolicy"))
+	idle := state.executors.Idle(state.taskman.CopyTasks(), now, executorIdleTimeout(policy))
+	for executorId, agentId := range idle {
+		err := calls.CallNoData(ctx, state.cli, calls.Shutdown(executorId.Value, agentId.Value))
+		fields := logrus.Fields{
+			"executorId": executorId.Value,
+			"agentId":    agentId.Value,
+		}
+		if err != nil {
+			log.WithPrefix("scheduler").WithFields(fields).WithError(err).Warning("cannot shut down idle executor")
+			continue
+		}
+		log.WithPrefix("scheduler").WithFields(fields).Info("idle executor shut down")
+	}
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/pborman/uuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Executors", func() {
+	descriptor := &task.Descriptor{
+		TaskRole:      &fakeRole{path: "test.role", taskClass: "test/class"},
+		TaskClassName: "test/class",
+	}
+	offer := func(executorIds ...string) *mesos.Offer {
+		o := &mesos.Offer{}
+		for _, id := range executorIds {
+			o.ExecutorIDs = append(o.ExecutorIDs, mesos.ExecutorID{Value: id})
+		}
+		return o
+	}
+
+	Describe("executorPolicyFromString", func() {
+		It("should parse the policy names", func() {
+			for _, p := range []executorPolicy{EXECUTOR_PER_HOST, EXECUTOR_PER_ENVIRONMENT, EXECUTOR_PER_TASK} {
+				Expect(executorPolicyFromString(p.String())).To(Equal(p))
+			}
+			_, err := executorPolicyFromString("rack")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("executorAssigner", func() {
+		It("should share one executor per host", func() {
+			a := newExecutorAssigner(EXECUTOR_PER_HOST, offer())
+			id, isNew := a.peek(descriptor)
+			Expect(isNew).To(BeTrue())
+			Expect(id.Value).To(HavePrefix("host."))
+			a.commit(descriptor, id)
+			Expect(a.peek(descriptor)).To(Equal(id))
+
+			a = newExecutorAssigner(EXECUTOR_PER_HOST, offer("env-x.1", "legacy"))
+			id, isNew = a.peek(descriptor)
+			Expect(isNew).To(BeFalse())
+			Expect(id.Value).To(Equal("legacy"))
+		})
+
+		It("should only share executors within an environment", func() {
+			a := newExecutorAssigner(EXECUTOR_PER_ENVIRONMENT, offer("host.1", "legacy"))
+			id, isNew := a.peek(descriptor)
+			Expect(isNew).To(BeTrue())
+			Expect(id.Value).To(HavePrefix("env-00000000-0000-0000-0000-000000000000."))
+			a.commit(descriptor, id)
+			Expect(a.peek(descriptor)).To(Equal(id))
+		})
+
+		It("should give each task an executor of its own", func() {
+			a := newExecutorAssigner(EXECUTOR_PER_TASK, offer("host.1"))
+			first, isNew := a.peek(descriptor)
+			Expect(isNew).To(BeTrue())
+			a.commit(descriptor, first)
+			second, isNew := a.peek(descriptor)
+			Expect(isNew).To(BeTrue())
+			Expect(second).NotTo(Equal(first))
+		})
+	})
+
+	Describe("executorAllowsReuse", func() {
+		It("should only let an environment reuse the idle tasks of its own executors", func() {
+			envId := uuid.NewRandom()
+			descriptor := &task.Descriptor{
+				TaskRole:      newEnvRole(envId.Array(), "test.role", "test/class"),
+				TaskClassName: "test/class",
+			}
+			Expect(executorAllowsReuse("env-"+envId.String()+".1", descriptor)).To(BeTrue())
+			Expect(executorAllowsReuse("env-"+uuid.NewRandom().String()+".1", descriptor)).To(BeFalse())
+			Expect(executorAllowsReuse("host.1", descriptor)).To(BeTrue())
+			Expect(executorAllowsReuse("task.1", descriptor)).To(BeTrue())
+			Expect(executorAllowsReuse("legacy", descriptor)).To(BeTrue())
+		})
+	})
+
+	Describe("executorTracker", func() {
+		It("should report executors idle for longer than the timeout", func() {
+			et := newExecutorTracker()
+			now := time.Now()
+			et.Add(mesos.ExecutorID{Value: "host.1"}, mesos.AgentID{Value: "agent"}, nil)
+			Expect(et.Idle(nil, now, 0)).To(BeEmpty(), "within the launch grace period")
+
+			later := now.Add(executorLaunchGrace + time.Second)
+			Expect(et.Idle(nil, later, time.Minute)).To(BeEmpty())
+			Expect(et.Idle(nil, later.Add(time.Minute), time.Minute)).
+				To(HaveKeyWithValue(mesos.ExecutorID{Value: "host.1"}, mesos.AgentID{Value: "agent"}))
+			Expect(et.Idle(nil, later.Add(time.Hour), 0)).To(BeEmpty())
+		})
+
+		It("should keep the ExecutorInfo an executor was launched with", func() {
+			et := newExecutorTracker()
+			executorId := mesos.ExecutorID{Value: "host.1"}
+			info := &mesos.ExecutorInfo{ExecutorID: executorId}
+			et.Add(executorId, mesos.AgentID{Value: "agent"}, nil)
+			Expect(et.Info(executorId)).To(BeNil())
+
+			et.Add(executorId, mesos.AgentID{Value: "agent"}, info)
+			et.Add(executorId, mesos.AgentID{Value: "agent"}, nil)
+			Expect(et.Info(executorId)).To(BeIdenticalTo(info))
+			Expect(et.Info(mesos.ExecutorID{Value: "host.2"})).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,267 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/executor/local"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// localBackend runs all tasks as child processes of the core, on the machine
+// the core runs on, so that full environments can be run without a Mesos
+// master. The machine is treated like a single Mesos agent, whose resources
+// and attributes are set with localCPU, localMemory and localAttributes.
+// All tasks share the one in-process executor, whatever the executorPolicy.
+type localBackend struct {
+	state      *internalState
+	executor   *local.Executor
+	agentId    mesos.AgentID
+	executorId mesos.ExecutorID
+	hostname   string
+	attributes []mesos.Attribute
+
+	mu         sync.Mutex
+	resources  mesos.Resources                    // still available to new tasks
+	allocated  map[mesos.TaskID]mesos.Resources
+}
+
+func newLocalBackend(state *internalState) (b *localBackend, err error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	b = &localBackend{
+		state:      state,
+		agentId:    mesos.AgentID{Value: product.NAME + "-local-agent"},
+		executorId: mesos.ExecutorID{Value: product.NAME + "-local-executor"},
+		hostname:   hostname,
+		attributes: make([]mesos.Attribute, 0),
+		allocated:  make(map[mesos.TaskID]mesos.Resources),
+	}
+	for name, value := range viper.GetStringMapString("localAttributes") {
+		b.attributes = append(b.attributes, mesos.Attribute{
+			Name: name,
+			Type: mesos.TEXT,
+			Text: &mesos.Value_Text{Value: value},
+		})
+	}
+	b.resources.Add(
+		resources.NewCPUs(viper.GetFloat64("localCPU")).Resource,
+		resources.NewMemory(viper.GetFloat64("localMemory")).Resource,
+		portsResource(resources.BuildRanges().Span(1024, 65535).Ranges),
+	)
+	b.executor = local.NewExecutor(b.agentId, b.executorId, viper.GetString("containerRuntime"),
+		b.statusUpdate, b.incomingMessage)
+	return
+}
+
+func (b *localBackend) run(ctx context.Context) error {
+	if b.state.sm.Is("INITIAL") {
+		b.state.sm.Event("CONNECT")
+	}
+	log.WithPrefix("scheduler").
+		WithFields(logrus.Fields{
+			"hostname": b.hostname,
+			"resources": b.resources.String(),
+		}).
+		Info("local backend ready, tasks will run on this machine")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-b.state.reviveOffersTrg:
+			// There are no offers to revive, the local machine is always at hand.
+			b.state.reviveOffersTrg <- struct{}{}
+		case descriptors := <-b.state.tasksToDeploy:
+			b.state.resourceOffersDone <- b.deploy(descriptors)
+		}
+	}
+}
+
+// deploy launches a task for each descriptor whose requirements are met by the
+// local machine, matched like the offer of a single agent in resourceOffers.
+func (b *localBackend) deploy(descriptors task.Descriptors) (deployed task.DeploymentMap) {
+	deployed = make(task.DeploymentMap)
+
+	taskman := b.state.taskman
+	matcher := newOfferMatcher(taskman, descriptors)
+	matcher.report.AddOffersReceived(1)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	taskman.AgentCache.Update(task.AgentCacheInfo{
+		AgentId: b.agentId,
+		Attributes: b.attributes,
+		Hostname: b.hostname,
+		Resources: b.resources.Clone(),
+		LastOffer: time.Now(),
+	})
+
+	for _, descriptor := range descriptors {
+		offer := mesos.Offer{
+			ID:         mesos.OfferID{Value: uuid.NewUUID().String()},
+			AgentID:    b.agentId,
+			Hostname:   b.hostname,
+			Resources:  b.resources.Clone(),
+			Attributes: b.attributes,
+		}
+
+		wants, ok := matcher.match(descriptor, &offer, b.resources, nil)
+		if !ok {
+			continue
+		}
+
+		// We claim everything the task needs from a copy of the available resources,
+		// which we only keep if the task is launched.
+		remaining := b.resources.Clone()
+		claim, err := matcher.claim(descriptor, &offer, wants, &remaining)
+		if err != nil {
+			matcher.report.Reject(descriptor, &offer, err.Error())
+			continue
+		}
+
+		taskPtr := taskman.NewTaskForMesosOffer(&offer, descriptor, claim.bindPorts, b.executorId)
+		if taskPtr == nil {
+			matcher.release(descriptor, &offer)
+			matcher.report.Reject(descriptor, &offer, "cannot create task")
+			continue
+		}
+		cmd, err := taskPtr.BuildTaskCommand()
+		if err != nil {
+			matcher.release(descriptor, &offer)
+			matcher.report.Reject(descriptor, &offer, fmt.Sprintf("cannot build task command: %s", err.Error()))
+			continue
+		}
+		setControlPort(cmd, claim.controlPort, offer.Hostname)
+		taskPtr.SetControlPort(claim.controlPort)
+
+		taskId := mesos.TaskID{Value: taskPtr.GetTaskId()}
+		err = b.executor.Launch(taskId, taskPtr.GetName(), *cmd)
+		if err != nil {
+			log.WithPrefix("scheduler").
+				WithError(err).
+				WithField("taskClass", descriptor.TaskClassName).
+				Error("cannot launch task")
+			matcher.release(descriptor, &offer)
+			matcher.report.Reject(descriptor, &offer, fmt.Sprintf("cannot launch task: %s", err.Error()))
+			continue
+		}
+
+		b.allocated[taskId] = b.resources.Minus(remaining...)
+		b.resources = remaining
+		deployed[taskPtr] = descriptor
+		matcher.placed(descriptor, &offer)
+		state := b.state
+		state.Lock()
+		state.tasksLaunched++
+		state.Unlock()
+		state.metricsAPI.tasksLaunched.Int(1)
+	}
+
+	log.WithPrefix("scheduler").
+		WithField("tasks", len(deployed)).
+		Debug("local deployment done")
+	return
+}
+
+func (b *localBackend) killTask(_ context.Context, receiver controlcommands.MesosCommandTarget) error {
+	return b.executor.Kill(receiver.TaskId)
+}
+
+func (b *localBackend) sendCommand(_ context.Context, command controlcommands.MesosCommand, receiver controlcommands.MesosCommandTarget) error {
+	data, err := json.Marshal(command)
+	if err != nil {
+		return err
+	}
+
+	// The response comes back asynchronously through incomingMessage, as it would
+	// from a Mesos executor.
+	go func() {
+		err := b.executor.HandleMessage(data)
+		if err != nil {
+			log.WithPrefix("scheduler").
+				WithError(err).
+				WithField("taskId", receiver.TaskId.Value).
+				Error("cannot handle command for local task")
+		}
+	}()
+	return nil
+}
+
+func (b *localBackend) incomingMessage(data []byte) {
+	err := handleMessage(b.state, b.agentId, b.executorId, data)
+	if err != nil {
+		log.WithPrefix("scheduler").WithError(err).Warning("cannot handle message from local task")
+	}
+}
+
+func (b *localBackend) statusUpdate(status mesos.TaskStatus) {
+	switch st := status.GetState(); st {
+	case mesos.TASK_FINISHED, mesos.TASK_LOST, mesos.TASK_KILLED, mesos.TASK_FAILED, mesos.TASK_ERROR:
+		log.WithPrefix("scheduler").
+			WithFields(logrus.Fields{
+				"taskId": status.GetTaskID().Value,
+				"state": st.String(),
+				"message": status.GetMessage(),
+			}).
+			Info("local task ended")
+
+		b.mu.Lock()
+		if allocated, ok := b.allocated[status.TaskID]; ok {
+			b.resources.Add(allocated...)
+			delete(b.allocated, status.TaskID)
+		}
+		b.mu.Unlock()
+
+		if st == mesos.TASK_FINISHED {
+			b.state.Lock()
+			b.state.tasksFinished++
+			b.state.Unlock()
+			b.state.metricsAPI.tasksFinished()
+		}
+	}
+
+	go b.state.taskman.UpdateTaskStatus(&status)
+}
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	"gopkg.in/yaml.v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// addTaskClass makes a task class known to the task manager without going
+// through a configuration repository, and returns its identifier.
+func addTaskClass(classYaml string) string {
+	class := &task.TaskClass{}
+	Expect(yaml.Unmarshal([]byte(classYaml), class)).To(Succeed())
+	state.taskman.AddClasses(class)
+	return class.Identifier.String()
+}
+
+var _ = Describe("Local backend", func() {
+	var (
+		b            *localBackend
+		sleeperClass string
+	)
+
+	cpus := func() float64 {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		cpus, _ := resources.CPUs(b.resources...)
+		return cpus
+	}
+
+	BeforeEach(func() {
+		var err error
+		b, err = newLocalBackend(state)
+		Expect(err).NotTo(HaveOccurred())
+		b.resources = mesos.Resources{
+			resources.NewCPUs(4).Resource,
+			resources.NewMemory(1024).Resource,
+			portsResource(resources.BuildRanges().Span(1024, 65535).Ranges),
+		}
+		sleeperClass = addTaskClass(`
+name: local-sleeper
+control:
+  mode: direct
+command:
+  value: sleep
+  arguments: ["30"]
+  shell: false
+wants:
+  cpu: 1
+  memory: 64
+  ports: "5000"
+`)
+	})
+
+	It("should launch a task and take its resources until it ends", func() {
+		deployed := b.deploy(task.Descriptors{{
+			TaskRole:      &fakeRole{path: "local.sleeper", taskClass: sleeperClass},
+			TaskClassName: sleeperClass,
+		}})
+		Expect(deployed).To(HaveLen(1))
+		Expect(cpus()).To(Equal(3.0))
+
+		for taskPtr := range deployed {
+			taskId := mesos.TaskID{Value: taskPtr.GetTaskId()}
+			Expect(b.executor.Kill(taskId)).To(Succeed())
+			b.statusUpdate(mesos.TaskStatus{TaskID: taskId, State: mesos.TASK_KILLED.Enum()})
+		}
+		Expect(cpus()).To(Equal(4.0))
+	})
+
+	It("should reject the descriptors the machine cannot host", func() {
+		hogClass := addTaskClass(`
+name: local-hog
+command:
+  value: sleep
+wants:
+  cpu: 100000
+  memory: 64
+`)
+		deployed := b.deploy(task.Descriptors{{
+			TaskRole:      &fakeRole{path: "local.hog", taskClass: hogClass},
+			TaskClassName: hogClass,
+		}})
+		Expect(deployed).To(BeEmpty())
+		Expect(cpus()).To(Equal(4.0))
+	})
+
+	It("should release the ports of a task which cannot be launched", func() {
+		brokenClass := addTaskClass(`
+name: local-broken
+command:
+  value: /nonexistent/o2-device
+  shell: false
+wants:
+  cpu: 1
+  memory: 64
+  ports: "5000"
+`)
+		sleeper := &task.Descriptor{
+			TaskRole:      &fakeRole{path: "local.sleeper", taskClass: sleeperClass},
+			TaskClassName: sleeperClass,
+		}
+		// Both tasks want static port 5000, which the broken one must give back
+		deployed := b.deploy(task.Descriptors{{
+			TaskRole:      &fakeRole{path: "local.broken", taskClass: brokenClass},
+			TaskClassName: brokenClass,
+		}, sleeper})
+		Expect(deployed).To(HaveLen(1))
+		for taskPtr, descriptor := range deployed {
+			Expect(descriptor).To(BeIdenticalTo(sleeper))
+			Expect(b.executor.Kill(mesos.TaskID{Value: taskPtr.GetTaskId()})).To(Succeed())
+		}
+	})
+})
@@ -0,0 +1,193 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package mesostest
+
+import (
+	"net/http"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/executor"
+	"github.com/mesos/mesos-go/api/v1/lib/scheduler"
+)
+
+// Executors returns the IDs of the executors which are subscribed.
+func (m *Master) Executors() []mesos.ExecutorID {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]mesos.ExecutorID, 0, len(m.executors))
+	for id, ex := range m.executors {
+		if ex.events != nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (m *Master) serveExecutor(w http.ResponseWriter, r *http.Request) {
+	var call executor.Call
+	if err := decodeCall(r, &call); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	ex, ok := m.executors[call.ExecutorID]
+	if !ok {
+		m.mu.Unlock()
+		http.Error(w, "unknown executor "+call.ExecutorID.Value, http.StatusBadRequest)
+		return
+	}
+
+	switch call.GetType() {
+	case executor.Call_SUBSCRIBE:
+		events := m.subscribeExecutor(ex)
+		m.mu.Unlock()
+		serveEvents(w, r, events, "executor-stream")
+		return
+	case executor.Call_UPDATE:
+		status := call.GetUpdate().Status
+		if status.AgentID == nil {
+			status.AgentID = &ex.agentId
+		}
+		if status.ExecutorID == nil {
+			status.ExecutorID = &call.ExecutorID
+		}
+		if status.Source == nil {
+			status.Source = mesos.SOURCE_EXECUTOR.Enum()
+		}
+		m.updateStatus(status)
+	case executor.Call_MESSAGE:
+		m.sendToScheduler(&scheduler.Event{
+			Type: scheduler.Event_MESSAGE,
+			Message: &scheduler.Event_Message{
+				AgentID:    ex.agentId,
+				ExecutorID: call.ExecutorID,
+				Data:       call.GetMessage().Data,
+			},
+		})
+	}
+	m.mu.Unlock()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (m *Master) subscribeExecutor(ex *executorState) chan proto.Message {
+	m.disconnectExecutor(ex)
+
+	events := make(chan proto.Message, eventQueueSize)
+	events <- &executor.Event{
+		Type: executor.Event_SUBSCRIBED,
+		Subscribed: &executor.Event_Subscribed{
+			ExecutorInfo:  ex.info,
+			FrameworkInfo: m.framework,
+			AgentInfo:     m.agents[ex.agentId.Value].info(),
+		},
+	}
+	for _, event := range ex.pending {
+		events <- event
+	}
+	ex.pending = nil
+	ex.events = events
+	return events
+}
+
+// launch records a task and hands it to its executor, which is started with
+// the ExecutorLauncher if needed.
+func (m *Master) launch(agent *agentState, info mesos.TaskInfo) {
+	info.AgentID = mesos.AgentID{Value: agent.Id}
+	t := &taskState{info: info}
+	m.tasks[info.TaskID] = t
+
+	if info.Executor == nil {
+		status := m.newStatus(info, mesos.TASK_ERROR)
+		status.Message = proto.String("only tasks with a custom executor are supported")
+		m.updateStatus(status)
+		return
+	}
+	t.status = m.newStatus(info, mesos.TASK_STAGING)
+
+	ex, ok := m.executors[info.Executor.ExecutorID]
+	if ok && !m.compatibleExecutor(&ex.info, info.Executor) {
+		status := m.newStatus(info, mesos.TASK_ERROR)
+		status.Message = proto.String("ExecutorInfo is not compatible with existing ExecutorInfo")
+		m.updateStatus(status)
+		return
+	}
+	if !ok {
+		ex = &executorState{
+			agentId: info.AgentID,
+			info:    *info.Executor,
+		}
+		if ex.info.FrameworkID == nil {
+			ex.info.FrameworkID = &m.frameworkId
+		}
+		m.executors[info.Executor.ExecutorID] = ex
+		if m.ExecutorLauncher != nil {
+			go m.ExecutorLauncher(agent.Agent, m.frameworkId, ex.info)
+		}
+	}
+	m.sendToExecutor(ex, &executor.Event{
+		Type:   executor.Event_LAUNCH,
+		Launch: &executor.Event_Launch{Task: info},
+	})
+}
+
+// compatibleExecutor tells whether a task may run in an executor, which Mesos
+// only allows if the task carries the ExecutorInfo the executor was launched
+// with.
+func (m *Master) compatibleExecutor(existing *mesos.ExecutorInfo, info *mesos.ExecutorInfo) bool {
+	info = proto.Clone(info).(*mesos.ExecutorInfo)
+	if info.FrameworkID == nil {
+		info.FrameworkID = &m.frameworkId
+	}
+	return proto.Equal(existing, info)
+}
+
+func (m *Master) executorOf(info mesos.TaskInfo) *executorState {
+	if info.Executor == nil {
+		return nil
+	}
+	return m.executors[info.Executor.ExecutorID]
+}
+
+// sendToExecutor sends an event to an executor, or queues it until the
+// executor subscribes.
+func (m *Master) sendToExecutor(ex *executorState, event *executor.Event) {
+	if ex.events == nil {
+		ex.pending = append(ex.pending, event)
+		return
+	}
+	ex.events <- event
+}
+
+func (m *Master) disconnectExecutor(ex *executorState) {
+	if ex.events != nil {
+		close(ex.events)
+		ex.events = nil
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package mesostest
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/encoding/codecs"
+	"github.com/mesos/mesos-go/api/v1/lib/executor"
+	"github.com/mesos/mesos-go/api/v1/lib/executor/calls"
+	"github.com/mesos/mesos-go/api/v1/lib/httpcli"
+	"github.com/mesos/mesos-go/api/v1/lib/httpcli/httpexec"
+	"github.com/pborman/uuid"
+)
+
+// RunFakeExecutor runs an executor which subscribes through the executor API
+// of the master and reports the tasks it is given as RUNNING, and as KILLED
+// once they are killed, without running anything. It returns when the
+// executor is shut down or disconnected.
+//
+// Its signature is the one of an ExecutorLauncherFunc, so that the master can
+// start it for every new executor.
+func (m *Master) RunFakeExecutor(agent Agent, frameworkId mesos.FrameworkID, info mesos.ExecutorInfo) {
+	apiURL := url.URL{
+		Scheme: "http",
+		Host:   m.AgentEndpoint(),
+		Path:   ExecutorApiPath,
+	}
+	cli := httpcli.New(
+		httpcli.Endpoint(apiURL.String()),
+		httpcli.Codec(codecs.ByMediaType[codecs.MediaTypeProtobuf]),
+	)
+	callOptions := executor.CallOptions{
+		calls.Framework(frameworkId.Value),
+		calls.Executor(info.ExecutorID.Value),
+	}
+	sender := calls.SenderWith(httpexec.NewSender(cli.Send), callOptions...)
+	subscriber := calls.SenderWith(httpexec.NewSender(cli.Send, httpcli.Close(true)), callOptions...)
+
+	resp, err := subscriber.Send(context.TODO(), calls.NonStreaming(calls.Subscribe(nil, nil)))
+	if resp != nil {
+		defer resp.Close()
+	}
+	if err != nil {
+		return
+	}
+
+	labels := make(map[mesos.TaskID]*mesos.Labels)
+	update := func(taskId mesos.TaskID, state mesos.TaskState, taskState string) error {
+		status := mesos.TaskStatus{
+			TaskID:     taskId,
+			State:      state.Enum(),
+			Source:     mesos.SOURCE_EXECUTOR.Enum(),
+			ExecutorID: &info.ExecutorID,
+			UUID:       []byte(uuid.NewRandom()),
+			Labels:     common.WithTaskLabel(labels[taskId], common.TASK_LABEL_STATE, taskState),
+		}
+		updateResp, err := sender.Send(context.TODO(), calls.NonStreaming(calls.Update(status)))
+		if updateResp != nil {
+			updateResp.Close()
+		}
+		return err
+	}
+
+	for {
+		var e executor.Event
+		if err = resp.Decode(&e); err != nil {
+			return
+		}
+		switch e.GetType() {
+		case executor.Event_LAUNCH:
+			// Like the O² executor, we report the task as RUNNING once it
+			// reaches STANDBY.
+			task := e.GetLaunch().Task
+			labels[task.TaskID] = task.GetLabels()
+			err = update(task.TaskID, mesos.TASK_RUNNING, "STANDBY")
+		case executor.Event_KILL:
+			taskId := e.GetKill().TaskID
+			err = update(taskId, mesos.TASK_KILLED, "DONE")
+			delete(labels, taskId)
+		case executor.Event_SHUTDOWN:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
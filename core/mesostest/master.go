@@ -0,0 +1,699 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package mesostest provides an in-process fake Mesos master, which emulates
+// the Mesos v1 scheduler and executor HTTP APIs, for end-to-end tests of the
+// core and executor under go test.
+//
+// The master offers the resources of a configurable set of agents, launches
+// tasks on the executors which subscribe to it, relays messages both ways, and
+// lets tests inject status updates and agent loss.
+package mesostest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/encoding/codecs"
+	"github.com/mesos/mesos-go/api/v1/lib/executor"
+	"github.com/mesos/mesos-go/api/v1/lib/recordio"
+	"github.com/mesos/mesos-go/api/v1/lib/scheduler"
+)
+
+const (
+	SchedulerApiPath = "/api/v1/scheduler"
+	ExecutorApiPath  = "/api/v1/executor"
+
+	DefaultOfferInterval = 100 * time.Millisecond
+
+	eventQueueSize = 1024
+)
+
+// Agent describes a fake Mesos agent, whose resources are offered to the
+// subscribed scheduler.
+type Agent struct {
+	Id         string
+	Hostname   string
+	Resources  mesos.Resources
+	Attributes []mesos.Attribute
+}
+
+func (a Agent) info() mesos.AgentInfo {
+	return mesos.AgentInfo{
+		ID:         &mesos.AgentID{Value: a.Id},
+		Hostname:   a.Hostname,
+		Resources:  a.Resources,
+		Attributes: a.Attributes,
+	}
+}
+
+// ExecutorLauncherFunc is called when a task is launched for an executor which
+// has not subscribed yet. It can start an executor, e.g. by running
+// executor.Run in a goroutine, with the master's AgentEndpoint.
+type ExecutorLauncherFunc func(agent Agent, frameworkId mesos.FrameworkID, executor mesos.ExecutorInfo)
+
+type agentState struct {
+	Agent
+	available    mesos.Resources // neither offered nor used by tasks
+	refusedUntil time.Time
+	lost         bool
+}
+
+type taskState struct {
+	info   mesos.TaskInfo
+	status mesos.TaskStatus
+}
+
+type executorState struct {
+	agentId mesos.AgentID
+	info    mesos.ExecutorInfo
+	events  chan proto.Message // nil while not subscribed
+	pending []proto.Message    // queued until subscribed
+}
+
+// Master is a fake Mesos master. It must be created with NewMaster and closed
+// with Close.
+type Master struct {
+	// ExecutorLauncher, if set, is called when an executor is needed.
+	ExecutorLauncher ExecutorLauncherFunc
+
+	mu          sync.Mutex
+	server      *httptest.Server
+	frameworkId mesos.FrameworkID
+	framework   mesos.FrameworkInfo
+	events      chan proto.Message // to the scheduler, nil while not subscribed
+	suppressed  bool
+
+	agents    map[string]*agentState
+	agentIds  []string // in order of addition
+	offers    map[mesos.OfferID]mesos.Offer
+	tasks     map[mesos.TaskID]*taskState
+	executors map[mesos.ExecutorID]*executorState
+
+	calls  []scheduler.Call
+	nextId int
+	done   chan struct{}
+}
+
+// NewMaster starts a fake Mesos master on a local port, which offers the
+// resources of its agents every offerInterval.
+func NewMaster(offerInterval time.Duration) *Master {
+	m := &Master{
+		agents:    make(map[string]*agentState),
+		agentIds:  make([]string, 0),
+		offers:    make(map[mesos.OfferID]mesos.Offer),
+		tasks:     make(map[mesos.TaskID]*taskState),
+		executors: make(map[mesos.ExecutorID]*executorState),
+		calls:     make([]scheduler.Call, 0),
+		done:      make(chan struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(SchedulerApiPath, m.serveScheduler)
+	mux.HandleFunc(ExecutorApiPath, m.serveExecutor)
+	m.server = httptest.NewServer(mux)
+
+	if offerInterval <= 0 {
+		offerInterval = DefaultOfferInterval
+	}
+	go func() {
+		ticker := time.NewTicker(offerInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.done:
+				return
+			case <-ticker.C:
+				m.mu.Lock()
+				m.sendOffers()
+				m.mu.Unlock()
+			}
+		}
+	}()
+	return m
+}
+
+// Close disconnects all clients and stops the master.
+func (m *Master) Close() {
+	m.mu.Lock()
+	close(m.done)
+	m.disconnectScheduler()
+	for _, ex := range m.executors {
+		m.disconnectExecutor(ex)
+	}
+	m.mu.Unlock()
+	m.server.Close()
+}
+
+// SchedulerURL is the URL of the scheduler API, e.g. for the mesosUrl setting
+// of the core.
+func (m *Master) SchedulerURL() string {
+	return m.server.URL + SchedulerApiPath
+}
+
+// AgentEndpoint is the host:port of the executor API, e.g. for the
+// AgentEndpoint of an executor configuration.
+func (m *Master) AgentEndpoint() string {
+	u, _ := url.Parse(m.server.URL)
+	return u.Host
+}
+
+func (m *Master) FrameworkId() mesos.FrameworkID {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.frameworkId
+}
+
+// AddAgent adds an agent, whose resources are offered from now on.
+func (m *Master) AddAgent(agent Agent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.agents[agent.Id]; !ok {
+		m.agentIds = append(m.agentIds, agent.Id)
+	}
+	m.agents[agent.Id] = &agentState{
+		Agent:     agent,
+		available: agent.Resources.Clone(),
+	}
+	m.sendOffers()
+}
+
+// LoseAgent emulates the loss of an agent: its offers are rescinded, its tasks
+// become TASK_LOST, and the scheduler gets a FAILURE event.
+func (m *Master) LoseAgent(agentId string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	agent, ok := m.agents[agentId]
+	if !ok || agent.lost {
+		return fmt.Errorf("no agent %s", agentId)
+	}
+	agent.lost = true
+
+	for id, offer := range m.offers {
+		if offer.AgentID.Value == agentId {
+			delete(m.offers, id)
+			m.sendToScheduler(&scheduler.Event{
+				Type:    scheduler.Event_RESCIND,
+				Rescind: &scheduler.Event_Rescind{OfferID: id},
+			})
+		}
+	}
+	for _, ex := range m.executors {
+		if ex.agentId.Value == agentId {
+			m.disconnectExecutor(ex)
+		}
+	}
+	for _, t := range m.tasks {
+		if t.info.AgentID.Value != agentId || isTerminal(t.status.GetState()) {
+			continue
+		}
+		status := m.newStatus(t.info, mesos.TASK_LOST)
+		status.Reason = mesos.REASON_AGENT_REMOVED.Enum()
+		status.Message = proto.String("agent lost")
+		m.updateStatus(status)
+	}
+	m.sendToScheduler(&scheduler.Event{
+		Type:    scheduler.Event_FAILURE,
+		Failure: &scheduler.Event_Failure{AgentID: &mesos.AgentID{Value: agentId}},
+	})
+	return nil
+}
+
+// UpdateStatus sends an UPDATE event with status to the scheduler, as if it
+// came from the task's executor. If status has no UUID, the scheduler is not
+// expected to acknowledge it.
+func (m *Master) UpdateStatus(status mesos.TaskStatus) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateStatus(status)
+}
+
+// ExecutorMessage sends a MESSAGE event with data to the scheduler, as if it
+// came from the given executor.
+func (m *Master) ExecutorMessage(agentId string, executorId string, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sendToScheduler(&scheduler.Event{
+		Type: scheduler.Event_MESSAGE,
+		Message: &scheduler.Event_Message{
+			AgentID:    mesos.AgentID{Value: agentId},
+			ExecutorID: mesos.ExecutorID{Value: executorId},
+			Data:       data,
+		},
+	})
+}
+
+// Calls returns the calls received from the scheduler so far.
+func (m *Master) Calls() []scheduler.Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]scheduler.Call{}, m.calls...)
+}
+
+// CallCount returns how many calls of type t were received from the scheduler.
+func (m *Master) CallCount(t scheduler.Call_Type) (count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, call := range m.calls {
+		if call.GetType() == t {
+			count++
+		}
+	}
+	return
+}
+
+// Tasks returns all the tasks launched so far.
+func (m *Master) Tasks() []mesos.TaskInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tasks := make([]mesos.TaskInfo, 0, len(m.tasks))
+	for _, t := range m.tasks {
+		tasks = append(tasks, t.info)
+	}
+	return tasks
+}
+
+// TaskStatus returns the last known status of a task.
+func (m *Master) TaskStatus(taskId string) (status mesos.TaskStatus, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tasks[mesos.TaskID{Value: taskId}]
+	if !ok {
+		return
+	}
+	return t.status, true
+}
+
+// Available returns the resources of an agent which are neither offered nor
+// used by tasks.
+func (m *Master) Available(agentId string) mesos.Resources {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	agent, ok := m.agents[agentId]
+	if !ok {
+		return nil
+	}
+	return agent.available.Clone()
+}
+
+func (m *Master) serveScheduler(w http.ResponseWriter, r *http.Request) {
+	var call scheduler.Call
+	if err := decodeCall(r, &call); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	m.calls = append(m.calls, call)
+	if call.GetType() == scheduler.Call_SUBSCRIBE {
+		events := m.subscribeScheduler(call.GetSubscribe().GetFrameworkInfo())
+		m.mu.Unlock()
+		serveEvents(w, r, events, "scheduler-stream")
+		return
+	}
+	err := m.handleSchedulerCall(&call)
+	m.mu.Unlock()
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (m *Master) subscribeScheduler(info *mesos.FrameworkInfo) chan proto.Message {
+	m.disconnectScheduler()
+
+	var framework mesos.FrameworkInfo
+	if info != nil {
+		framework = *info
+	}
+
+	if framework.ID == nil || len(framework.ID.Value) == 0 {
+		if len(m.frameworkId.Value) == 0 {
+			m.frameworkId = mesos.FrameworkID{Value: m.newId("framework")}
+		}
+		framework.ID = &m.frameworkId
+	} else {
+		m.frameworkId = *framework.ID
+	}
+	m.framework = framework
+	m.suppressed = false
+
+	// Outstanding offers are lost with the previous subscription
+	for id, offer := range m.offers {
+		m.agents[offer.AgentID.Value].available.Add(offer.Resources...)
+		delete(m.offers, id)
+	}
+
+	m.events = make(chan proto.Message, eventQueueSize)
+	m.events <- &scheduler.Event{
+		Type: scheduler.Event_SUBSCRIBED,
+		Subscribed: &scheduler.Event_Subscribed{
+			FrameworkID: &m.frameworkId,
+		},
+	}
+	m.sendOffers()
+	return m.events
+}
+
+func (m *Master) handleSchedulerCall(call *scheduler.Call) error {
+	switch call.GetType() {
+	case scheduler.Call_ACCEPT:
+		accept := call.GetAccept()
+		for _, offerId := range accept.GetOfferIDs() {
+			offer, ok := m.offers[offerId]
+			if !ok {
+				continue // rescinded or unknown, Mesos also drops these silently
+			}
+			delete(m.offers, offerId)
+			agent := m.agents[offer.AgentID.Value]
+			remaining := mesos.Resources(offer.Resources).Clone()
+			for _, op := range accept.GetOperations() {
+				switch op.GetType() {
+				case mesos.Offer_Operation_RESERVE:
+					reserved := mesos.Resources(op.GetReserve().GetResources())
+					remaining.Subtract(reserved.ToUnreserved()...)
+					remaining.Add(reserved...)
+				case mesos.Offer_Operation_UNRESERVE:
+					reserved := mesos.Resources(op.GetUnreserve().GetResources())
+					remaining.Subtract(reserved...)
+					remaining.Add(reserved.ToUnreserved()...)
+				case mesos.Offer_Operation_LAUNCH:
+					for _, info := range op.GetLaunch().GetTaskInfos() {
+						remaining.Subtract(info.Resources...)
+						m.launch(agent, info)
+					}
+				}
+			}
+			agent.available.Add(remaining...)
+			m.refuse(agent, accept.GetFilters())
+		}
+	case scheduler.Call_DECLINE:
+		decline := call.GetDecline()
+		for _, offerId := range decline.GetOfferIDs() {
+			offer, ok := m.offers[offerId]
+			if !ok {
+				continue
+			}
+			delete(m.offers, offerId)
+			agent := m.agents[offer.AgentID.Value]
+			agent.available.Add(offer.Resources...)
+			m.refuse(agent, decline.GetFilters())
+		}
+	case scheduler.Call_REVIVE:
+		m.suppressed = false
+		for _, agent := range m.agents {
+			agent.refusedUntil = time.Time{}
+		}
+		m.sendOffers()
+	case scheduler.Call_SUPPRESS:
+		m.suppressed = true
+	case scheduler.Call_KILL:
+		t, ok := m.tasks[call.GetKill().TaskID]
+		if !ok {
+			return fmt.Errorf("unknown task %s", call.GetKill().TaskID.Value)
+		}
+		ex := m.executorOf(t.info)
+		if ex != nil && ex.events != nil {
+			m.sendToExecutor(ex, &executor.Event{
+				Type: executor.Event_KILL,
+				Kill: &executor.Event_Kill{TaskID: t.info.TaskID},
+			})
+		} else {
+			m.updateStatus(m.newStatus(t.info, mesos.TASK_KILLED))
+		}
+	case scheduler.Call_ACKNOWLEDGE:
+		ack := call.GetAcknowledge()
+		if t, ok := m.tasks[ack.TaskID]; ok {
+			if ex := m.executorOf(t.info); ex != nil {
+				m.sendToExecutor(ex, &executor.Event{
+					Type:         executor.Event_ACKNOWLEDGED,
+					Acknowledged: &executor.Event_Acknowledged{TaskID: ack.TaskID, UUID: ack.UUID},
+				})
+			}
+		}
+	case scheduler.Call_MESSAGE:
+		msg := call.GetMessage()
+		ex, ok := m.executors[msg.ExecutorID]
+		if !ok {
+			return fmt.Errorf("unknown executor %s", msg.ExecutorID.Value)
+		}
+		m.sendToExecutor(ex, &executor.Event{
+			Type:    executor.Event_MESSAGE,
+			Message: &executor.Event_Message{Data: msg.Data},
+		})
+	case scheduler.Call_SHUTDOWN:
+		shutdown := call.GetShutdown()
+		ex, ok := m.executors[shutdown.ExecutorID]
+		if !ok {
+			return fmt.Errorf("unknown executor %s", shutdown.ExecutorID.Value)
+		}
+		if ex.events != nil {
+			m.sendToExecutor(ex, &executor.Event{Type: executor.Event_SHUTDOWN})
+		}
+		m.disconnectExecutor(ex)
+		delete(m.executors, shutdown.ExecutorID)
+	case scheduler.Call_RECONCILE:
+		requested := call.GetReconcile().GetTasks()
+		if len(requested) == 0 {
+			for _, t := range m.tasks {
+				m.reconcile(t.info.TaskID)
+			}
+		}
+		for _, rt := range requested {
+			m.reconcile(rt.TaskID)
+		}
+	case scheduler.Call_TEARDOWN:
+		for _, t := range m.tasks {
+			if !isTerminal(t.status.GetState()) {
+				m.updateStatus(m.newStatus(t.info, mesos.TASK_KILLED))
+			}
+		}
+		m.disconnectScheduler()
+	}
+	return nil
+}
+
+func (m *Master) refuse(agent *agentState, filters *mesos.Filters) {
+	if filters == nil || filters.RefuseSeconds == nil {
+		return
+	}
+	agent.refusedUntil = time.Now().Add(time.Duration(*filters.RefuseSeconds * float64(time.Second)))
+}
+
+func (m *Master) reconcile(taskId mesos.TaskID) {
+	var status mesos.TaskStatus
+	if t, ok := m.tasks[taskId]; ok {
+		status = t.status
+	} else {
+		status = mesos.TaskStatus{
+			TaskID: taskId,
+			State:  mesos.TASK_LOST.Enum(),
+			Source: mesos.SOURCE_MASTER.Enum(),
+		}
+	}
+	status.Reason = mesos.REASON_RECONCILIATION.Enum()
+	status.UUID = nil // reconciliation updates are not acknowledged
+	m.sendToScheduler(&scheduler.Event{
+		Type:   scheduler.Event_UPDATE,
+		Update: &scheduler.Event_Update{Status: status},
+	})
+}
+
+// sendOffers offers the available resources of each agent which has no
+// outstanding offer, unless offers are suppressed or refused.
+func (m *Master) sendOffers() {
+	if m.events == nil || m.suppressed {
+		return
+	}
+	now := time.Now()
+	offers := make([]mesos.Offer, 0)
+	for _, agentId := range m.agentIds {
+		agent := m.agents[agentId]
+		if agent.lost || now.Before(agent.refusedUntil) || len(agent.available) == 0 {
+			continue
+		}
+		offer := mesos.Offer{
+			ID:          mesos.OfferID{Value: m.newId("offer")},
+			FrameworkID: m.frameworkId,
+			AgentID:     mesos.AgentID{Value: agent.Id},
+			Hostname:    agent.Hostname,
+			Resources:   agent.available,
+			Attributes:  agent.Attributes,
+		}
+		for id, ex := range m.executors {
+			if ex.agentId.Value == agent.Id && ex.events != nil {
+				offer.ExecutorIDs = append(offer.ExecutorIDs, id)
+			}
+		}
+		agent.available = nil
+		m.offers[offer.ID] = offer
+		offers = append(offers, offer)
+	}
+	if len(offers) == 0 {
+		return
+	}
+	m.sendToScheduler(&scheduler.Event{
+		Type:   scheduler.Event_OFFERS,
+		Offers: &scheduler.Event_Offers{Offers: offers},
+	})
+}
+
+func (m *Master) updateStatus(status mesos.TaskStatus) {
+	if status.Timestamp == nil {
+		status.Timestamp = proto.Float64(float64(time.Now().UnixNano()) / float64(time.Second))
+	}
+	if t, ok := m.tasks[status.TaskID]; ok {
+		if isTerminal(status.GetState()) && !isTerminal(t.status.GetState()) {
+			if agent, ok := m.agents[t.info.AgentID.Value]; ok && !agent.lost {
+				agent.available.Add(t.info.Resources...)
+			}
+		}
+		t.status = status
+	}
+	m.sendToScheduler(&scheduler.Event{
+		Type:   scheduler.Event_UPDATE,
+		Update: &scheduler.Event_Update{Status: status},
+	})
+}
+
+func (m *Master) newStatus(info mesos.TaskInfo, state mesos.TaskState) mesos.TaskStatus {
+	status := mesos.TaskStatus{
+		TaskID:  info.TaskID,
+		State:   state.Enum(),
+		Source:  mesos.SOURCE_MASTER.Enum(),
+		AgentID: &info.AgentID,
+		UUID:    []byte(m.newId("status")),
+	}
+	if info.Executor != nil {
+		status.ExecutorID = &info.Executor.ExecutorID
+	}
+	return status
+}
+
+func (m *Master) sendToScheduler(event *scheduler.Event) {
+	if m.events == nil {
+		return
+	}
+	m.events <- event
+}
+
+func (m *Master) disconnectScheduler() {
+	if m.events != nil {
+		close(m.events)
+		m.events = nil
+	}
+}
+
+func (m *Master) newId(prefix string) string {
+	m.nextId++
+	return fmt.Sprintf("%s-%d", prefix, m.nextId)
+}
+
+func isTerminal(state mesos.TaskState) bool {
+	switch state {
+	case mesos.TASK_FINISHED, mesos.TASK_FAILED, mesos.TASK_KILLED, mesos.TASK_ERROR,
+		mesos.TASK_LOST, mesos.TASK_DROPPED, mesos.TASK_GONE, mesos.TASK_GONE_BY_OPERATOR:
+		return true
+	}
+	return false
+}
+
+// decodeCall decodes a call encoded with either of the codecs supported by
+// mesos-go.
+func decodeCall(r *http.Request, call proto.Message) error {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	switch r.Header.Get("Content-Type") {
+	case codecs.MediaTypeProtobuf.ContentType():
+		return proto.Unmarshal(body, call)
+	case codecs.MediaTypeJSON.ContentType():
+		return json.Unmarshal(body, call)
+	}
+	return errors.New("unsupported content type " + r.Header.Get("Content-Type"))
+}
+
+// serveEvents streams the events received on the channel to the client as
+// RecordIO frames, until the channel is closed or the client disconnects.
+func serveEvents(w http.ResponseWriter, r *http.Request, events <-chan proto.Message, streamId string) {
+	useJson := r.Header.Get("Accept") == codecs.MediaTypeJSON.ContentType()
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// Like the Mesos master, the content type is the one of the messages,
+	// which are framed with RecordIO.
+	if useJson {
+		w.Header().Set("Content-Type", codecs.MediaTypeJSON.ContentType())
+	} else {
+		w.Header().Set("Content-Type", codecs.MediaTypeProtobuf.ContentType())
+	}
+	w.Header().Set("Mesos-Stream-Id", streamId)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	writer := recordio.NewWriter(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			var (
+				data []byte
+				err  error
+			)
+			if useJson {
+				data, err = json.Marshal(event)
+			} else {
+				data, err = proto.Marshal(event)
+			}
+			if err != nil {
+				continue
+			}
+			if err = writer.WriteFrame(data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
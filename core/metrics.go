@@ -78,6 +78,8 @@ type metricsAPI struct {
 	callLatency           xmetrics.Watcher
 	offersReceived        xmetrics.Adder
 	offersDeclined        xmetrics.Adder
+	offersUsed            xmetrics.Adder
+	offerWaitTime         xmetrics.Watcher
 	tasksLaunched         xmetrics.Adder
 	tasksFinished         xmetrics.Counter
 	launchesPerOfferCycle xmetrics.Watcher
@@ -96,6 +98,8 @@ func newMetricsAPI() *metricsAPI {
 		eventReceivedLatency:  newMetricWatchers(schedmetrics.EventReceivedLatency),
 		offersReceived:        newMetricAdder(schedmetrics.OffersReceived),
 		offersDeclined:        newMetricAdder(schedmetrics.OffersDeclined),
+		offersUsed:            newMetricAdder(schedmetrics.OffersUsed),
+		offerWaitTime:         newMetricWatcher(schedmetrics.OfferWaitTime),
 		tasksLaunched:         newMetricAdder(schedmetrics.TasksLaunched),
 		tasksFinished:         newMetricCounter(schedmetrics.TasksFinished),
 		launchesPerOfferCycle: newMetricWatcher(schedmetrics.TasksLaunchedPerOfferCycle),
@@ -81,6 +81,16 @@ var (
 		Name:      "offers_declined",
 		Help:      "The number of offers declined.",
 	})
+	OffersUsed = prometheus.NewCounter(prometheus.CounterOpts{
+		Subsystem: Subsystem,
+		Name:      "offers_used",
+		Help:      "The number of offers used to launch tasks.",
+	})
+	OfferWaitTime = prometheus.NewSummary(prometheus.SummaryOpts{
+		Subsystem: Subsystem,
+		Name:      "offer_wait_seconds",
+		Help:      "Time a deployment waited for offers to launch its tasks.",
+	})
 	TasksFinished = prometheus.NewCounter(prometheus.CounterOpts{
 		Subsystem: Subsystem,
 		Name:      "tasks_finished",
@@ -125,6 +135,8 @@ func Register() {
 		prometheus.MustRegister(EventReceivedLatency)
 		prometheus.MustRegister(OffersReceived)
 		prometheus.MustRegister(OffersDeclined)
+		prometheus.MustRegister(OffersUsed)
+		prometheus.MustRegister(OfferWaitTime)
 		prometheus.MustRegister(JobStartCount)
 		prometheus.MustRegister(TasksFinished)
 		prometheus.MustRegister(TasksLaunched)
@@ -0,0 +1,264 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/placement"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// offerMatcher matches the descriptors of a deployment against the offers of
+// the backend, be it the offers of Mesos agents or the local machine, and
+// claims the resources of the tasks it places.
+type offerMatcher struct {
+	taskman     *task.Manager
+	constraints map[*task.Descriptor]constraint.Constraints
+	placements  map[*task.Descriptor]placement.Policy
+	occupancy   *placement.Occupancy
+	ports       *task.PortAllocator
+	report      *task.DeploymentReport
+	// The executors run containerized tasks through this runtime, if any
+	containerRuntime string
+}
+
+func newOfferMatcher(taskman *task.Manager, descriptors task.Descriptors) *offerMatcher {
+	return &offerMatcher{
+		taskman: taskman,
+		// We make a map[Descriptor]constraint.Constraints and for each descriptor to deploy we
+		// fill it with the pre-computed total constraints for that Descriptor.
+		constraints: taskman.BuildDescriptorConstraints(descriptors),
+		// Same for placement policies, which we check against the occupancy of the hosts
+		// by the tasks of the environment, including the ones we place in this round.
+		placements: taskman.BuildDescriptorPlacements(descriptors),
+		occupancy:  taskman.DeploymentOccupancy(),
+		// Dynamic ports come from the configured pools, and no port held by
+		// another task on the same host is ever handed out again.
+		ports: taskman.DeploymentPorts(),
+		// The report collects the reasons why offers are rejected, so that a failed
+		// deployment can be explained to the user.
+		report: taskman.DeploymentReport(),
+		containerRuntime: viper.GetString("containerRuntime"),
+	}
+}
+
+// match checks whether the task of descriptor can run on the host of offer,
+// with the resources in available, of which executorResources are taken by a
+// new executor if needed. If so it returns what the task wants, otherwise it
+// records the reason in the deployment report.
+func (m *offerMatcher) match(descriptor *task.Descriptor, offer *mesos.Offer, available mesos.Resources, executorResources mesos.Resources) (wants *task.Wants, ok bool) {
+	if hostState := m.taskman.HostStates.Get(offer.Hostname); hostState != task.HOST_ACTIVE {
+		m.report.Reject(descriptor, offer, fmt.Sprintf("host is in state %s", hostState.String()))
+		return nil, false
+	}
+
+	offerAttributes := constraint.Attributes(offer.Attributes)
+	if !offerAttributes.Satisfy(m.constraints[descriptor]) {
+		if viper.GetBool("veryVerbose") {
+			log.WithPrefix("scheduler").
+				WithFields(logrus.Fields{
+					"taskClass": descriptor.TaskClassName,
+					"constraints": m.constraints[descriptor],
+					"offerId": offer.ID.Value,
+					"resources": available.String(),
+					"attributes": offerAttributes.String(),
+				}).
+				Warn("descriptor constraints not satisfied by offer attributes")
+		}
+		m.report.Reject(descriptor, offer, fmt.Sprintf("constraints %s unsatisfied",
+			offerAttributes.Unsatisfied(m.constraints[descriptor]).String()))
+		return nil, false
+	}
+	log.WithPrefix("scheduler").Debug("offer attributes satisfy constraints")
+
+	descriptorPath := descriptor.TaskRole.GetPath()
+	if ok, reason := m.occupancy.Allows(offer.Hostname, descriptorPath, m.placements[descriptor]); !ok {
+		if viper.GetBool("veryVerbose") {
+			log.WithPrefix("scheduler").
+				WithFields(logrus.Fields{
+					"role": descriptorPath,
+					"placement": m.placements[descriptor].String(),
+					"offerId": offer.ID.Value,
+					"hostname": offer.Hostname,
+					"reason": reason,
+				}).
+				Warn("descriptor placement policy not satisfied by offer")
+		}
+		m.report.Reject(descriptor, offer, reason)
+		return nil, false
+	}
+
+	wants = m.taskman.GetWantsForDescriptor(descriptor)
+	if wants == nil {
+		log.WithPrefix("scheduler").WithField("class", descriptor.TaskClassName).
+			Warning("no resource demands for descriptor, invalid class perhaps?")
+		m.report.Reject(descriptor, offer, "no resource demands for task class")
+		return nil, false
+	}
+	if taskClass := m.taskman.GetTaskClass(descriptor.TaskClassName); taskClass != nil &&
+		taskClass.Container != nil && len(m.containerRuntime) == 0 {
+		m.report.Reject(descriptor, offer, fmt.Sprintf("container image %s wanted, but no containerRuntime is configured",
+			taskClass.Container.Image))
+		return nil, false
+	}
+	if len(executorResources) > 0 {
+		if !resources.ContainsAll(available, executorResources) {
+			m.report.Reject(descriptor, offer, "insufficient resources for a new executor")
+			return nil, false
+		}
+		available = available.Minus(executorResources...)
+	}
+	if reason := task.Resources(available).Unsatisfied(wants); len(reason) > 0 {
+		m.report.Reject(descriptor, offer, reason)
+		return nil, false
+	}
+	if conflicts := m.ports.StaticConflicts(offer.Hostname, wants.StaticPorts); len(conflicts) > 0 {
+		m.report.Reject(descriptor, offer, fmt.Sprintf("static ports already in use: %s",
+			strings.Join(conflicts, ", ")))
+		return nil, false
+	}
+	return wants, true
+}
+
+// resourceClaim holds what the task of a descriptor takes from an offer.
+type resourceClaim struct {
+	wants       *task.Wants
+	bindPorts   map[string]uint64
+	controlPort uint64
+}
+
+// resources returns the resources to request for the task.
+func (c *resourceClaim) resources() (request mesos.Resources) {
+	request = make(mesos.Resources, 0)
+	request.Add1(resources.NewCPUs(c.wants.Cpu).Resource)
+	request.Add1(resources.NewMemory(c.wants.Memory).Resource)
+	portsBuilder := resources.BuildRanges()
+	for _, rng := range c.wants.StaticPorts {
+		portsBuilder = portsBuilder.Span(rng.Begin, rng.End)
+	}
+	for _, port := range c.bindPorts {
+		portsBuilder = portsBuilder.Span(port, port)
+	}
+	portsBuilder = portsBuilder.Span(c.controlPort, c.controlPort)
+	request.Add1(portsResource(portsBuilder.Ranges.Sort().Squash()))
+	request.Add(c.wants.Custom.Resources()...)
+	return
+}
+
+// claim takes what the task of descriptor wants from remaining, including its
+// bind and control ports, which it holds in the port allocator.
+// If it fails, neither remaining nor the port allocator are modified.
+func (m *offerMatcher) claim(descriptor *task.Descriptor, offer *mesos.Offer, wants *task.Wants, remaining *mesos.Resources) (claim *resourceClaim, err error) {
+	descriptorPath := descriptor.TaskRole.GetPath()
+	claimed := remaining.Clone()
+
+	// The scalar, static ports and custom resources go first, so that the
+	// dynamic ports are allocated among what's left.
+	claimed.Subtract(
+		resources.NewCPUs(wants.Cpu).Resource,
+		resources.NewMemory(wants.Memory).Resource,
+	)
+	staticPorts := resources.BuildRanges()
+	for _, rng := range wants.StaticPorts {
+		staticPorts = staticPorts.Span(rng.Begin, rng.End)
+	}
+	if len(staticPorts.Ranges) > 0 {
+		claimed.Subtract(portsResource(staticPorts.Ranges.Sort().Squash()))
+	}
+	claimed.Subtract(wants.Custom.Resources()...)
+	for _, rng := range wants.StaticPorts {
+		for port := rng.Begin; port <= rng.End; port++ {
+			m.ports.Hold(offer.Hostname, port, descriptorPath)
+		}
+	}
+
+	claim = &resourceClaim{
+		wants:     wants,
+		bindPorts: make(map[string]uint64),
+	}
+	for _, ch := range wants.BindPorts {
+		claim.bindPorts[ch.Name], err = m.claimPort(&claimed, offer, task.BIND_PORTS,
+			preferredBindPort(descriptor, offer.Hostname, ch.Name), descriptorPath)
+		if err != nil {
+			m.release(descriptor, offer)
+			return nil, err
+		}
+	}
+	claim.controlPort, err = m.claimPort(&claimed, offer, task.CONTROL_PORTS,
+		preferredControlPort(descriptor, offer.Hostname), descriptorPath)
+	if err != nil {
+		m.release(descriptor, offer)
+		return nil, err
+	}
+
+	*remaining = claimed
+	return
+}
+
+// claimPort allocates a port for the given purpose among the ports left in
+// remaining, see PortAllocator.Allocate, and removes it from remaining.
+func (m *offerMatcher) claimPort(remaining *mesos.Resources, offer *mesos.Offer, purpose task.PortPurpose, preferred uint64, holder string) (port uint64, err error) {
+	availPorts, ok := resources.Ports(*remaining...)
+	if !ok {
+		return 0, errors.New("no ports available")
+	}
+	port, err = m.ports.Allocate(offer.Hostname, constraint.Attributes(offer.Attributes), purpose,
+		availPorts, preferred, holder)
+	if err != nil {
+		return
+	}
+	remaining.Subtract(portsResource(resources.BuildRanges().Span(port, port).Ranges))
+	return
+}
+
+// release gives back the ports held for the task of descriptor, when it cannot
+// be launched after all.
+func (m *offerMatcher) release(descriptor *task.Descriptor, offer *mesos.Offer) {
+	m.ports.Release(offer.Hostname, descriptor.TaskRole.GetPath())
+}
+
+// placed records the task of descriptor as placed on the host of offer, so that
+// the placement policies of the next descriptors take it into account.
+func (m *offerMatcher) placed(descriptor *task.Descriptor, offer *mesos.Offer) {
+	m.occupancy.Add(offer.Hostname, descriptor.TaskRole.GetPath(), m.placements[descriptor])
+}
+
+func portsResource(ranges mesos.Ranges) mesos.Resource {
+	return resources.Build().
+		Name(resources.Name("ports")).
+		Ranges(ranges).
+		Resource
+}
@@ -0,0 +1,214 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/mesos/mesos-go/api/v1/lib/scheduler"
+	"github.com/mesos/mesos-go/api/v1/lib/scheduler/calls"
+	"github.com/mesos/mesos-go/api/v1/lib/scheduler/events"
+	"github.com/spf13/viper"
+)
+
+// offerController keeps track of the offers we want from Mesos. It suppresses
+// offers while the core has nothing to deploy, so that a busy shared Mesos
+// master does not keep offering us its agents, and it holds a deployment
+// across offer rounds until enough offers came in or the wait times out.
+type offerController struct {
+	mu         sync.Mutex
+	suppressed bool
+	idleSince  time.Time
+	revivedAt  time.Time // zero once the deployment the revive was for began
+	deployment *pendingDeployment
+}
+
+// pendingDeployment is a request to deploy descriptors, which may take several
+// offer rounds to fulfil.
+type pendingDeployment struct {
+	descriptors task.Descriptors // still to deploy
+	deployed    task.DeploymentMap
+	since       time.Time
+	timer       *time.Timer
+}
+
+func newOfferController() *offerController {
+	return &offerController{
+		idleSince: time.Now(),
+	}
+}
+
+// Lock must be held while a round of offers is processed, so that a pending
+// deployment does not time out halfway through.
+func (oc *offerController) Lock() {
+	oc.mu.Lock()
+}
+
+func (oc *offerController) Unlock() {
+	oc.mu.Unlock()
+}
+
+// Deployment returns the pending deployment, if any. Lock must be held.
+func (oc *offerController) Deployment() *pendingDeployment {
+	return oc.deployment
+}
+
+// BeginDeployment starts waiting for offers for descriptors, for at most
+// mesosOfferWaitTimeout. Lock must be held.
+func (oc *offerController) BeginDeployment(state *internalState, descriptors task.Descriptors) *pendingDeployment {
+	deployment := &pendingDeployment{
+		descriptors: descriptors,
+		deployed:    make(task.DeploymentMap),
+		since:       time.Now(),
+	}
+	deployment.timer = time.AfterFunc(viper.GetDuration("mesosOfferWaitTimeout"), func() {
+		oc.mu.Lock()
+		defer oc.mu.Unlock()
+		if oc.deployment != deployment {
+			return
+		}
+		log.WithPrefix("scheduler").
+			WithField("undeployed", len(deployment.descriptors)).
+			Warning("timed out waiting for offers")
+		oc.finishDeployment(state)
+	})
+	oc.deployment = deployment
+	oc.suppressed = false
+	oc.revivedAt = time.Time{}
+	return deployment
+}
+
+// UpdateDeployment records the outcome of an offer round for the pending
+// deployment, which ends as soon as all of its tasks are deployed. Lock must be held.
+func (oc *offerController) UpdateDeployment(state *internalState, remaining task.Descriptors, deployed task.DeploymentMap) {
+	deployment := oc.deployment
+	if deployment == nil {
+		return
+	}
+	deployment.descriptors = remaining
+	for k, v := range deployed {
+		deployment.deployed[k] = v
+	}
+	if len(remaining) == 0 {
+		oc.finishDeployment(state)
+		return
+	}
+	log.WithPrefix("scheduler").
+		WithField("undeployed", len(remaining)).
+		Debug("waiting for more offers")
+}
+
+// finishDeployment hands the tasks deployed so far to the task manager, which
+// is waiting for them in AcquireTasks. Lock must be held.
+func (oc *offerController) finishDeployment(state *internalState) {
+	deployment := oc.deployment
+	oc.deployment = nil
+	oc.idleSince = time.Now()
+	deployment.timer.Stop()
+	state.metricsAPI.offerWaitTime(time.Since(deployment.since).Seconds())
+
+	// Notify listeners...
+	select {
+	case state.resourceOffersDone <- deployment.deployed:
+		log.WithPrefix("scheduler").
+			WithField("tasksDeployed", len(deployment.deployed)).
+			Debug("notified listeners on resourceOffers done")
+	default:
+		if viper.GetBool("veryVerbose") {
+			log.WithPrefix("scheduler").
+				Debug("no listeners notified")
+		}
+	}
+}
+
+// RefuseSeconds is how long Mesos should not offer us again the resources we
+// decline. While a deployment waits for offers we want them back soon, otherwise
+// we refuse them for up to mesosMaxRefuseSeconds. Lock must be held.
+// The task manager revives offers before it hands over the descriptors to
+// deploy, so the offers which come in between are only refused briefly too,
+// lest the deployment they were revived for wait for them in vain.
+func (oc *offerController) RefuseSeconds(state *internalState) scheduler.CallOpt {
+	reviving := !oc.revivedAt.IsZero() &&
+		time.Since(oc.revivedAt) < viper.GetDuration("mesosOfferWaitTimeout")
+	if oc.deployment != nil || reviving {
+		return calls.RefuseSeconds(viper.GetDuration("mesosDeploymentRefuseSeconds"))
+	}
+	return calls.RefuseSecondsWithJitter(state.random, viper.GetDuration("mesosMaxRefuseSeconds"))
+}
+
+// Idle suppresses offers once there has been nothing to deploy for
+// mesosSuppressOffersAfter. Lock must be held.
+func (oc *offerController) Idle(ctx context.Context, state *internalState) {
+	suppressAfter := viper.GetDuration("mesosSuppressOffersAfter")
+	if oc.suppressed || oc.deployment != nil || suppressAfter <= 0 ||
+		time.Since(oc.idleSince) < suppressAfter {
+		return
+	}
+	err := calls.CallNoData(ctx, state.cli, calls.Suppress())
+	if err != nil {
+		log.WithPrefix("scheduler").WithError(err).Error("failed to suppress offers")
+		return
+	}
+	oc.suppressed = true
+	log.WithPrefix("scheduler").Info("nothing to deploy, offers suppressed")
+}
+
+// Reviving must be called before a REVIVE call, since Mesos may send the offers
+// it brings before the call returns.
+func (oc *offerController) Reviving() {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+	oc.revivedAt = time.Now()
+}
+
+// Revived must be called whenever Mesos resumes sending offers, i.e. after a
+// REVIVE call or a new subscription.
+func (oc *offerController) Revived() {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+	oc.suppressed = false
+	oc.idleSince = time.Now()
+}
+
+func (oc *offerController) IsSuppressed() bool {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+	return oc.suppressed
+}
+
+// Handler for Event_SUBSCRIBED, since a new subscription clears the
+// suppression of offers.
+func resetOfferSuppression(state *internalState) events.HandlerFunc {
+	return func(_ context.Context, _ *scheduler.Event) error {
+		state.offers.Revived()
+		return nil
+	}
+}
@@ -191,15 +191,28 @@ func (r *Repo) refresh() error {
 	return nil
 }
 
-func (r *Repo) getWorkflows() ([]string, error) {
-	var workflows []string
+func (r *Repo) getWorkflows() ([]WorkflowTemplate, error) {
+	var workflows []WorkflowTemplate
 	files, err := ioutil.ReadDir(r.getWorkflowDir())
 	if err != nil {
 		return workflows, err
 	}
 	for _, file := range files {
 		if strings.HasSuffix(file.Name(), ".yaml") { // Only return .yaml files
-			workflows = append(workflows, strings.TrimSuffix(file.Name(), ".yaml"))
+			workflow := WorkflowTemplate{Name: strings.TrimSuffix(file.Name(), ".yaml")}
+
+			// A template with a broken header is still listed, it will fail on load
+			yamlDoc, err := ioutil.ReadFile(r.getWorkflowDir() + file.Name())
+			if err == nil {
+				workflow.Parameters, err = ParseWorkflowParameters(yamlDoc)
+			}
+			if err != nil {
+				log.WithError(err).
+					WithField("workflow", workflow.Name).
+					Warning("cannot read workflow template parameters")
+			}
+
+			workflows = append(workflows, workflow)
 		}
 	}
 	return workflows, nil
@@ -199,6 +199,18 @@ func (manager *RepoManager) GetRepos() (repoList map[string]*Repo) {
 	return manager.repoList
 }
 
+// ResolveTaskClassIdentifier returns the full identifier of a task class,
+// looking it up in the default repository if no repository is specified.
+func (manager *RepoManager) ResolveTaskClassIdentifier(loadTaskClass string) string {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if manager.defaultRepo == nil {
+		return loadTaskClass
+	}
+	return manager.defaultRepo.ResolveTaskClassIdentifier(loadTaskClass)
+}
+
 func (manager *RepoManager) RemoveRepoByIndex(index int) (ok bool, newDefaultRepo string) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -408,8 +420,8 @@ func (manager *RepoManager) GetOrderedRepolistKeys() []string {
 	return keys
 }
 
-func (manager *RepoManager) GetWorkflowTemplates() (map[string][]string, int, error) {
-	templateList := make(map[string][]string)
+func (manager *RepoManager) GetWorkflowTemplates() (map[string][]WorkflowTemplate, int, error) {
+	templateList := make(map[string][]WorkflowTemplate)
 	numTemplates := 0
 	for _, repo := range manager.GetRepos() {
 		templates, err := repo.getWorkflows()
@@ -0,0 +1,13 @@
+package repos_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRepos(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Repos Suite")
+}
@@ -0,0 +1,169 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Kostas Alexopoulos <kostas.alexopoulos@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package repos
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	PARAMETER_TYPE_STRING = "string"
+	PARAMETER_TYPE_INT    = "int"
+	PARAMETER_TYPE_FLOAT  = "float"
+	PARAMETER_TYPE_BOOL   = "bool"
+)
+
+// WorkflowParameter is a parameter declaration in the header block of a
+// workflow template, e.g.
+//   parameters:
+//     - name: detector
+//       type: string
+//       default: TPC
+//       description: detector to read out
+//       values: [ TPC, ITS, MFT ]
+// A parameter without a default value is required.
+type WorkflowParameter struct {
+	Name        string   `yaml:"name"`
+	Type        string   `yaml:"type,omitempty"`
+	Default     *string  `yaml:"default,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+	Values      []string `yaml:"values,omitempty"`
+}
+
+type WorkflowParameters []WorkflowParameter
+
+type WorkflowTemplate struct {
+	Name       string
+	Parameters WorkflowParameters
+}
+
+// ParseWorkflowParameters reads the parameter declarations of a workflow
+// template, if any.
+func ParseWorkflowParameters(yamlDoc []byte) (params WorkflowParameters, err error) {
+	header := struct {
+		Parameters WorkflowParameters `yaml:"parameters"`
+	}{}
+	err = yaml.Unmarshal(yamlDoc, &header)
+	if err != nil {
+		return nil, err
+	}
+	params = header.Parameters
+
+	names := make(map[string]bool)
+	for i, param := range params {
+		if len(strings.TrimSpace(param.Name)) == 0 {
+			return nil, fmt.Errorf("workflow parameter at index %d has no name", i)
+		}
+		if names[param.Name] {
+			return nil, fmt.Errorf("workflow parameter %s declared more than once", param.Name)
+		}
+		names[param.Name] = true
+
+		if len(param.Type) == 0 {
+			params[i].Type = PARAMETER_TYPE_STRING
+		}
+		if param.Default != nil {
+			err = params[i].check(*param.Default)
+			if err != nil {
+				return nil, fmt.Errorf("invalid default value: %s", err.Error())
+			}
+		}
+	}
+	return
+}
+
+func (p WorkflowParameter) check(value string) (err error) {
+	switch p.Type {
+	case PARAMETER_TYPE_STRING:
+	case PARAMETER_TYPE_INT:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case PARAMETER_TYPE_FLOAT:
+		_, err = strconv.ParseFloat(value, 64)
+	case PARAMETER_TYPE_BOOL:
+		_, err = strconv.ParseBool(value)
+	default:
+		return fmt.Errorf("parameter %s has unknown type %s", p.Name, p.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("parameter %s expects a value of type %s, got %s", p.Name, p.Type, value)
+	}
+
+	if len(p.Values) == 0 {
+		return
+	}
+	for _, allowed := range p.Values {
+		if value == allowed {
+			return
+		}
+	}
+	return fmt.Errorf("parameter %s expects one of [%s], got %s", p.Name, strings.Join(p.Values, ", "), value)
+}
+
+// Validate checks the vars supplied for a new environment against the
+// parameter declarations, and returns them completed with default values.
+// If the workflow template declares no parameters, any var is accepted.
+func (ps WorkflowParameters) Validate(vars map[string]string) (resolved map[string]string, err error) {
+	resolved = make(map[string]string)
+	for k, v := range vars {
+		resolved[k] = v
+	}
+	if len(ps) == 0 {
+		return
+	}
+
+	declared := make(map[string]bool)
+	problems := make([]string, 0)
+	for _, param := range ps {
+		declared[param.Name] = true
+		value, ok := resolved[param.Name]
+		if !ok {
+			if param.Default == nil {
+				problems = append(problems, fmt.Sprintf("required parameter %s not set", param.Name))
+				continue
+			}
+			resolved[param.Name] = *param.Default
+			continue
+		}
+		if checkErr := param.check(value); checkErr != nil {
+			problems = append(problems, checkErr.Error())
+		}
+	}
+	for k := range vars {
+		if !declared[k] {
+			problems = append(problems, fmt.Sprintf("parameter %s not declared by workflow template", k))
+		}
+	}
+
+	if len(problems) != 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("invalid workflow parameters: %s", strings.Join(problems, "; "))
+	}
+	return
+}
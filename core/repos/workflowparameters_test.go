@@ -0,0 +1,147 @@
+package repos_test
+
+import (
+	. "github.com/AliceO2Group/Control/core/repos"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkflowParameters", func() {
+	var (
+		params WorkflowParameters
+		err    error
+	)
+
+	Describe("parsing", func() {
+		It("should parse the parameter declarations of a workflow template", func() {
+			params, err = ParseWorkflowParameters([]byte(`
+name: readout
+parameters:
+  - name: detector
+    default: TPC
+    description: detector to read out
+    values: [ TPC, ITS, MFT ]
+  - name: rate
+    type: int
+roles: []
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(params).To(HaveLen(2))
+			Expect(params[0].Name).To(Equal("detector"))
+			Expect(params[0].Type).To(Equal(PARAMETER_TYPE_STRING))
+			Expect(*params[0].Default).To(Equal("TPC"))
+			Expect(params[0].Values).To(Equal([]string{"TPC", "ITS", "MFT"}))
+			Expect(params[1].Type).To(Equal(PARAMETER_TYPE_INT))
+			Expect(params[1].Default).To(BeNil())
+		})
+
+		It("should accept a workflow template without parameters", func() {
+			params, err = ParseWorkflowParameters([]byte(`
+name: readout
+roles: []
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(params).To(BeEmpty())
+		})
+
+		It("should reject a parameter without a name", func() {
+			_, err = ParseWorkflowParameters([]byte(`
+parameters:
+  - type: int
+`))
+			Expect(err).To(MatchError("workflow parameter at index 0 has no name"))
+		})
+
+		It("should reject a parameter declared more than once", func() {
+			_, err = ParseWorkflowParameters([]byte(`
+parameters:
+  - name: rate
+  - name: rate
+`))
+			Expect(err).To(MatchError("workflow parameter rate declared more than once"))
+		})
+
+		It("should reject a default value of the wrong type", func() {
+			_, err = ParseWorkflowParameters([]byte(`
+parameters:
+  - name: rate
+    type: int
+    default: fast
+`))
+			Expect(err).To(MatchError("invalid default value: parameter rate expects a value of type int, got fast"))
+		})
+
+		It("should reject a default value which is not among the allowed values", func() {
+			_, err = ParseWorkflowParameters([]byte(`
+parameters:
+  - name: detector
+    default: EMC
+    values: [ TPC, ITS ]
+`))
+			Expect(err).To(MatchError("invalid default value: parameter detector expects one of [TPC, ITS], got EMC"))
+		})
+
+		It("should reject a parameter of unknown type", func() {
+			_, err = ParseWorkflowParameters([]byte(`
+parameters:
+  - name: rate
+    type: complex
+    default: "1"
+`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown type complex"))
+		})
+	})
+
+	Describe("validation", func() {
+		BeforeEach(func() {
+			params, err = ParseWorkflowParameters([]byte(`
+parameters:
+  - name: detector
+    default: TPC
+    values: [ TPC, ITS ]
+  - name: rate
+    type: float
+  - name: verbose
+    type: bool
+    default: "false"
+`))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should complete the vars with the default values", func() {
+			vars, err := params.Validate(map[string]string{"rate": "1.5"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vars).To(Equal(map[string]string{
+				"detector": "TPC",
+				"rate":     "1.5",
+				"verbose":  "false",
+			}))
+		})
+
+		It("should not override the values which are set", func() {
+			vars, err := params.Validate(map[string]string{"rate": "2", "detector": "ITS"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vars).To(HaveKeyWithValue("detector", "ITS"))
+		})
+
+		It("should report all problems at once", func() {
+			_, err := params.Validate(map[string]string{
+				"detector": "MFT",
+				"verbose":  "maybe",
+				"extra":    "1",
+			})
+			Expect(err).To(MatchError("invalid workflow parameters: " +
+				"parameter detector expects one of [TPC, ITS], got MFT; " +
+				"parameter extra not declared by workflow template; " +
+				"parameter verbose expects a value of type bool, got maybe; " +
+				"required parameter rate not set"))
+		})
+
+		It("should accept any var if the workflow template declares no parameters", func() {
+			vars, err := WorkflowParameters{}.Validate(map[string]string{"anything": "goes"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vars).To(Equal(map[string]string{"anything": "goes"}))
+		})
+	})
+})
@@ -0,0 +1,305 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	"github.com/mesos/mesos-go/api/v1/lib/scheduler/calls"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// Label of the dynamic reservations made for an environment, so that they can
+// be released when the environment is gone.
+const RESERVATION_LABEL_ENVIRONMENT_ID = "o2.environmentId"
+
+// reservationPolicy tells which resources of an offer tasks may use.
+// With a role, tasks only run on resources reserved for it, e.g. flp, so that
+// the hosts of that role are never taken by other frameworks. With dynamic
+// reservations, unreserved resources are reserved for the role as tasks are
+// launched, for the lifetime of the environment of each task.
+type reservationPolicy struct {
+	role      string
+	principal string
+	dynamic   bool
+}
+
+func reservationPolicyFromConfig() reservationPolicy {
+	return reservationPolicy{
+		role:      viper.GetString("mesosReservedRole"),
+		principal: viper.GetString("mesosPrincipal"),
+		dynamic:   viper.GetBool("mesosDynamicReservations"),
+	}
+}
+
+// frameworkRoles returns the roles the framework registers with.
+func frameworkRoles() []string {
+	if roles := viper.GetStringSlice("mesosFrameworkRoles"); len(roles) > 0 {
+		return roles
+	}
+	if role := viper.GetString("mesosFrameworkRole"); role != "" {
+		return []string{role}
+	}
+	return nil
+}
+
+// validateReservationConfig checks that the framework registers with the role
+// for which resources are reserved, since Mesos would never offer them otherwise.
+func validateReservationConfig() error {
+	return reservationPolicyFromConfig().validate(frameworkRoles())
+}
+
+func (p reservationPolicy) validate(frameworkRoles []string) error {
+	if p.role == "" {
+		if p.dynamic {
+			return fmt.Errorf("dynamic reservations need a reserved role, see mesosReservedRole")
+		}
+		return nil
+	}
+	for _, role := range frameworkRoles {
+		if role == p.role {
+			return nil
+		}
+	}
+	return fmt.Errorf("reserved role %s is not among the framework roles", p.role)
+}
+
+// allows tells whether tasks of environment envId may use r. Resources
+// dynamically reserved for an environment are only ever used by its own tasks.
+func (p reservationPolicy) allows(r *mesos.Resource, envId string) bool {
+	if p.role == "" {
+		return true
+	}
+	if !r.IsReserved(p.role) {
+		return p.dynamic && r.IsUnreserved()
+	}
+	reservedFor, ok := common.GetTaskLabel(dynamicReservationLabels(r), RESERVATION_LABEL_ENVIRONMENT_ID)
+	return !ok || reservedFor == envId
+}
+
+// usable returns the resources of an offer which the policy allows tasks of
+// environment envId to use, without their allocation to a role.
+func (p reservationPolicy) usable(offerResources []mesos.Resource, envId string) (pool mesos.Resources) {
+	pool = make(mesos.Resources, 0, len(offerResources))
+	for _, r := range mesos.Resources(offerResources).Clone() {
+		if !p.allows(&r, envId) {
+			continue
+		}
+		r.Unallocate()
+		pool.Add1(r)
+	}
+	return
+}
+
+// assign takes the resources wanted by a task from pool, preferring those
+// reserved for the role. Under dynamic reservations, the unreserved ones are
+// reserved for the environment of the task, and also returned in toReserve.
+// Both are allocated to the role of the offer, if any.
+func (p reservationPolicy) assign(pool *mesos.Resources, wants mesos.Resources, envId string, allocatedTo string) (assigned mesos.Resources, toReserve mesos.Resources, err error) {
+	if len(wants) == 0 {
+		return
+	}
+	want := wants.Clone()
+	if p.role != "" {
+		for i := range want {
+			role := p.role
+			want[i].Role = &role
+		}
+	}
+	// pool may come from usable for another environment
+	candidates := make(mesos.Resources, 0, len(*pool))
+	for i := range *pool {
+		if p.allows(&(*pool)[i], envId) {
+			candidates = append(candidates, (*pool)[i])
+		}
+	}
+	found := resources.Find(want, candidates...)
+	if found == nil {
+		err = fmt.Errorf("cannot find %s among the usable resources", wants.String())
+		return
+	}
+	pool.Subtract(found...)
+
+	for _, r := range found {
+		if p.dynamic && r.IsUnreserved() {
+			role := p.role
+			r.Role = &role
+			r.Reservation = &mesos.Resource_ReservationInfo{
+				Labels: common.WithTaskLabel(nil, RESERVATION_LABEL_ENVIRONMENT_ID, envId),
+			}
+			if p.principal != "" {
+				principal := p.principal
+				r.Reservation.Principal = &principal
+			}
+			toReserve.Add1(r)
+		}
+		assigned.Add1(r)
+	}
+	if allocatedTo != "" {
+		assigned.Allocate(allocatedTo)
+		toReserve.Allocate(allocatedTo)
+	}
+	return
+}
+
+// allocationRole returns the role an offer is allocated to, which is only set
+// when the framework has several roles.
+func allocationRole(offer *mesos.Offer) string {
+	for _, r := range offer.Resources {
+		if role := r.GetAllocationInfo().GetRole(); role != "" {
+			return role
+		}
+	}
+	return ""
+}
+
+// stale returns the resources of an offer dynamically reserved for environments
+// which are gone.
+func (rt *reservationTracker) stale(offerResources []mesos.Resource) (stale mesos.Resources) {
+	for _, r := range offerResources {
+		envId, ok := common.GetTaskLabel(dynamicReservationLabels(&r), RESERVATION_LABEL_ENVIRONMENT_ID)
+		if ok && !rt.IsActive(envId) {
+			stale = append(stale, r)
+		}
+	}
+	return
+}
+
+// dynamicReservationLabels returns the labels of the dynamic reservation of a
+// resource, if any, in either reservation format.
+func dynamicReservationLabels(r *mesos.Resource) *mesos.Labels {
+	if rs := r.GetReservations(); len(rs) > 0 {
+		if last := rs[len(rs)-1]; last.GetType() == mesos.Resource_ReservationInfo_DYNAMIC {
+			return last.GetLabels()
+		}
+		return nil
+	}
+	return r.GetReservation().GetLabels()
+}
+
+// reservationTracker keeps the environments which hold dynamic reservations,
+// along with the reservation policy, which is set once at startup since the
+// framework registers with its roles only then.
+// It does not rely on the environment manager, which stays locked while an
+// environment waits for offers to deploy its tasks.
+type reservationTracker struct {
+	mu     sync.Mutex
+	policy reservationPolicy
+	active map[string]struct{}
+}
+
+func newReservationTracker(policy reservationPolicy) *reservationTracker {
+	return &reservationTracker{
+		policy: policy,
+		active: make(map[string]struct{}),
+	}
+}
+
+func (rt *reservationTracker) Policy() reservationPolicy {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return rt.policy
+}
+
+func (rt *reservationTracker) SetPolicy(policy reservationPolicy) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.policy = policy
+}
+
+func (rt *reservationTracker) Reserved(envId string) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.active[envId] = struct{}{}
+}
+
+func (rt *reservationTracker) Released(envId string) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	delete(rt.active, envId)
+}
+
+func (rt *reservationTracker) IsActive(envId string) bool {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	_, ok := rt.active[envId]
+	return ok
+}
+
+// releaseStaleReservations unreserves the resources of offers which are still
+// reserved for environments which are gone, e.g. torn down or lost with a
+// previous core. Such offers are not used in this round, and their resources
+// come back unreserved with the next offers.
+func releaseStaleReservations(ctx context.Context, state *internalState, offers []mesos.Offer, offerIDsToDecline map[mesos.OfferID]struct{}) []mesos.Offer {
+	usable := offers[:0]
+	for _, offer := range offers {
+		stale := state.reservations.stale(offer.Resources)
+		if len(stale) == 0 {
+			usable = append(usable, offer)
+			continue
+		}
+		unreserve := calls.Accept(
+			calls.OfferOperations{calls.OpUnreserve(stale...)}.WithOffers(offer.ID),
+		).With(calls.RefuseSeconds(0))
+		fields := logrus.Fields{
+			"offerId":   offer.ID.Value,
+			"hostname":  offer.Hostname,
+			"resources": stale.String(),
+		}
+		if err := calls.CallNoData(ctx, state.cli, unreserve); err != nil {
+			log.WithPrefix("scheduler").WithFields(fields).WithError(err).Error("cannot release reserved resources")
+			usable = append(usable, offer)
+			continue
+		}
+		delete(offerIDsToDecline, offer.ID)
+		log.WithPrefix("scheduler").WithFields(fields).Info("reserved resources released")
+	}
+	return usable
+}
+
+// releaseReservations lets the dynamic reservations of an environment go, and
+// asks for offers, which bring back its reserved resources to unreserve.
+// Offers are revived without waiting, since callers may hold the state lock,
+// which resourceOffers needs before the revive can be done.
+func releaseReservations(state *internalState, envId uuid.UUID) {
+	state.reservations.Released(envId.String())
+	if !state.reservations.Policy().dynamic {
+		return
+	}
+	go func() {
+		state.reviveOffersTrg <- struct{}{}
+		<-state.reviveOffersTrg
+	}()
+}
@@ -0,0 +1,142 @@
+package core
+
+import (
+	"github.com/AliceO2Group/Control/common"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func reservedFor(role string, envId string, rs ...*resources.Builder) (reserved mesos.Resources) {
+	for _, rb := range rs {
+		r := rb.Role(role).Resource
+		if envId != "" {
+			r.Reservation = &mesos.Resource_ReservationInfo{
+				Labels: common.WithTaskLabel(nil, RESERVATION_LABEL_ENVIRONMENT_ID, envId),
+			}
+		}
+		reserved = append(reserved, r)
+	}
+	return
+}
+
+var _ = Describe("Reservations", func() {
+	var offerResources mesos.Resources
+
+	BeforeEach(func() {
+		offerResources = append(
+			reservedFor("flp", "", resources.NewCPUs(2)),
+			resources.NewCPUs(4).Resource,
+			resources.NewMemory(1024).Resource,
+		)
+	})
+
+	Describe("reservationPolicy", func() {
+		It("should only use the resources reserved for its role", func() {
+			Expect(reservationPolicy{}.usable(offerResources, "env-1")).To(HaveLen(3))
+
+			policy := reservationPolicy{role: "flp"}
+			usable := policy.usable(offerResources, "env-1")
+			Expect(usable).To(HaveLen(1))
+			Expect(usable[0].IsReserved("flp")).To(BeTrue())
+
+			policy.dynamic = true
+			Expect(policy.usable(offerResources, "env-1")).To(HaveLen(3))
+		})
+
+		It("should leave the dynamic reservations of an environment to its own tasks", func() {
+			policy := reservationPolicy{role: "flp", dynamic: true}
+			offered := append(reservedFor("flp", "env-1", resources.NewMemory(64)),
+				reservedFor("flp", "env-2", resources.NewMemory(128))...)
+			offered = append(offered, reservedFor("flp", "", resources.NewCPUs(2))...)
+
+			usable := policy.usable(offered, "env-1")
+			Expect(usable).To(HaveLen(2))
+			mem, _ := resources.Memory(usable...)
+			Expect(mem).To(BeNumerically("==", 64))
+
+			pool := policy.usable(offered, "env-2")
+			_, _, err := policy.assign(&pool, mesos.Resources{resources.NewMemory(64).Resource}, "env-1", "")
+			Expect(err).To(HaveOccurred())
+			assigned, toReserve, err := policy.assign(&pool, mesos.Resources{resources.NewMemory(128).Resource}, "env-2", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toReserve).To(BeEmpty())
+			envId, _ := common.GetTaskLabel(assigned[0].GetReservation().GetLabels(), RESERVATION_LABEL_ENVIRONMENT_ID)
+			Expect(envId).To(Equal("env-2"))
+		})
+
+		It("should prefer reserved resources, and reserve the others dynamically", func() {
+			policy := reservationPolicy{role: "flp", principal: "o2", dynamic: true}
+			pool := policy.usable(offerResources, "env-1")
+			wants := mesos.Resources{resources.NewCPUs(3).Resource}
+
+			assigned, toReserve, err := policy.assign(&pool, wants, "env-1", "flp")
+			Expect(err).NotTo(HaveOccurred())
+			cpus, _ := resources.CPUs(assigned...)
+			Expect(cpus).To(BeNumerically("==", 3))
+			for _, r := range assigned {
+				Expect(r.IsReserved("flp")).To(BeTrue())
+				Expect(r.GetAllocationInfo().GetRole()).To(Equal("flp"))
+			}
+			Expect(toReserve).To(HaveLen(1))
+			Expect(toReserve[0].GetScalar().GetValue()).To(BeNumerically("==", 1))
+			Expect(toReserve[0].GetReservation().GetPrincipal()).To(Equal("o2"))
+			envId, _ := common.GetTaskLabel(toReserve[0].GetReservation().GetLabels(), RESERVATION_LABEL_ENVIRONMENT_ID)
+			Expect(envId).To(Equal("env-1"))
+			cpus, _ = resources.CPUs(pool...)
+			Expect(cpus).To(BeNumerically("==", 3))
+		})
+
+		It("should not take more than the reserved resources without dynamic reservations", func() {
+			policy := reservationPolicy{role: "flp"}
+			pool := policy.usable(offerResources, "env-1")
+			_, _, err := policy.assign(&pool, mesos.Resources{resources.NewCPUs(3).Resource}, "env-1", "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("reservationTracker", func() {
+		It("should find the reservations of environments which are gone", func() {
+			rt := newReservationTracker(reservationPolicy{})
+			rt.Reserved("env-1")
+			rt.Reserved("env-2")
+			rt.Released("env-2")
+
+			offered := append(offerResources, reservedFor("flp", "env-1", resources.NewMemory(64))...)
+			offered = append(offered, reservedFor("flp", "env-2", resources.NewMemory(128))...)
+			stale := rt.stale(offered)
+			Expect(stale).To(HaveLen(1))
+			Expect(stale[0].GetScalar().GetValue()).To(BeNumerically("==", 128))
+		})
+	})
+
+	// The scheduler of the suite reads the configuration as it runs, so these
+	// specs leave it alone.
+	Describe("configuration", func() {
+		It("should register with several roles", func() {
+			info := &mesos.FrameworkInfo{}
+			setFrameworkRoles(info, []string{"flp", "epn"}, "ignored")
+			Expect(info.GetRoles()).To(Equal([]string{"flp", "epn"}))
+			Expect(info.Role).To(BeNil())
+			Expect(info.GetCapabilities()).To(ContainElement(
+				mesos.FrameworkInfo_Capability{Type: mesos.FrameworkInfo_Capability_MULTI_ROLE}))
+
+			info = &mesos.FrameworkInfo{}
+			setFrameworkRoles(info, nil, "flp")
+			Expect(info.GetRole()).To(Equal("flp"))
+			Expect(info.GetCapabilities()).To(BeEmpty())
+		})
+
+		It("should reject a reserved role the framework does not register with", func() {
+			policy := reservationPolicy{}
+			Expect(policy.validate(nil)).To(Succeed())
+			policy.dynamic = true
+			Expect(policy.validate(nil)).NotTo(Succeed())
+			policy.role = "flp"
+			Expect(policy.validate(nil)).NotTo(Succeed())
+			Expect(policy.validate([]string{"flp", "epn"})).To(Succeed())
+		})
+	})
+})
@@ -34,15 +34,18 @@ import (
 	"fmt"
 	"github.com/spf13/viper"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/AliceO2Group/Control/common"
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/core/environment"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/placement"
 	"github.com/AliceO2Group/Control/executor/protos"
 	"github.com/gogo/protobuf/proto"
 	"github.com/mesos/mesos-go/api/v1/lib"
@@ -56,7 +59,6 @@ import (
 	"github.com/mesos/mesos-go/api/v1/lib/scheduler"
 	"github.com/mesos/mesos-go/api/v1/lib/scheduler/calls"
 	"github.com/mesos/mesos-go/api/v1/lib/scheduler/events"
-	"github.com/pborman/uuid"
 	"github.com/sirupsen/logrus"
 )
 
@@ -98,6 +100,9 @@ func runSchedulerController(ctx context.Context,
 		}
 	}()
 
+	// Executors which run no tasks are shut down after a while
+	go shutdownIdleExecutors(ctx, state)
+
 	// The controller starts here, it takes care of connecting to Mesos and subscribing
 	// as well as resubscribing if the connection is dropped.
 	// It also handles incoming events on the subscription connection.
@@ -148,13 +153,16 @@ func buildEventHandler(state *internalState, fidStore store.Singleton) events.Ha
 		eventrules.HandleF(notifyStateMachine(state)),
 	).Handle(events.Handlers{
 		// scheduler.Event_Type: events.Handler
-		scheduler.Event_FAILURE: logger.HandleF(failure), // wrapper + print error
+		scheduler.Event_FAILURE: logger.HandleF(failure(state)), // wrapper + print error
 		scheduler.Event_OFFERS:  trackOffersReceived(state).HandleF(resourceOffers(state, fidStore)),
 		scheduler.Event_UPDATE:  controller.AckStatusUpdates(state.cli).AndThen().HandleF(statusUpdate(state)),
 		scheduler.Event_SUBSCRIBED: eventrules.New(
 			logger,
 			controller.TrackSubscription(fidStore, viper.GetDuration("mesosFailoverTimeout")),
+			eventrules.HandleF(resetOfferSuppression(state)),
+			eventrules.HandleF(reconcileTasks(state)),
 		),
+		scheduler.Event_ERROR:   logger.HandleF(subscriptionError(fidStore)),
 		scheduler.Event_MESSAGE: eventrules.HandleF(incomingMessageHandler(state, fidStore)),
 	}.Otherwise(logger.HandleEvent))
 }
@@ -181,28 +189,93 @@ func trackOffersReceived(state *internalState) eventrules.Rule {
 
 // Handle an incoming Event_FAILURE, which may be a failure in the executor or
 // in the Mesos agent.
-func failure(_ context.Context, e *scheduler.Event) error {
-	var (
-		f              = e.GetFailure()
-		eid, aid, stat = f.ExecutorID, f.AgentID, f.Status
-	)
-	if eid != nil {
-		// executor failed..
-		fields := logrus.Fields{
-			"executor":	eid.Value,
+// A failed agent has been removed by Mesos, e.g. because it rebooted or became
+// unreachable, so we drop it from the agent cache and mark its tasks as lost.
+func failure(state *internalState) events.HandlerFunc {
+	return func(_ context.Context, e *scheduler.Event) error {
+		var (
+			f              = e.GetFailure()
+			eid, aid, stat = f.ExecutorID, f.AgentID, f.Status
+		)
+		if eid != nil {
+			// executor failed..
+			fields := logrus.Fields{
+				"executor":	eid.Value,
+			}
+			if aid != nil {
+				fields["agent"] = aid.Value
+			}
+			if stat != nil {
+				fields["error"] = strconv.Itoa(int(*stat))
+			}
+			state.executors.Remove(*eid)
+			log.WithPrefix("scheduler").WithFields(fields).Error("executor failed")
+		} else if aid != nil {
+			// agent failed..
+			fields := logrus.Fields{
+				"agent": aid.Value,
+			}
+			if agent := state.taskman.AgentCache.Get(*aid); agent != nil {
+				fields["hostname"] = agent.Hostname
+			}
+			state.taskman.AgentCache.Remove(*aid)
+			state.executors.RemoveAgent(*aid)
+			// The task manager may be locked by AcquireTasks, which waits on
+			// this event loop for the offers of its deployment
+			agentId := *aid
+			go func() {
+				lost := state.taskman.AgentLost(agentId)
+				fields["tasks"] = len(lost)
+				log.WithPrefix("scheduler").WithFields(fields).Error("agent failed")
+			}()
+		}
+		return nil
+	}
+}
+
+// Handle an incoming Event_ERROR, which Mesos sends before closing the
+// subscription, e.g. when it refuses to let us resubscribe because our
+// framework was removed after its failover timeout. In that case we forget the
+// framework ID, so that we subscribe again as a new framework.
+// FRAMEWORK_REMOVED_MESSAGE is the error Mesos sends to a framework which it
+// removed, and which therefore cannot subscribe again with the same ID.
+const FRAMEWORK_REMOVED_MESSAGE = "Framework has been removed"
+
+func subscriptionError(fidStore store.Singleton) events.HandlerFunc {
+	return func(_ context.Context, e *scheduler.Event) error {
+		message := e.GetError().GetMessage()
+		log.WithPrefix("scheduler").WithField("message", message).Error("error received from Mesos")
+		if message == FRAMEWORK_REMOVED_MESSAGE {
+			_ = fidStore.Set("")
+		}
+		return nil
+	}
+}
+
+// Handle an incoming Event_SUBSCRIBED by asking Mesos for the latest state of
+// our tasks. Explicit reconciliation covers the tasks in the roster, implicit
+// reconciliation also covers those we lost track of, e.g. because the core
+// restarted. The replies come in as status updates, see statusUpdate.
+func reconcileTasks(state *internalState) events.HandlerFunc {
+	return func(ctx context.Context, e *scheduler.Event) error {
+		known := make(map[string]string)
+		for _, t := range state.taskman.GetTasks() {
+			known[t.GetTaskId()] = t.GetAgentId()
 		}
-		if aid != nil {
-			fields["agent"] = aid.Value
+		if len(known) > 0 {
+			err := calls.CallNoData(ctx, state.cli, calls.Reconcile(calls.ReconcileTasks(known)))
+			if err != nil {
+				log.WithPrefix("scheduler").WithError(err).Error("explicit reconciliation failed")
+			}
 		}
-		if stat != nil {
-			fields["error"] = strconv.Itoa(int(*stat))
+		err := calls.CallNoData(ctx, state.cli, calls.Reconcile(calls.ReconcileTasks(nil)))
+		if err != nil {
+			log.WithPrefix("scheduler").WithError(err).Error("implicit reconciliation failed")
+			return nil
 		}
-		log.WithPrefix("scheduler").WithFields(fields).Error("executor failed")
-	} else if aid != nil {
-		// agent failed..
-		log.WithPrefix("scheduler").WithField("agent", aid.Value).Error("agent failed")
+		log.WithPrefix("scheduler").WithField("knownTasks", len(known)).Debug("reconciliation requested")
+		return nil
 	}
-	return nil
 }
 
 // Handler for Event_MESSAGE
@@ -240,79 +313,83 @@ func incomingMessageHandler(state *internalState, fidStore store.Singleton) even
 			return
 		}
 
-		data := mesosMessage.GetData()
+		return handleMessage(state, agentId, executorId, mesosMessage.GetData())
+	}
+}
+
+// handleMessage processes a MESSAGE coming from an executor, i.e. either a
+// device event or the response to a command.
+func handleMessage(state *internalState, agentId mesos.AgentID, executorId mesos.ExecutorID, data []byte) (err error) {
+	var incomingType struct {
+		MessageType string `json:"_messageType"`
+	}
+	err = json.Unmarshal(data, &incomingType)
+	if err != nil {
+		return
+	}
 
-		var incomingType struct {
-			MessageType string `json:"_messageType"`
+	switch incomingType.MessageType {
+	case "DeviceEvent":
+		var incomingEvent struct {
+			Type pb.DeviceEventType        `json:"type"`
+			Origin event.DeviceEventOrigin `json:"origin"`
 		}
-		err = json.Unmarshal(data, &incomingType)
+		err = json.Unmarshal(data, &incomingEvent)
 		if err != nil {
 			return
 		}
+		ev := event.NewDeviceEvent(incomingEvent.Origin, incomingEvent.Type)
+		if ev != nil {
+			handleDeviceEvent(state, ev)
+		} else {
+			log.WithFields(logrus.Fields{
+					"type": incomingEvent.Type.String(),
+					"originTask": incomingEvent.Origin.TaskId.Value,
+				}).
+				Error("cannot handle incoming device event")
+		}
 
-		switch incomingType.MessageType {
-		case "DeviceEvent":
-			var incomingEvent struct {
-				Type pb.DeviceEventType        `json:"type"`
-				Origin event.DeviceEventOrigin `json:"origin"`
-			}
-			err = json.Unmarshal(data, &incomingEvent)
-			if err != nil {
-				return
-			}
-			ev := event.NewDeviceEvent(incomingEvent.Origin, incomingEvent.Type)
-			if ev != nil {
-				handleDeviceEvent(state, ev)
-			} else {
-				log.WithFields(logrus.Fields{
-						"type": incomingEvent.Type.String(),
-						"originTask": incomingEvent.Origin.TaskId.Value,
-					}).
-					Error("cannot handle incoming device event")
-			}
+	case "MesosCommandResponse":
+		var incomingCommand struct {
+			CommandName string `json:"name"`
+		}
+		err = json.Unmarshal(data, &incomingCommand)
+		if err != nil {
+			return
+		}
 
-		case "MesosCommandResponse":
-			var incomingCommand struct {
-				CommandName string `json:"name"`
-			}
-			err = json.Unmarshal(data, &incomingCommand)
+		log.WithPrefix("scheduler").WithField("commandName", incomingCommand.CommandName).Debug("processing incoming MESSAGE")
+		switch incomingCommand.CommandName {
+		case "MesosCommand_Transition":
+			var res controlcommands.MesosCommandResponse_Transition
+			err = json.Unmarshal(data, &res)
 			if err != nil {
+				log.WithPrefix("scheduler").WithFields(logrus.Fields{
+					"commandName": incomingCommand.CommandName,
+					"agentId":     agentId.GetValue(),
+					"executorId":  executorId.GetValue(),
+					"message":     string(data[:]),
+					"error":       err.Error(),
+				}).
+					Error("cannot unmarshal incoming MESSAGE")
 				return
 			}
-
-			log.WithPrefix("scheduler").WithField("commandName", incomingCommand.CommandName).Debug("processing incoming MESSAGE")
-			switch incomingCommand.CommandName {
-			case "MesosCommand_Transition":
-				var res controlcommands.MesosCommandResponse_Transition
-				err = json.Unmarshal(data, &res)
-				if err != nil {
-					log.WithPrefix("scheduler").WithFields(logrus.Fields{
-						"commandName": incomingCommand.CommandName,
-						"agentId":     agentId.GetValue(),
-						"executorId":  executorId.GetValue(),
-						"message":     string(data[:]),
-						"error":       err.Error(),
-					}).
-						Error("cannot unmarshal incoming MESSAGE")
-					return
-				}
-				sender := controlcommands.MesosCommandTarget{
-					AgentId: agentId,
-					ExecutorId: executorId,
-					TaskId: mesos.TaskID{Value: res.TaskId},
-				}
-
-				go func() {
-					state.taskman.UpdateTaskState(res.TaskId, res.CurrentState)
-					state.servent.ProcessResponse(&res, sender)
-				}()
-				return
-			default:
-				return errors.New(fmt.Sprintf("unrecognized response for controlcommand %s", incomingCommand.CommandName))
+			sender := controlcommands.MesosCommandTarget{
+				AgentId: agentId,
+				ExecutorId: executorId,
+				TaskId: mesos.TaskID{Value: res.TaskId},
 			}
+
+			go func() {
+				state.taskman.UpdateTaskState(res.TaskId, res.CurrentState)
+				state.servent.ProcessResponse(&res, sender)
+			}()
+			return
+		default:
+			return errors.New(fmt.Sprintf("unrecognized response for controlcommand %s", incomingCommand.CommandName))
 		}
-		return
 	}
+	return
 }
 
 func handleDeviceEvent(state *internalState, evt event.DeviceEvent) {
@@ -347,11 +424,15 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 	return func(ctx context.Context, e *scheduler.Event) error {
 		var (
 			offers                 = e.GetOffers().GetOffers()
-			callOption             = calls.RefuseSeconds(time.Second)//calls.RefuseSecondsWithJitter(state.random, state.config.maxRefuseSeconds)
 			tasksLaunchedThisCycle = 0
-			offersDeclined         = 0
+			offersUsed             = 0
 		)
 
+		// A deployment may wait for offers over several rounds, and it must not
+		// time out while we process this one.
+		state.offers.Lock()
+		defer state.offers.Unlock()
+
 		if viper.GetBool("veryVerbose") {
 			var(
 				prettyOffers []string
@@ -369,27 +450,35 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 		}
 
 		var descriptorsToDeploy task.Descriptors
-		select {
-		case descriptorsToDeploy = <- state.tasksToDeploy:
-			if viper.GetBool("veryVerbose") {
-				rolePaths := make([]string, len(descriptorsToDeploy))
-				taskClasses := make([]string, len(descriptorsToDeploy))
-				for i, d := range descriptorsToDeploy {
-					rolePaths[i] = d.TaskRole.GetPath()
-					taskClasses[i] = d.TaskClassName
+		deployment := state.offers.Deployment()
+		if deployment != nil {
+			descriptorsToDeploy = deployment.descriptors
+		} else {
+			select {
+			case descriptorsToDeploy = <- state.tasksToDeploy:
+				deployment = state.offers.BeginDeployment(state, descriptorsToDeploy)
+				if viper.GetBool("veryVerbose") {
+					rolePaths := make([]string, len(descriptorsToDeploy))
+					taskClasses := make([]string, len(descriptorsToDeploy))
+					for i, d := range descriptorsToDeploy {
+						rolePaths[i] = d.TaskRole.GetPath()
+						taskClasses[i] = d.TaskClassName
+					}
+					log.WithPrefix("scheduler").
+						WithFields(logrus.Fields{
+							"roles": strings.Join(rolePaths, ", "),
+							"classes": strings.Join(taskClasses, ", "),
+						}).
+						Debug("received descriptors for tasks to deploy on this offers round")
+				}
+			default:
+				if viper.GetBool("veryVerbose") {
+					log.WithPrefix("scheduler").Debug("no roles need deployment")
 				}
-				log.WithPrefix("scheduler").
-					WithFields(logrus.Fields{
-						"roles": strings.Join(rolePaths, ", "),
-						"classes": strings.Join(taskClasses, ", "),
-					}).
-					Debug("received descriptors for tasks to deploy on this offers round")
-			}
-		default:
-			if viper.GetBool("veryVerbose") {
-				log.WithPrefix("scheduler").Debug("no roles need deployment")
 			}
 		}
+		// While a deployment waits, we want declined resources back soon.
+		callOption := state.offers.RefuseSeconds(state)
 
 		// by default we get ready to decline all offers
 		offerIDsToDecline := make(map[mesos.OfferID]struct{}, len(offers))
@@ -397,6 +486,22 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 			offerIDsToDecline[offers[i].ID] = struct{}{}
 		}
 
+		// Every offer refreshes what we know about its agent
+		agentsOffered := make([]task.AgentCacheInfo, len(offers))
+		for i := range offers {
+			agentsOffered[i] = task.AgentCacheInfo{
+				AgentId: offers[i].AgentID,
+				Attributes: offers[i].Attributes,
+				Hostname: offers[i].Hostname,
+				Resources: mesos.Resources(offers[i].Resources).Clone(),
+				LastOffer: time.Now(),
+			}
+		}
+		state.taskman.AgentCache.Update(agentsOffered...) //thread safe
+
+		// Resources still reserved for environments which are gone are released first
+		offers = releaseStaleReservations(ctx, state, offers, offerIDsToDecline)
+
 		tasksDeployed := make(task.DeploymentMap)
 
 		if len(descriptorsToDeploy) > 0 {
@@ -413,23 +518,39 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 
 			var	err error
 
-			// We make a map[Descriptor]constraint.Constraints and for each descriptor to deploy we
-			// fill it with the pre-computed total constraints for that Descriptor.
-			descriptorConstraints := state.taskman.BuildDescriptorConstraints(descriptorsToDeploy)
+			matcher := newOfferMatcher(state.taskman, descriptorsToDeploy)
+			matcher.report.AddOffersReceived(len(offers))
+			policy, policyErr := executorPolicyFromString(viper.GetString("executorPolicy"))
+			if policyErr != nil {
+				log.WithPrefix("scheduler").WithError(policyErr).Warning("falling back to one executor per host")
+			}
+			executorResources := mesos.Resources(state.executor.Resources)
+			reservation := state.reservations.Policy()
+			// All the descriptors of a round belong to the deployment of one environment
+			deploymentEnvId := descriptorsToDeploy[0].TaskRole.GetEnvironmentId().UUID().String()
+			sortForPlacement(offers, descriptorsToDeploy, matcher.placements, matcher.occupancy)
+			sortForPreferredHosts(offers, descriptorsToDeploy)
 
 			// NOTE: 1 offer per host
 			for _, offer := range offers {
 				var (
-					remainingResources = mesos.Resources(offer.Resources)
+					// Tasks are matched against the plain resources which the reservation
+					// policy allows, and take the actual resources from the pool at launch.
+					pool = reservation.usable(offer.Resources, deploymentEnvId)
+					remainingResources = pool.ToUnreserved().Clone()
+					allocatedTo = allocationRole(&offer)
+					toReserve = make(mesos.Resources, 0)
 					tasks = make([]mesos.TaskInfo, 0)
 					tasksDeployedForCurrentOffer = make(task.DeploymentMap)
-					targetExecutorId = mesos.ExecutorID{}
+					// Tasks share executors as the executor policy allows
+					executors = newExecutorAssigner(policy, &offer)
+					// Mesos rejects the tasks of an executor whose ExecutorInfo differs
+					// from the one it was launched with, so each executor gets its
+					// ExecutorInfo once and all of its tasks carry it as is.
+					executorInfos = make(map[mesos.ExecutorID]*mesos.ExecutorInfo)
 				)
-
-				if len(offer.ExecutorIDs) == 0 {
-					targetExecutorId.Value = uuid.NewUUID().String()
-				} else {
-					targetExecutorId.Value = offer.ExecutorIDs[0].Value
+				for _, executorId := range offer.ExecutorIDs {
+					state.executors.Add(executorId, offer.AgentID, nil)
 				}
 
 				log.WithPrefix("scheduler").
@@ -460,96 +581,56 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 					log.WithPrefix("scheduler").
 						WithField("taskClass", descriptor.TaskClassName).
 						Debug("processing descriptor")
-					offerAttributes := constraint.Attributes(offer.Attributes)
-					if !offerAttributes.Satisfy(descriptorConstraints[descriptor]) {
-						if viper.GetBool("veryVerbose") {
-							log.WithPrefix("scheduler").
-								WithFields(logrus.Fields{
-								    "taskClass": descriptor.TaskClassName,
-								    "constraints": descriptorConstraints[descriptor],
-								    "offerId": offer.ID.Value,
-								    "resources": remainingResources.String(),
-								    "attributes": offerAttributes.String(),
-								}).
-								Warn("descriptor constraints not satisfied by offer attributes")
-						}
-						continue
-					}
-					log.WithPrefix("scheduler").Debug("offer attributes satisfy constraints")
-
-					wants := state.taskman.GetWantsForDescriptor(descriptor)
-					if wants == nil {
-						log.WithPrefix("scheduler").WithField("class", descriptor.TaskClassName).
-							Warning("no resource demands for descriptor, invalid class perhaps?")
-						continue
+					// A new executor takes its own resources from the offer
+					executorId, newExecutor := executors.peek(descriptor)
+					var newExecutorResources mesos.Resources
+					if newExecutor {
+						newExecutorResources = executorResources
 					}
-					if !task.Resources(remainingResources).Satisfy(wants) {
+					wants, ok := matcher.match(descriptor, &offer, remainingResources, newExecutorResources)
+					if !ok {
 						continue
 					}
 
-					// Point of no return, we start subtracting resources
-
-					bindMap := make(map[string]uint64)
-					for _, ch := range wants.BindPorts {
-						availPorts, ok := resources.Ports(remainingResources...)
-						if !ok {
-							continue FOR_DESCRIPTORS
-						}
-						// TODO: this can be optimized by excluding the base range outside the loop
-						availPorts = availPorts.Remove(mesos.Value_Range{Begin: 0, End: 8999})
-						port := availPorts.Min()
-						builder := resources.Build().
-							Name(resources.Name("ports")).
-							Ranges(resources.BuildRanges().Span(port, port).Ranges)
-						remainingResources.Subtract(builder.Resource)
-						bindMap[ch.Name] = port
+					// The executor resources go first, the task then claims its own
+					// from what's left. Both are taken from a copy of the remaining
+					// resources, which replaces them only once nothing else can fail,
+					// so that a descriptor which cannot be launched after all leaves
+					// this offer as it was for the next descriptors.
+					remaining := remainingResources.Clone()
+					if newExecutor {
+						remaining.Subtract(executorResources...)
 					}
-
-					agentForCache := task.AgentCacheInfo{
-						AgentId: offer.AgentID,
-						Attributes: offer.Attributes,
-						Hostname: offer.Hostname,
+					claim, claimErr := matcher.claim(descriptor, &offer, wants, &remaining)
+					if claimErr != nil {
+						matcher.report.Reject(descriptor, &offer, claimErr.Error())
+						continue FOR_DESCRIPTORS
 					}
-					state.taskman.AgentCache.Update(agentForCache) //thread safe
 
-					taskPtr := state.taskman.NewTaskForMesosOffer(&offer, descriptor, bindMap, targetExecutorId)
+					taskPtr := state.taskman.NewTaskForMesosOffer(&offer, descriptor, claim.bindPorts, executorId)
 					if taskPtr == nil {
 						log.WithPrefix("scheduler").
 							WithField("offerId", offer.ID.Value).
 							Error("cannot get task for offer+descriptor, this should never happen")
-						log.Debug("state unlock")
-						continue
-					}
-
-					// Do not decline this offer
-					_, contains := offerIDsToDecline[offer.ID]
-					if contains {
-						delete(offerIDsToDecline, offer.ID)
+						matcher.release(descriptor, &offer)
+						matcher.report.Reject(descriptor, &offer, "cannot create task")
+						continue FOR_DESCRIPTORS
 					}
 
 					// Define the O² process to run as a mesos.CommandInfo, which we'll then JSON-serialize
-					cmd := taskPtr.BuildTaskCommand()
-
-					// Claim the control port
-					availPorts, ok := resources.Ports(remainingResources...)
-					if !ok {
+					cmd, cmdErr := taskPtr.BuildTaskCommand()
+					if cmdErr != nil {
+						log.WithPrefix("scheduler").
+							WithError(cmdErr).
+							WithField("taskClass", descriptor.TaskClassName).
+							Error("cannot build task command")
+						matcher.release(descriptor, &offer)
+						matcher.report.Reject(descriptor, &offer, fmt.Sprintf("cannot build task command: %s", cmdErr.Error()))
 						continue FOR_DESCRIPTORS
 					}
-					// The control port range starts at 47101
-					// FIXME: make the control ports cutoff configurable
-					availPorts = availPorts.Remove(mesos.Value_Range{Begin: 0, End: 29999})
-					controlPort := availPorts.Min()
-					builder := resources.Build().
-						Name(resources.Name("ports")).
-						Ranges(resources.BuildRanges().Span(controlPort, controlPort).Ranges)
-					remainingResources.Subtract(builder.Resource)
-
-					// Append control port to arguments
-					// For the control port parameter and/or environment variable, see occ/OccGlobals.h
-					cmd.Arguments = append(cmd.Arguments, "--control-port", strconv.FormatUint(controlPort, 10))
-					cmd.ControlPort = controlPort
-					cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", "OCC_CONTROL_PORT", controlPort))
-					cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", "O2_ROLE", offer.Hostname))
+
+					setControlPort(cmd, claim.controlPort, offer.Hostname)
+					taskPtr.SetControlPort(claim.controlPort)
 
 					runCommand := *cmd
 
@@ -566,81 +647,108 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 								"json":  jsonCommand,
 							}).
 							Error("cannot serialize mesos.CommandInfo for executor")
-						state.Unlock()
-						log.WithPrefix("scheduler").Debug("state unlock")
-						continue
+						matcher.release(descriptor, &offer)
+						matcher.report.Reject(descriptor, &offer, fmt.Sprintf("cannot serialize task command: %s", err.Error()))
+						continue FOR_DESCRIPTORS
 					}
 
 					// Build resources request
-					resourcesRequest := make(mesos.Resources, 0)
-					resourcesRequest.Add1(resources.NewCPUs(wants.Cpu).Resource)
-					resourcesRequest.Add1(resources.NewMemory(wants.Memory).Resource)
-					portsBuilder := resources.BuildRanges()
-					for _, rng := range wants.StaticPorts {
-						portsBuilder = portsBuilder.Span(rng.Begin, rng.End)
+					resourcesRequest := claim.resources()
+
+					// The resources actually taken from the offer carry its reservations
+					envId := descriptor.TaskRole.GetEnvironmentId().UUID().String()
+					remainingPool := pool.Clone()
+					taskResources, reserveForTask, assignErr := reservation.assign(&remainingPool, resourcesRequest, envId, allocatedTo)
+					var executorAssigned, reserveForExecutor mesos.Resources
+					if assignErr == nil && newExecutor {
+						executorAssigned, reserveForExecutor, assignErr = reservation.assign(&remainingPool, executorResources, envId, allocatedTo)
 					}
-					for _, port := range bindMap {
-						portsBuilder = portsBuilder.Span(port, port)
+					if assignErr != nil {
+						matcher.release(descriptor, &offer)
+						matcher.report.Reject(descriptor, &offer, assignErr.Error())
+						continue FOR_DESCRIPTORS
 					}
-					portsBuilder = portsBuilder.Span(controlPort, controlPort)
 
-					portRanges := portsBuilder.Ranges.Sort().Squash()
-					portsResources := resources.Build().Name(resources.Name("ports")).Ranges(portRanges)
-					resourcesRequest.Add1(portsResources.Resource)
+					// Point of no return, the task takes its resources from the offer
+					remainingResources = remaining
+					pool = remainingPool
+					// Do not decline this offer
+					_, contains := offerIDsToDecline[offer.ID]
+					if contains {
+						delete(offerIDsToDecline, offer.ID)
+					}
+					if len(reserveForTask) > 0 || len(reserveForExecutor) > 0 {
+						toReserve.Add(reserveForTask...)
+						toReserve.Add(reserveForExecutor...)
+						state.reservations.Reserved(envId)
+					}
 
-					// Append executor resources to request
-					executorResources := mesos.Resources(state.executor.Resources)
+					// The executor resources are not part of the task's, Mesos takes
+					// them from the offer when it launches a new executor.
 					log.WithPrefix("scheduler").
 						WithField("taskResources", resourcesRequest).
 						WithField("executorResources", executorResources).
+						WithField("newExecutor", newExecutor).
 						Debug("creating Mesos task")
-					resourcesRequest.Add(executorResources...)
 
 					newTaskId := taskPtr.GetTaskId()
 
-					executor := state.executor
-					executor.ExecutorID.Value = taskPtr.GetExecutorId()
+					executor, ok := executorInfos[executorId]
+					if !ok && !newExecutor {
+						executor = state.executors.Info(executorId)
+					}
+					if executor == nil {
+						// An executor unknown to this core runs with the default resources
+						var assigned mesos.Resources
+						if newExecutor && (len(reservation.role) > 0 || len(allocatedTo) > 0) {
+							assigned = executorAssigned
+						}
+						executor = newExecutorInfo(state.executor, executorId, &offer, assigned)
+					}
+					executorInfos[executorId] = executor
 
 					mesosTaskInfo := mesos.TaskInfo{
 						Name:      taskPtr.GetName(),
 						TaskID:    mesos.TaskID{Value: newTaskId},
 						AgentID:   offer.AgentID,
 						Executor:  executor,
-						Resources: resourcesRequest,
+						Resources: taskResources,
 						Data:      jsonCommand, // this ends up in LAUNCH for the executor
-					}
-
-					// We must run the executor with a special LD_LIBRARY_PATH because
-					// its InfoLogger binding is built with GCC-Toolchain
-					ldLibPath, ok := agentForCache.Attributes.Get("executor_env_LD_LIBRARY_PATH")
-					mesosTaskInfo.Executor.Command.Environment = &mesos.Environment{}
-					if ok {
-						mesosTaskInfo.Executor.Command.Environment.Variables =
-						append(mesosTaskInfo.Executor.Command.Environment.Variables,
-							mesos.Environment_Variable{
-								Name: "LD_LIBRARY_PATH",
-								Value: proto.String(ldLibPath),
-							})
+						Labels:    taskPtr.GetMesosLabels(),
 					}
 
 					log.WithPrefix("scheduler").
 						WithFields(logrus.Fields{
 						"taskId":     newTaskId,
 						"offerId":    offer.ID.Value,
-						"executorId": state.executor.ExecutorID.Value,
+						"executorId": executor.ExecutorID.Value,
 						"task":       mesosTaskInfo,
 					}).Debug("launching task")
 
 					tasks = append(tasks, mesosTaskInfo)
+					executors.commit(descriptor, executorId)
+					state.executors.Add(executorId, offer.AgentID, executor)
 					descriptorsToDeploy = append(descriptorsToDeploy[:i], descriptorsToDeploy[i+1:]...)
 					tasksDeployedForCurrentOffer[taskPtr] = descriptor
+					matcher.placed(descriptor, &offer)
 				}
 				state.Unlock()
 				log.WithPrefix("scheduler").Debug("state unlock")
 
-				// build ACCEPT call to launch all of the tasks we've assembled
+				if len(tasks) == 0 {
+					// Nothing to launch, the offer is declined along with the others
+					continue
+				}
+
+				// build ACCEPT call to launch all of the tasks we've assembled,
+				// once the resources they need are reserved
+				operations := calls.OfferOperations{}
+				if len(toReserve) > 0 {
+					operations = append(operations, calls.OpReserve(toReserve...))
+				}
+				operations = append(operations, calls.OpLaunch(tasks...))
 				accept := calls.Accept(
-					calls.OfferOperations{calls.OpLaunch(tasks...)}.WithOffers(offer.ID),
+					operations.WithOffers(offer.ID),
 				).With(callOption) // handles refuseSeconds etc.
 
 				// send ACCEPT call to mesos
@@ -650,28 +758,26 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 						Error("failed to launch tasks")
 					// FIXME: we probably need to react to a failed ACCEPT here
 				} else {
-					if n := len(tasks); n > 0 {
-						tasksLaunchedThisCycle += n
-						log.WithPrefix("scheduler").WithField("tasks", n).
-							Info("tasks launched")
-						for _, taskInfo := range tasks {
-							log.WithPrefix("scheduler").
-								WithFields(logrus.Fields{
-									"executorId": taskInfo.GetExecutor().ExecutorID.Value,
-									"executorName": taskInfo.GetExecutor().GetName(),
-									"agentId": taskInfo.GetAgentID().Value,
-									"taskId": taskInfo.GetTaskID().Value,
-								}).
-								Debug("launched")
-						}
+					n := len(tasks)
+					tasksLaunchedThisCycle += n
+					log.WithPrefix("scheduler").WithField("tasks", n).
+						Info("tasks launched")
+					for _, taskInfo := range tasks {
+						log.WithPrefix("scheduler").
+							WithFields(logrus.Fields{
+								"executorId": taskInfo.GetExecutor().ExecutorID.Value,
+								"executorName": taskInfo.GetExecutor().GetName(),
+								"agentId": taskInfo.GetAgentID().Value,
+								"taskId": taskInfo.GetTaskID().Value,
+							}).
+							Debug("launched")
+					}
 
-						// update deployment map
-						for k, v := range tasksDeployedForCurrentOffer {
-							tasksDeployed[k] = v
-						}
-					} else {
-						offersDeclined++
+					// update deployment map
+					for k, v := range tasksDeployedForCurrentOffer {
+						tasksDeployed[k] = v
 					}
+					offersUsed++
 				}
 			} // end for _, offerUsed := range offersUsed
 		} // end if len(descriptorsToDeploy) > 0
@@ -698,21 +804,17 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 			log.WithPrefix("scheduler").Info("no offers to decline")
 		}
 
-		// Notify listeners...
-		select {
-		case state.resourceOffersDone <- tasksDeployed:
-			log.WithPrefix("scheduler").
-				WithField("tasksDeployed", len(tasksDeployed)).
-				Debug("notified listeners on resourceOffers done")
-		default:
-			if viper.GetBool("veryVerbose") {
-				log.WithPrefix("scheduler").
-					Debug("no listeners notified")
-			}
+		// Notify listeners once all tasks are deployed, otherwise the deployment
+		// waits for more offers until it times out.
+		if deployment != nil {
+			state.offers.UpdateDeployment(state, descriptorsToDeploy, tasksDeployed)
+		} else {
+			state.offers.Idle(ctx, state)
 		}
 
 		// Update metrics...
-		state.metricsAPI.offersDeclined.Int(offersDeclined)
+		state.metricsAPI.offersDeclined.Int(len(offerIDsToDecline))
+		state.metricsAPI.offersUsed.Int(offersUsed)
 		state.metricsAPI.tasksLaunched.Int(tasksLaunchedThisCycle)
 		if viper.GetBool("summaryMetrics") {
 			state.metricsAPI.launchesPerOfferCycle(float64(tasksLaunchedThisCycle))
@@ -728,6 +830,98 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 	}
 }
 
+// newExecutorInfo builds the ExecutorInfo of a new executor on the agent of
+// offer from the default one. Reserved or allocated resources replace the
+// default executor resources, since they must be those of the offer.
+func newExecutorInfo(defaultInfo *mesos.ExecutorInfo, executorId mesos.ExecutorID, offer *mesos.Offer, assigned mesos.Resources) *mesos.ExecutorInfo {
+	executor := proto.Clone(defaultInfo).(*mesos.ExecutorInfo)
+	executor.ExecutorID = executorId
+	if len(assigned) > 0 {
+		executor.Resources = assigned
+	}
+
+	// We must run the executor with a special LD_LIBRARY_PATH because
+	// its InfoLogger binding is built with GCC-Toolchain
+	executor.Command.Environment = &mesos.Environment{}
+	if ldLibPath, ok := constraint.Attributes(offer.Attributes).Get("executor_env_LD_LIBRARY_PATH"); ok {
+		executor.Command.Environment.Variables = append(executor.Command.Environment.Variables,
+			mesos.Environment_Variable{
+				Name: "LD_LIBRARY_PATH",
+				Value: proto.String(ldLibPath),
+			})
+	}
+	// The executor runs containerized tasks through a local container runtime
+	if containerRuntime := viper.GetString("containerRuntime"); len(containerRuntime) > 0 {
+		executor.Command.Environment.Variables = append(executor.Command.Environment.Variables,
+			mesos.Environment_Variable{
+				Name: common.CONTAINER_RUNTIME_ENV,
+				Value: proto.String(containerRuntime),
+			})
+	}
+	return executor
+}
+
+// sortForPlacement reorders offers and descriptors in place before matching,
+// so that the greedy matching in resourceOffers honors placement policies.
+// Descriptors are matched from last to first, so those with affinity rules go
+// first, and are thus matched after the tasks they want to be co-located with.
+// If some descriptors have a pack strategy, the offers from hosts which already
+// run tasks of their groups come first, followed by the larger offers.
+func sortForPlacement(offers []mesos.Offer, descriptors task.Descriptors, placements map[*task.Descriptor]placement.Policy, occupancy *placement.Occupancy) {
+	sort.SliceStable(descriptors, func(i, j int) bool {
+		return len(placements[descriptors[i]].Affinity) > 0 &&
+			len(placements[descriptors[j]].Affinity) == 0
+	})
+
+	packGroups := make([]string, 0)
+	for _, policy := range placements {
+		if policy.Strategy == placement.Pack {
+			packGroups = append(packGroups, policy.Group)
+		}
+	}
+	if len(packGroups) == 0 {
+		return
+	}
+
+	packScore := func(offer *mesos.Offer) (score int) {
+		for _, group := range packGroups {
+			score += occupancy.GroupCount(offer.Hostname, group)
+		}
+		return
+	}
+	offeredCpus := func(offer *mesos.Offer) float64 {
+		cpus, _ := resources.CPUs(resources.Flatten(offer.Resources)...)
+		return cpus
+	}
+	sort.SliceStable(offers, func(i, j int) bool {
+		scoreI, scoreJ := packScore(&offers[i]), packScore(&offers[j])
+		if scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+		return offeredCpus(&offers[i]) > offeredCpus(&offers[j])
+	})
+}
+
+// sortForPreferredHosts moves to the front the offers from hosts on which a
+// restarted task used to run, so that it gets the chance to come back there
+// with the same bind ports.
+func sortForPreferredHosts(offers []mesos.Offer, descriptors task.Descriptors) {
+	preferred := make(map[string]struct{})
+	for _, descriptor := range descriptors {
+		if len(descriptor.PreferredHostname) > 0 {
+			preferred[descriptor.PreferredHostname] = struct{}{}
+		}
+	}
+	if len(preferred) == 0 {
+		return
+	}
+	sort.SliceStable(offers, func(i, j int) bool {
+		_, okI := preferred[offers[i].Hostname]
+		_, okJ := preferred[offers[j].Hostname]
+		return okI && !okJ
+	})
+}
+
 // statusUpdate handles an incoming UPDATE event.
 // This func runs after acknowledgement.
 func statusUpdate(state *internalState) events.HandlerFunc {
@@ -773,6 +967,16 @@ func statusUpdate(state *internalState) events.HandlerFunc {
 				Info("task inactive exception")
 		}
 
+		// Reconciliation replies may concern tasks which aren't in the roster,
+		// so we rebuild them first
+		if s.GetReason() == mesos.REASON_RECONCILIATION {
+			go func() {
+				reconcileTaskStatus(state, s)
+				state.taskman.UpdateTaskStatus(&s)
+			}()
+			return nil
+		}
+
 		// Enqueue task state update
 		go state.taskman.UpdateTaskStatus(&s)
 
@@ -780,6 +984,31 @@ func statusUpdate(state *internalState) events.HandlerFunc {
 	}
 }
 
+// reconcileTaskStatus brings a task reported through reconciliation into the
+// roster, or kills it if it cannot be rebuilt so that its process doesn't hold
+// on to its ports.
+func reconcileTaskStatus(state *internalState, s mesos.TaskStatus) {
+	_, err := state.taskman.ReconcileTask(&s)
+	if err == nil {
+		return
+	}
+	log.WithPrefix("scheduler").
+		WithError(err).
+		WithField("taskId", s.GetTaskID().Value).
+		Warning("cannot rebuild task from reconciliation, killing it")
+	err = KillTask(context.TODO(), state, controlcommands.MesosCommandTarget{
+		AgentId:    mesos.AgentID{Value: s.GetAgentID().GetValue()},
+		ExecutorId: mesos.ExecutorID{Value: s.GetExecutorID().GetValue()},
+		TaskId:     s.GetTaskID(),
+	})
+	if err != nil {
+		log.WithPrefix("scheduler").
+			WithError(err).
+			WithField("taskId", s.GetTaskID().Value).
+			Error("cannot kill task")
+	}
+}
+
 // tryReviveOffers sends a REVIVE call to Mesos. With this we clear all filters we might previously
 // have set through ACCEPT or DECLINE calls, in the hope that Mesos then sends us new resource offers.
 // This should generally run when we have received a TASK_FINISHED for some tasks, and we have more
@@ -796,12 +1025,14 @@ func tryReviveOffers(ctx context.Context, state *internalState) {
 }
 
 func doReviveOffers(ctx context.Context, state *internalState) {
+	state.offers.Reviving()
 	err := calls.CallNoData(ctx, state.cli, calls.Revive())
 	if err != nil {
 		log.WithPrefix("scheduler").WithField("error", err.Error()).
 			Error("failed to revive offers")
 		return
 	}
+	state.offers.Revived()
 	log.WithPrefix("scheduler").Debug("revive offers done")
 }
 
@@ -0,0 +1,763 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/mesostest"
+	"github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/gogo/protobuf/proto"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/extras/store"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	"github.com/mesos/mesos-go/api/v1/lib/scheduler"
+	"github.com/mesos/mesos-go/api/v1/lib/scheduler/calls"
+	"github.com/pborman/uuid"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeRole is the smallest parent role a Descriptor can point to.
+type fakeRole struct {
+	path      string
+	taskClass string
+}
+
+func (r *fakeRole) UpdateStatus(task.Status)                    {}
+func (r *fakeRole) UpdateState(task.State)                      {}
+func (r *fakeRole) GetPath() string                             { return r.path }
+func (r *fakeRole) GetTaskClass() string                        { return r.taskClass }
+func (r *fakeRole) SetTask(*task.Task)                          {}
+func (r *fakeRole) GetEnvironmentId() uuid.Array                { return uuid.NIL.Array() }
+func (r *fakeRole) CollectOutboundChannels() []channel.Outbound { return nil }
+func (r *fakeRole) GetVars() task.VarMap                        { return nil }
+func (r *fakeRole) GetCurrentRunNumber() uint32                 { return 0 }
+
+// reconciledStatus is the status Mesos reports through reconciliation for a
+// task of a previous core instance, with the labels to rebuild it from.
+func reconciledStatus(taskId string, agentId string, hostname string) mesos.TaskStatus {
+	labels := &mesos.Labels{}
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_CLASS, "some/repo/tasks/qc")
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_HOSTNAME, hostname)
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_OFFER_ID, "old-offer")
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_CONTROL_PORT, "47101")
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_BIND_PORTS, `{"data":31000}`)
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_STATE, "STANDBY")
+	return mesos.TaskStatus{
+		TaskID:     mesos.TaskID{Value: taskId},
+		State:      mesos.TASK_RUNNING.Enum(),
+		Source:     mesos.SOURCE_MASTER.Enum(),
+		Reason:     mesos.REASON_RECONCILIATION.Enum(),
+		AgentID:    &mesos.AgentID{Value: agentId},
+		ExecutorID: &mesos.ExecutorID{Value: taskId + "-executor"},
+		Labels:     labels,
+	}
+}
+
+// envRole is a fakeRole of an environment, which records the task it gets
+// and the status updates of that task.
+type envRole struct {
+	*fakeRole
+	envId    uuid.Array
+	tasks    chan *task.Task
+	statuses chan task.Status
+}
+
+func newEnvRole(envId uuid.Array, path string, taskClass string) *envRole {
+	return &envRole{
+		fakeRole: &fakeRole{path: path, taskClass: taskClass},
+		envId:    envId,
+		tasks:    make(chan *task.Task, 1),
+		statuses: make(chan task.Status, 16),
+	}
+}
+
+func (r *envRole) GetEnvironmentId() uuid.Array { return r.envId }
+
+func (r *envRole) SetTask(t *task.Task) {
+	select {
+	case r.tasks <- t:
+	default:
+	}
+}
+
+func (r *envRole) UpdateStatus(status task.Status) {
+	select {
+	case r.statuses <- status:
+	default:
+	}
+}
+
+var _ = Describe("Scheduler", func() {
+	const timeout = 10 * time.Second
+
+	// Events sent by the master before the scheduler subscribes are lost, so
+	// no spec may rely on an earlier one having waited for the subscription.
+	BeforeEach(func() {
+		Eventually(func() string { return state.sm.Current() }, timeout).Should(Equal("CONNECTED"))
+	})
+
+	// deploy hands descriptors to the scheduler the way AcquireTasks does, and
+	// returns what it deployed once the deployment is over.
+	deploy := func(descriptors task.Descriptors) (deploymentMap task.DeploymentMap) {
+		deployed := make(chan task.DeploymentMap)
+		go func() {
+			deployed <- <-state.resourceOffersDone
+		}()
+		state.reviveOffersTrg <- struct{}{}
+		<-state.reviveOffersTrg
+		state.tasksToDeploy <- descriptors
+
+		Eventually(deployed, timeout).Should(Receive(&deploymentMap))
+		return
+	}
+
+	// launched returns the tasks of a class which the master was asked to launch.
+	launched := func(className string) (tasks []mesos.TaskInfo) {
+		for _, info := range master.Tasks() {
+			if strings.HasPrefix(info.GetName(), className+"#") {
+				tasks = append(tasks, info)
+			}
+		}
+		return
+	}
+
+	It("should subscribe to the Mesos master and connect", func() {
+		Eventually(func() string { return state.sm.Current() }, timeout).Should(Equal("CONNECTED"))
+		Expect(master.CallCount(scheduler.Call_SUBSCRIBE)).To(BeNumerically(">=", 1))
+		Eventually(func() string { return store.GetIgnoreErrors(fidStore)() }, timeout).
+			Should(Equal(master.FrameworkId().Value))
+	})
+
+	It("should decline offers when no roles need deployment", func() {
+		accepts := master.CallCount(scheduler.Call_ACCEPT)
+		declines := master.CallCount(scheduler.Call_DECLINE)
+		state.reviveOffersTrg <- struct{}{}
+		<-state.reviveOffersTrg
+
+		Eventually(func() int { return master.CallCount(scheduler.Call_DECLINE) }, timeout).
+			Should(BeNumerically(">", declines))
+		Expect(master.CallCount(scheduler.Call_ACCEPT)).To(Equal(accepts))
+	})
+
+	It("should refuse the offers it declines for a while", func() {
+		Eventually(func() int { return master.CallCount(scheduler.Call_DECLINE) }, timeout).
+			Should(BeNumerically(">=", 1))
+		declines := 0
+		for _, call := range master.Calls() {
+			if call.GetType() == scheduler.Call_DECLINE {
+				declines++
+				Expect(call.GetDecline().GetFilters().GetRefuseSeconds()).To(BeNumerically(">", 0))
+			}
+		}
+		Expect(declines).To(BeNumerically(">=", 1))
+	})
+
+	It("should deploy nothing for descriptors of an unknown task class", func() {
+		// The deployment waits for better offers until it times out, after
+		// mesosOfferWaitTimeout as set for the suite.
+		declinesBefore := master.CallCount(scheduler.Call_DECLINE)
+		deploymentMap := deploy(task.Descriptors{{
+			TaskRole:      &fakeRole{path: "test.role", taskClass: "no/such/class"},
+			TaskClassName: "no/such/class",
+		}})
+
+		Expect(deploymentMap).To(BeEmpty())
+		Eventually(func() int { return master.CallCount(scheduler.Call_DECLINE) }, timeout).
+			Should(BeNumerically(">", declinesBefore))
+		Expect(launched("no/such/class")).To(BeEmpty())
+	})
+
+	It("should launch the tasks it deploys on executors which report them running", func() {
+		className := addTaskClass(`
+name: mesos-device
+control:
+  mode: direct
+command:
+  value: o2-device
+wants:
+  cpu: 0.5
+  memory: 64
+`)
+		envId := uuid.NewRandom().Array()
+		role := newEnvRole(envId, "test.device", className)
+		Expect(state.taskman.AcquireTasks(envId, task.Descriptors{{
+			TaskRole:      role,
+			TaskClassName: className,
+		}})).To(Succeed())
+
+		var taskPtr *task.Task
+		Expect(role.tasks).To(Receive(&taskPtr))
+		tasks := launched(className)
+		Expect(tasks).To(HaveLen(1))
+		info := tasks[0]
+		Expect(info.GetExecutor()).NotTo(BeNil())
+		Expect(info.GetData()).NotTo(BeEmpty())
+
+		// The executor subscribes, gets the task and reports it running,
+		// which the scheduler acknowledges and passes on to the role.
+		Eventually(master.Executors, timeout).Should(ContainElement(info.GetExecutor().ExecutorID))
+		Eventually(func() mesos.TaskState {
+			status, _ := master.TaskStatus(info.TaskID.Value)
+			return status.GetState()
+		}, timeout).Should(Equal(mesos.TASK_RUNNING))
+		Eventually(role.statuses, timeout).Should(Receive(BeEquivalentTo(task.ACTIVE)))
+
+		Expect(state.taskman.ReleaseTasks(envId, task.Tasks{taskPtr})).To(Succeed())
+		killed, _, err := state.taskman.KillTasks([]string{info.TaskID.Value})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(killed).To(HaveLen(1))
+		Eventually(func() mesos.TaskState {
+			status, _ := master.TaskStatus(info.TaskID.Value)
+			return status.GetState()
+		}, timeout).Should(Equal(mesos.TASK_KILLED))
+	})
+
+	It("should explain why tasks cannot be deployed", func() {
+		classYaml := func(name string, extra string) string {
+			return "name: " + name + `
+control:
+  mode: direct
+command:
+  value: o2-device
+` + extra
+		}
+		reasons := map[string]string{
+			addTaskClass(classYaml("constrained-device", `
+wants:
+  cpu: 0.5
+  memory: 64
+constraints:
+- attribute: machine_id
+  value: nope
+`)): "constraints [ATTR:'machine_id' EQUALS 'nope'] unsatisfied",
+			addTaskClass(classYaml("greedy-cpu-device", `
+wants:
+  cpu: 64
+  memory: 64
+`)): "insufficient CPU: 64 wanted",
+			addTaskClass(classYaml("greedy-memory-device", `
+wants:
+  cpu: 0.5
+  memory: 100000
+`)): "insufficient memory: 100000 MB wanted",
+			addTaskClass(classYaml("privileged-device", `
+wants:
+  cpu: 0.5
+  memory: 64
+  ports: "80-81"
+`)): "port ranges unavailable: [80-81] wanted",
+			// containerRuntime is not set for the suite
+			addTaskClass(classYaml("containerized-device", `
+wants:
+  cpu: 0.5
+  memory: 64
+container:
+  image: registry.cern.ch/alice/device:v1
+`)): "container image registry.cern.ch/alice/device:v1 wanted, but no containerRuntime is configured",
+		}
+
+		// All of them fail in one deployment, which takes the offer wait timeout
+		envId := uuid.NewRandom().Array()
+		descriptors := make(task.Descriptors, 0)
+		for className := range reasons {
+			role := newEnvRole(envId, "test."+className, className)
+			descriptors = append(descriptors, &task.Descriptor{TaskRole: role, TaskClassName: className})
+		}
+		err := state.taskman.AcquireTasks(envId, descriptors)
+		Expect(err).To(BeAssignableToTypeOf(task.TasksDeploymentError{}))
+		deploymentErr := err.(task.TasksDeploymentError)
+		Expect(deploymentErr.OffersReceived()).To(BeNumerically(">=", 1))
+		Expect(deploymentErr.Explanations()).To(HaveLen(len(reasons)))
+		for _, explanation := range deploymentErr.Explanations() {
+			Expect(explanation.Rejections).NotTo(BeEmpty())
+			for _, rejection := range explanation.Rejections {
+				Expect(rejection.Hostname).To(Equal("test-host-1"))
+				Expect(rejection.Reason).To(ContainSubstring(reasons[explanation.TaskClass]))
+			}
+		}
+
+		// The reasons reach the user as details of the gRPC status
+		st := deploymentErrorToStatus(codes.Internal, "cannot create new environment", err)
+		Expect(st.Code()).To(Equal(codes.Internal))
+		Expect(st.Message()).To(HavePrefix("cannot create new environment: deployment failed for 5 roles: "))
+		Expect(st.Details()).To(HaveLen(1))
+		failure, ok := st.Details()[0].(*pb.DeploymentFailure)
+		Expect(ok).To(BeTrue())
+		Expect(failure.OffersReceived).To(BeEquivalentTo(deploymentErr.OffersReceived()))
+		Expect(failure.Descriptors).To(HaveLen(len(reasons)))
+		for _, pde := range failure.Descriptors {
+			Expect(pde.RolePath).To(Equal("test." + pde.TaskClass))
+			Expect(pde.Rejections).NotTo(BeEmpty())
+			Expect(pde.Rejections[0].Reason).To(ContainSubstring(reasons[pde.TaskClass]))
+		}
+	})
+
+	It("should neither deploy on nor reuse the idle tasks of a drained host", func() {
+		className := addTaskClass(`
+name: drained-device
+control:
+  mode: direct
+command:
+  value: o2-device
+wants:
+  cpu: 0.5
+  memory: 64
+`)
+		acquire := func(envId uuid.Array) (role *envRole, err error) {
+			role = newEnvRole(envId, "test.drained", className)
+			err = state.taskman.AcquireTasks(envId, task.Descriptors{{TaskRole: role, TaskClassName: className}})
+			return
+		}
+
+		envId := uuid.NewRandom().Array()
+		role, err := acquire(envId)
+		Expect(err).NotTo(HaveOccurred())
+		var idle *task.Task
+		Expect(role.tasks).To(Receive(&idle))
+		Expect(state.taskman.ReleaseTasks(envId, task.Tasks{idle})).To(Succeed())
+
+		state.taskman.HostStates.Set("test-host-1", task.HOST_DRAINING)
+		envId = uuid.NewRandom().Array()
+		role, err = acquire(envId)
+		state.taskman.HostStates.Set("test-host-1", task.HOST_ACTIVE)
+		Expect(err).To(BeAssignableToTypeOf(task.TasksDeploymentError{}))
+		Expect(role.tasks).NotTo(Receive())
+		explanations := err.(task.TasksDeploymentError).Explanations()
+		Expect(explanations).To(HaveLen(1))
+		Expect(explanations[0].Rejections).NotTo(BeEmpty())
+		for _, rejection := range explanations[0].Rejections {
+			Expect(rejection.Hostname).To(Equal("test-host-1"))
+			Expect(rejection.Reason).To(Equal("host is in state DRAINING"))
+		}
+
+		// Once active again, the host gives its idle task to the next environment
+		envId = uuid.NewRandom().Array()
+		role, err = acquire(envId)
+		Expect(err).NotTo(HaveOccurred())
+		var reused *task.Task
+		Expect(role.tasks).To(Receive(&reused))
+		Expect(reused.GetTaskId()).To(Equal(idle.GetTaskId()))
+		Expect(launched(className)).To(HaveLen(1))
+
+		Expect(state.taskman.ReleaseTasks(envId, task.Tasks{reused})).To(Succeed())
+		_, _, err = state.taskman.KillTasks([]string{reused.GetTaskId()})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should acknowledge status updates", func() {
+		master.UpdateStatus(mesos.TaskStatus{
+			TaskID:  mesos.TaskID{Value: "unknown-task"},
+			State:   mesos.TASK_FAILED.Enum(),
+			Source:  mesos.SOURCE_EXECUTOR.Enum(),
+			AgentID: &mesos.AgentID{Value: testAgentId},
+			UUID:    []byte("status-uuid"),
+		})
+		Eventually(func() []string {
+			acked := make([]string, 0)
+			for _, call := range master.Calls() {
+				if call.GetType() == scheduler.Call_ACKNOWLEDGE {
+					acked = append(acked, string(call.GetAcknowledge().UUID))
+				}
+			}
+			return acked
+		}, timeout).Should(ContainElement("status-uuid"))
+	})
+
+	It("should request reconciliation when subscribing", func() {
+		Eventually(func() int { return master.CallCount(scheduler.Call_RECONCILE) }, timeout).
+			Should(BeNumerically(">=", 1))
+	})
+
+	It("should rebuild the tasks reported through reconciliation", func() {
+		master.UpdateStatus(reconciledStatus("orphaned-task", testAgentId, "test-host-1"))
+
+		Eventually(func() *task.Task { return state.taskman.GetTask("orphaned-task") }, timeout).
+			ShouldNot(BeNil())
+		t := state.taskman.GetTask("orphaned-task")
+		Expect(t.GetClassName()).To(Equal("some/repo/tasks/qc"))
+		Expect(t.GetHostname()).To(Equal("test-host-1"))
+		Expect(t.GetControlPort()).To(BeEquivalentTo(47101))
+		Expect(t.GetBindPorts()).To(HaveKeyWithValue("data", uint64(31000)))
+		Expect(t.GetState()).To(Equal(task.STANDBY))
+		Expect(t.GetStatus()).To(BeEquivalentTo(task.ACTIVE))
+		Expect(t.IsLocked()).To(BeFalse())
+	})
+
+	It("should kill the tasks it cannot rebuild through reconciliation", func() {
+		killsBefore := master.CallCount(scheduler.Call_KILL)
+		master.UpdateStatus(mesos.TaskStatus{
+			TaskID:     mesos.TaskID{Value: "unlabeled-task"},
+			State:      mesos.TASK_RUNNING.Enum(),
+			Source:     mesos.SOURCE_MASTER.Enum(),
+			Reason:     mesos.REASON_RECONCILIATION.Enum(),
+			AgentID:    &mesos.AgentID{Value: testAgentId},
+			ExecutorID: &mesos.ExecutorID{Value: "unlabeled-executor"},
+		})
+
+		Eventually(func() int { return master.CallCount(scheduler.Call_KILL) }, timeout).
+			Should(BeNumerically(">", killsBefore))
+		Expect(state.taskman.GetTask("unlabeled-task")).To(BeNil())
+	})
+
+	It("should forget its framework ID only once Mesos removed the framework", func() {
+		errorEvent := func(message string) *scheduler.Event {
+			return &scheduler.Event{
+				Type:  scheduler.Event_ERROR,
+				Error: &scheduler.Event_Error{Message: message},
+			}
+		}
+		ids := store.NewInMemorySingleton()
+		Expect(ids.Set("framework-1")).To(Succeed())
+		handler := subscriptionError(ids)
+
+		Expect(handler(context.Background(), errorEvent("Framework removed by operator"))).To(Succeed())
+		Expect(store.GetIgnoreErrors(ids)()).To(Equal("framework-1"))
+
+		Expect(handler(context.Background(), errorEvent(FRAMEWORK_REMOVED_MESSAGE))).To(Succeed())
+		Expect(store.GetIgnoreErrors(ids)()).To(BeEmpty())
+	})
+
+	It("should suppress offers while there is nothing to deploy", func() {
+		// The scheduler reads mesosSuppressOffersAfter as it runs, so rather than
+		// shortening it we make the core look idle for longer than that.
+		suppresses := master.CallCount(scheduler.Call_SUPPRESS)
+		state.offers.Lock()
+		state.offers.idleSince = time.Now().Add(-viper.GetDuration("mesosSuppressOffersAfter") - time.Second)
+		state.offers.Idle(context.Background(), state)
+		state.offers.Unlock()
+		Expect(master.CallCount(scheduler.Call_SUPPRESS)).To(Equal(suppresses + 1))
+		Expect(state.offers.IsSuppressed()).To(BeTrue())
+
+		// Deployments revive offers, as the task manager does in AcquireTasks.
+		revives := master.CallCount(scheduler.Call_REVIVE)
+		state.reviveOffersTrg <- struct{}{}
+		<-state.reviveOffersTrg
+		Expect(master.CallCount(scheduler.Call_REVIVE)).To(Equal(revives + 1))
+		Expect(state.offers.IsSuppressed()).To(BeFalse())
+	})
+
+	It("should refuse offers briefly between a revive and the deployment it was for", func() {
+		oc := newOfferController()
+		refuseSeconds := func() float64 {
+			return calls.Decline().With(oc.RefuseSeconds(state)).GetDecline().GetFilters().GetRefuseSeconds()
+		}
+		deploymentRefuseSeconds := viper.GetDuration("mesosDeploymentRefuseSeconds").Seconds()
+
+		oc.Reviving()
+		Expect(refuseSeconds()).To(Equal(deploymentRefuseSeconds))
+		oc.Lock()
+		oc.BeginDeployment(state, task.Descriptors{})
+		Expect(refuseSeconds()).To(Equal(deploymentRefuseSeconds))
+		oc.finishDeployment(state)
+		Expect(oc.revivedAt.IsZero()).To(BeTrue())
+		oc.Unlock()
+	})
+
+	It("should deploy with the offers handled between a revive and its descriptors", func() {
+		className := addTaskClass(`
+name: revived-device
+control:
+  mode: direct
+command:
+  value: o2-device
+wants:
+  cpu: 0.5
+  memory: 64
+`)
+		// AcquireTasks revives offers before it hands over the descriptors, and
+		// the offers of that revive may be declined before they come.
+		declines := master.CallCount(scheduler.Call_DECLINE)
+		state.reviveOffersTrg <- struct{}{}
+		<-state.reviveOffersTrg
+		Eventually(func() int { return master.CallCount(scheduler.Call_DECLINE) }, timeout).
+			Should(BeNumerically(">", declines))
+
+		deployed := make(chan task.DeploymentMap)
+		go func() {
+			deployed <- <-state.resourceOffersDone
+		}()
+		role := newEnvRole(uuid.NewRandom().Array(), "test.revived", className)
+		state.tasksToDeploy <- task.Descriptors{{TaskRole: role, TaskClassName: className}}
+
+		// Declined for mesosMaxRefuseSeconds, those offers would only come back
+		// after up to 5s.
+		var deploymentMap task.DeploymentMap
+		Eventually(deployed, 2*time.Second).Should(Receive(&deploymentMap))
+		Expect(deploymentMap).To(HaveLen(1))
+
+		tasks := launched(className)
+		Expect(tasks).To(HaveLen(1))
+		Expect(KillTask(context.Background(), state, controlcommands.MesosCommandTarget{
+			AgentId: tasks[0].AgentID,
+			TaskId:  tasks[0].TaskID,
+		})).To(Succeed())
+	})
+
+	It("should release the reservations of environments which are gone", func() {
+		accepts := master.CallCount(scheduler.Call_ACCEPT)
+		offers := []mesos.Offer{
+			{ID: mesos.OfferID{Value: "stale-offer"}, AgentID: mesos.AgentID{Value: testAgentId},
+				Resources: reservedFor("flp", "gone", resources.NewCPUs(1))},
+			{ID: mesos.OfferID{Value: "other-offer"}, AgentID: mesos.AgentID{Value: testAgentId},
+				Resources: reservedFor("flp", "", resources.NewCPUs(1))},
+		}
+		declines := map[mesos.OfferID]struct{}{offers[0].ID: {}, offers[1].ID: {}}
+
+		usable := releaseStaleReservations(context.Background(), state, offers, declines)
+		Expect(usable).To(HaveLen(1))
+		Expect(usable[0].ID.Value).To(Equal("other-offer"))
+		Expect(declines).NotTo(HaveKey(mesos.OfferID{Value: "stale-offer"}))
+		Expect(master.CallCount(scheduler.Call_ACCEPT)).To(Equal(accepts + 1))
+	})
+
+	It("should shut down idle executors", func() {
+		// Other specs may leave idle executors behind, so we only look at ours
+		shutdowns := func() (executorIds []string) {
+			for _, call := range master.Calls() {
+				if call.GetType() == scheduler.Call_SHUTDOWN {
+					executorIds = append(executorIds, call.GetShutdown().ExecutorID.Value)
+				}
+			}
+			return
+		}
+		// executorIdleTimeout is a minute for the suite
+		state.executors.Add(mesos.ExecutorID{Value: "host.idle"}, mesos.AgentID{Value: testAgentId}, nil)
+		doShutdownIdleExecutors(context.Background(), state, time.Now())
+		Expect(shutdowns()).NotTo(ContainElement("host.idle"))
+
+		idleSince := time.Now().Add(time.Hour)
+		doShutdownIdleExecutors(context.Background(), state, idleSince)
+		Expect(shutdowns()).NotTo(ContainElement("host.idle"))
+
+		doShutdownIdleExecutors(context.Background(), state, idleSince.Add(time.Minute))
+		Expect(shutdowns()).To(ContainElement("host.idle"))
+		Expect(state.executors.Idle(nil, idleSince.Add(time.Hour), 0)).To(BeEmpty())
+	})
+
+	Describe("under a reserved role", func() {
+		// Each spec runs its tasks on an agent of its own, whose resources are
+		// all reserved for flp and allocated to it, as Mesos does for frameworks
+		// of several roles. The agent is lost afterwards, so that the other
+		// specs only get offers from the suite agent.
+		var (
+			agentId  string
+			acquired map[uuid.Array]task.Tasks
+		)
+
+		addReservedAgent := func(id string, hostname string) {
+			agentId = id
+			reserved := append(mesos.Resources{},
+				resources.NewCPUs(2).Resource,
+				resources.NewMemory(1024).Resource,
+				portsResource(resources.BuildRanges().Span(31000, 32000).Ranges),
+			)
+			for i := range reserved {
+				role := "flp"
+				reserved[i].Role = &role
+			}
+			reserved.Allocate("flp")
+			master.AddAgent(mesostest.Agent{
+				Id:        id,
+				Hostname:  hostname,
+				Resources: reserved,
+			})
+
+			// The first offer of the agent, which comes before any deployment
+			// unless offers are suppressed, must not be outstanding once the
+			// specs deploy, or the scheduler may decline it for a long while
+			// in the middle of their deployment. So we have it offered now and
+			// wait until the scheduler knows the agent and has declined it.
+			state.reviveOffersTrg <- struct{}{}
+			<-state.reviveOffersTrg
+			Eventually(func() *task.AgentCacheInfo {
+				return state.taskman.AgentCache.Get(mesos.AgentID{Value: id})
+			}, timeout).ShouldNot(BeNil())
+			Eventually(func() mesos.Resources { return master.Available(id) }, timeout).
+				ShouldNot(BeEmpty())
+		}
+
+		BeforeEach(func() {
+			acquired = make(map[uuid.Array]task.Tasks)
+			state.reservations.SetPolicy(reservationPolicy{role: "flp"})
+		})
+
+		AfterEach(func() {
+			state.reservations.SetPolicy(reservationPolicy{})
+			for envId, tasks := range acquired {
+				Expect(state.taskman.ReleaseTasks(envId, tasks)).To(Succeed())
+			}
+			Expect(master.LoseAgent(agentId)).To(Succeed())
+			for _, tasks := range acquired {
+				for _, taskPtr := range tasks {
+					taskId := taskPtr.GetTaskId()
+					Eventually(func() *task.Task { return state.taskman.GetTask(taskId) }, timeout).
+						Should(BeNil())
+				}
+			}
+		})
+
+		// acquire deploys one task for each role of an environment, and waits
+		// until they all run.
+		acquire := func(className string, roles ...*envRole) (tasks []mesos.TaskInfo) {
+			descriptors := make(task.Descriptors, len(roles))
+			for i, role := range roles {
+				descriptors[i] = &task.Descriptor{TaskRole: role, TaskClassName: className}
+			}
+			Expect(state.taskman.AcquireTasks(roles[0].envId, descriptors)).To(Succeed())
+
+			for _, role := range roles {
+				var taskPtr *task.Task
+				Expect(role.tasks).To(Receive(&taskPtr))
+				acquired[role.envId] = append(acquired[role.envId], taskPtr)
+				for _, info := range launched(className) {
+					if info.TaskID.Value == taskPtr.GetTaskId() {
+						tasks = append(tasks, info)
+					}
+				}
+			}
+			Expect(tasks).To(HaveLen(len(roles)))
+			for _, info := range tasks {
+				Eventually(func() mesos.TaskState {
+					status, _ := master.TaskStatus(info.TaskID.Value)
+					return status.GetState()
+				}, timeout).Should(Equal(mesos.TASK_RUNNING))
+			}
+			return
+		}
+
+		It("should launch tasks and executors on the resources reserved for the role", func() {
+			addReservedAgent("reserved-agent-1", "reserved-host-1")
+			className := addTaskClass(`
+name: reserved-device
+control:
+  mode: direct
+command:
+  value: o2-device
+wants:
+  cpu: 0.5
+  memory: 64
+`)
+			envId := uuid.NewRandom().Array()
+			tasks := acquire(className, newEnvRole(envId, "test.reserved", className))
+
+			info := tasks[0]
+			Expect(info.AgentID.Value).To(Equal("reserved-agent-1"))
+			Expect(info.Resources).NotTo(BeEmpty())
+			Expect(info.GetExecutor().Resources).NotTo(BeEmpty())
+			for _, r := range append(info.Resources, info.GetExecutor().Resources...) {
+				Expect(r.IsReserved("flp")).To(BeTrue(), r.String())
+				Expect(r.GetAllocationInfo().GetRole()).To(Equal("flp"), r.String())
+			}
+		})
+
+		It("should launch all the tasks of an executor with the ExecutorInfo it was launched with", func() {
+			addReservedAgent("reserved-agent-2", "reserved-host-2")
+			className := addTaskClass(`
+name: shared-device
+control:
+  mode: direct
+command:
+  value: o2-device
+wants:
+  cpu: 0.5
+  memory: 64
+`)
+			// Two tasks with one offer, then another one with a later offer,
+			// once the executor runs.
+			envId := uuid.NewRandom().Array()
+			tasks := acquire(className,
+				newEnvRole(envId, "test.shared1", className),
+				newEnvRole(envId, "test.shared2", className))
+			executorInfo := tasks[0].GetExecutor()
+			Eventually(master.Executors, timeout).Should(ContainElement(executorInfo.ExecutorID))
+
+			otherEnvId := uuid.NewRandom().Array()
+			tasks = append(tasks, acquire(className, newEnvRole(otherEnvId, "test.shared3", className))...)
+
+			for _, info := range tasks {
+				Expect(info.AgentID.Value).To(Equal("reserved-agent-2"))
+				Expect(proto.Equal(info.GetExecutor(), executorInfo)).To(BeTrue(), info.GetExecutor().String())
+			}
+			for _, r := range executorInfo.Resources {
+				Expect(r.GetAllocationInfo().GetRole()).To(Equal("flp"), r.String())
+			}
+		})
+	})
+
+	Describe("losing an agent", func() {
+		// Each spec loses an agent of its own, so that the suite agent stays
+		// available to the other specs.
+		addAgent := func(agentId string, hostname string) {
+			master.AddAgent(mesostest.Agent{
+				Id:       agentId,
+				Hostname: hostname,
+				Resources: mesos.Resources{
+					resources.NewCPUs(1).Resource,
+					resources.NewMemory(512).Resource,
+				},
+			})
+		}
+
+		It("should keep running", func() {
+			addAgent("lost-agent-1", "lost-host-1")
+			Expect(master.LoseAgent("lost-agent-1")).To(Succeed())
+			Expect(master.LoseAgent("lost-agent-1")).NotTo(Succeed())
+			Consistently(func() string { return state.sm.Current() }, time.Second).Should(Equal("CONNECTED"))
+		})
+
+		It("should drop its idle tasks from the roster", func() {
+			addAgent("lost-agent-2", "lost-host-2")
+			master.UpdateStatus(reconciledStatus("lost-task", "lost-agent-2", "lost-host-2"))
+			master.UpdateStatus(reconciledStatus("kept-task", testAgentId, "test-host-1"))
+			Eventually(func() *task.Task { return state.taskman.GetTask("lost-task") }, timeout).
+				ShouldNot(BeNil())
+			Eventually(func() *task.Task { return state.taskman.GetTask("kept-task") }, timeout).
+				ShouldNot(BeNil())
+
+			Expect(master.LoseAgent("lost-agent-2")).To(Succeed())
+			Eventually(func() *task.Task { return state.taskman.GetTask("lost-task") }, timeout).
+				Should(BeNil())
+			Expect(state.taskman.GetTask("kept-task")).NotTo(BeNil())
+		})
+
+		It("should not wait for a deployment in progress to handle the loss", func() {
+			className := addTaskClass(`
+name: unplaceable-device
+control:
+  mode: direct
+command:
+  value: o2-device
+wants:
+  cpu: 0.5
+  memory: 64
+constraints:
+- attribute: machine_id
+  value: nope
+`)
+			addAgent("lost-agent-3", "lost-host-3")
+			envId := uuid.NewRandom().Array()
+			acquired := make(chan error, 1)
+			go func() {
+				acquired <- state.taskman.AcquireTasks(envId, task.Descriptors{{
+					TaskRole:      newEnvRole(envId, "test.unplaceable", className),
+					TaskClassName: className,
+				}})
+			}()
+			// The deployment waits for the offer wait timeout with the task
+			// manager locked, and needs the scheduler to handle offers to end
+			time.Sleep(200 * time.Millisecond)
+			Expect(master.LoseAgent("lost-agent-3")).To(Succeed())
+
+			Eventually(acquired, timeout).Should(Receive(BeAssignableToTypeOf(task.TasksDeploymentError{})))
+		})
+	})
+})
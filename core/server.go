@@ -43,6 +43,7 @@ import (
 	"github.com/AliceO2Group/Control/core/environment"
 	"github.com/AliceO2Group/Control/core/protos"
 	"github.com/looplab/fsm"
+	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/mesos/mesos-go/api/v1/lib/extras/store"
 	"github.com/pborman/uuid"
 	"google.golang.org/grpc/codes"
@@ -188,6 +189,7 @@ func (m *RpcServer) GetEnvironments(context.Context, *pb.GetEnvironmentsRequest)
 			Tasks:            tasksToShortTaskInfos(tasks),
 			RootRole:         env.Workflow().GetName(),
 			CurrentRunNumber: env.GetCurrentRunNumber(),
+			UnavailableHosts: unavailableHosts(tasks, &m.state.taskman.HostStates),
 		}
 
 		r.Environments = append(r.Environments, e)
@@ -222,9 +224,13 @@ func (m *RpcServer) NewEnvironment(cxt context.Context, request *pb.NewEnvironme
 	}
 
 	// Create new Environment instance with some roles, we get back a UUID
-	id, err := m.state.environments.CreateEnvironment(request.GetWorkflowTemplate())
+	id, err := m.state.environments.CreateEnvironment(request.GetWorkflowTemplate(), request.GetVars())
 	if err != nil {
-		return nil, status.Newf(codes.Internal, "cannot create new environment: %s", err.Error()).Err()
+		// The environment may have reserved resources before it failed to deploy
+		if id != nil && !uuid.Equal(id, uuid.NIL) {
+			releaseReservations(m.state, id)
+		}
+		return nil, deploymentErrorToStatus(codes.Internal, "cannot create new environment", err).Err()
 	}
 
 	newEnv, err := m.state.environments.Environment(id)
@@ -241,6 +247,7 @@ func (m *RpcServer) NewEnvironment(cxt context.Context, request *pb.NewEnvironme
 			Tasks: tasksToShortTaskInfos(tasks),
 			RootRole: newEnv.Workflow().GetName(),
 			CurrentRunNumber: newEnv.GetCurrentRunNumber(),
+			UnavailableHosts: unavailableHosts(tasks, &m.state.taskman.HostStates),
 		},
 	}
 
@@ -270,8 +277,10 @@ func (m *RpcServer) GetEnvironment(cxt context.Context, req *pb.GetEnvironmentRe
 			Tasks: tasksToShortTaskInfos(tasks),
 			RootRole: env.Workflow().GetName(),
 			CurrentRunNumber: env.GetCurrentRunNumber(),
+			UnavailableHosts: unavailableHosts(tasks, &m.state.taskman.HostStates),
 		},
 		Workflow: workflowToRoleTree(env.Workflow()),
+		History: historyToEnvironmentEvents(env.History()),
 	}
 	return r, nil
 }
@@ -302,15 +311,67 @@ func (m *RpcServer) ControlEnvironment(cxt context.Context, req *pb.ControlEnvir
 		State: env.CurrentState(),
 		CurrentRunNumber: env.GetCurrentRunNumber(),
 	}
+	if err != nil {
+		return reply, deploymentErrorToStatus(codes.Internal, "transition failed", err).Err()
+	}
 
 	return reply, err
 }
 
-func (*RpcServer) ModifyEnvironment(context.Context, *pb.ModifyEnvironmentRequest) (*pb.ModifyEnvironmentReply, error) {
-	log.WithPrefix("rpcserver").
-		WithField("method", "ModifyEnvironment").
-		Debug("implement me")
-	return nil, status.New(codes.Unimplemented, "not implemented").Err()
+func (m *RpcServer) ModifyEnvironment(cxt context.Context, req *pb.ModifyEnvironmentRequest) (*pb.ModifyEnvironmentReply, error) {
+	m.logMethod()
+	m.state.RLock()
+	defer m.state.RUnlock()
+
+	if req == nil || len(req.Id) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	env, err := m.state.environments.Environment(uuid.Parse(req.Id))
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+
+	if env.CurrentState() != "CONFIGURED" {
+		return nil, status.Newf(codes.FailedPrecondition, "cannot modify environment in state %s", env.CurrentState()).Err()
+	}
+
+	reply := &pb.ModifyEnvironmentReply{
+		FailedOperations: make([]*pb.EnvironmentOperation, 0),
+		Id: env.Id().String(),
+	}
+
+	// Operations are applied in order, and we stop at the first failure: the
+	// failed operation and all the following ones are reported back.
+	for i, op := range req.GetOperations() {
+		switch op.GetType() {
+		case pb.EnvironmentOperation_NOOP:
+			continue
+		case pb.EnvironmentOperation_SET_ITERATOR:
+			itRange := op.GetIteratorRange()
+			if itRange == nil {
+				err = status.Newf(codes.InvalidArgument, "no iterator range provided for role %s", op.GetRoleName()).Err()
+				break
+			}
+			var values []string
+			if len(itRange.GetValues()) != 0 {
+				values = itRange.GetValues()
+			}
+			err = m.state.environments.SetIterator(env.Id(), op.GetRoleName(), int(itRange.GetBegin()), int(itRange.GetEnd()), values)
+			if err != nil {
+				err = status.Newf(codes.Internal, "cannot modify iterator %s: %s", op.GetRoleName(), err.Error()).Err()
+			}
+		default:
+			err = status.Newf(codes.Unimplemented, "environment operation %s not implemented", op.GetType().String()).Err()
+		}
+		if err != nil {
+			reply.FailedOperations = append(reply.FailedOperations, req.GetOperations()[i:]...)
+			break
+		}
+	}
+
+	reply.State = env.CurrentState()
+	return reply, err
 }
 
 func (m *RpcServer) DestroyEnvironment(cxt context.Context, req *pb.DestroyEnvironmentRequest) (*pb.DestroyEnvironmentReply, error) {
@@ -352,6 +413,8 @@ func (m *RpcServer) DestroyEnvironment(cxt context.Context, req *pb.DestroyEnvir
 	if err != nil {
 		return &pb.DestroyEnvironmentReply{}, status.New(codes.Internal, err.Error()).Err()
 	}
+	// Once the tasks are gone, their resources may be unreserved
+	defer releaseReservations(m.state, env.Id())
 
 	if req.KeepTasks { // Tasks should stay running, so we're done
 		return &pb.DestroyEnvironmentReply{}, nil
@@ -391,7 +454,10 @@ func (m *RpcServer) GetTask(cxt context.Context, req *pb.GetTaskRequest) (*pb.Ge
 		return &pb.GetTaskReply{}, status.New(codes.NotFound, "task not found").Err()
 	}
 	taskClass := task.GetTaskClass()
-	commandInfo := task.BuildTaskCommand()
+	commandInfo, err := task.BuildTaskCommand()
+	if err != nil {
+		return &pb.GetTaskReply{}, status.Newf(codes.Internal, "cannot build task command: %s", err.Error()).Err()
+	}
 	var outbound []channel.Outbound
 	taskPath := ""
 	// TODO: probably not the nicest way to do this... the outbound assignments should be cached
@@ -414,17 +480,46 @@ func (m *RpcServer) GetTask(cxt context.Context, req *pb.GetTaskRequest) (*pb.Ge
 			ClassInfo: &pb.TaskClassInfo{
 				Name: task.GetClassName(),
 				ControlMode: taskClass.Control.Mode.String(),
+				CustomResources: customResourcesToPbCustomResources(taskClass.Wants.Custom),
 			},
 			InboundChannels: inboundChannelsToPbChannels(taskClass.Bind),
 			OutboundChannels: outboundChannelsToPbChannels(outbound),
 			CommandInfo: commandInfoToPbCommandInfo(commandInfo),
 			TaskPath: taskPath,
 			EnvId: task.GetEnvironmentId().String(),
+			CustomResources: customResourcesToPbCustomResources(task.GetWantsCustom()),
 		},
 	}
 	return rep, nil
 }
 
+func (m *RpcServer) GetTaskClass(cxt context.Context, req *pb.GetTaskClassRequest) (*pb.GetTaskClassReply, error) {
+	m.logMethod()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	if len(req.TaskClass) == 0 {
+		return nil, status.New(codes.InvalidArgument, "missing task class").Err()
+	}
+
+	identifier := the.RepoManager().ResolveTaskClassIdentifier(req.TaskClass)
+	err := the.RepoManager().EnsureReposPresent([]string{identifier})
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "cannot find repository for task class %s: %s", identifier, err.Error()).Err()
+	}
+	_, ancestors, resolvedYaml, err := task.ResolveTaskClass(identifier)
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "cannot resolve task class %s: %s", identifier, err.Error()).Err()
+	}
+
+	return &pb.GetTaskClassReply{
+		Identifier: identifier,
+		Extends: ancestors,
+		ResolvedDefinition: string(resolvedYaml),
+	}, nil
+}
+
 func (m *RpcServer) CleanupTasks(cxt context.Context, req *pb.CleanupTasksRequest) (*pb.CleanupTasksReply, error) {
 	m.logMethod()
 	m.state.Lock()
@@ -480,6 +575,102 @@ func (m *RpcServer) GetRoles(cxt context.Context, req *pb.GetRolesRequest) (*pb.
 	return &pb.GetRolesReply{Roles: roleInfos}, nil
 }
 
+func (m *RpcServer) GetHosts(cxt context.Context, req *pb.GetHostsRequest) (*pb.GetHostsReply, error) {
+	m.logMethod()
+	m.state.RLock()
+	defer m.state.RUnlock()
+
+	agents := m.state.taskman.AgentCache.List()
+	tasks := m.state.taskman.GetTasks()
+	hostStates := &m.state.taskman.HostStates
+	hostInfos := make([]*pb.HostInfo, 0, len(agents))
+	known := make(map[string]bool)
+	for _, agent := range agents {
+		known[agent.Hostname] = true
+		hostInfos = append(hostInfos, agentToHostInfo(agent, tasks, hostStates.Get(agent.Hostname)))
+	}
+	// Hosts under maintenance are often offline, but we still list them so
+	// that they aren't forgotten
+	for _, hostname := range hostStates.Unavailable() {
+		if !known[hostname] {
+			hostInfos = append(hostInfos, agentToHostInfo(task.AgentCacheInfo{Hostname: hostname}, nil, hostStates.Get(hostname)))
+		}
+	}
+	sort.SliceStable(hostInfos, func(i, j int) bool {
+		return hostInfos[i].Hostname < hostInfos[j].Hostname
+	})
+	return &pb.GetHostsReply{Hosts: hostInfos}, nil
+}
+
+func (m *RpcServer) GetHost(cxt context.Context, req *pb.GetHostRequest) (*pb.GetHostReply, error) {
+	m.logMethod()
+	m.state.RLock()
+	defer m.state.RUnlock()
+
+	if req == nil || len(req.Host) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	agent := m.state.taskman.AgentCache.GetByHostname(req.Host)
+	if agent == nil {
+		agent = m.state.taskman.AgentCache.Get(mesos.AgentID{Value: req.Host})
+	}
+	if agent == nil {
+		hostState := m.state.taskman.HostStates.Get(req.Host)
+		if hostState == task.HOST_ACTIVE {
+			return nil, status.Newf(codes.NotFound, "host not found: %s", req.Host).Err()
+		}
+		return &pb.GetHostReply{Host: agentToHostInfo(task.AgentCacheInfo{Hostname: req.Host}, nil, hostState)}, nil
+	}
+	return &pb.GetHostReply{Host: agentToHostInfo(*agent, m.state.taskman.GetTasks(),
+		m.state.taskman.HostStates.Get(agent.Hostname))}, nil
+}
+
+func (m *RpcServer) SetHostState(cxt context.Context, req *pb.SetHostStateRequest) (*pb.SetHostStateReply, error) {
+	m.logMethod()
+	m.state.Lock()
+	defer m.state.Unlock()
+
+	if req == nil || len(req.Host) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	hostState, err := task.HostStateFromString(req.State)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	// A host is always stored by hostname, but we also accept an agent id
+	hostname := req.Host
+	agent := m.state.taskman.AgentCache.GetByHostname(req.Host)
+	if agent == nil {
+		agent = m.state.taskman.AgentCache.Get(mesos.AgentID{Value: req.Host})
+		if agent != nil {
+			hostname = agent.Hostname
+		}
+	}
+
+	persistedState := hostState.String()
+	if hostState == task.HOST_ACTIVE {
+		persistedState = ""
+	}
+	err = the.ConfSvc().SetHostState(hostname, persistedState)
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot persist host state: %s", err.Error()).Err()
+	}
+	m.state.taskman.HostStates.Set(hostname, hostState)
+
+	log.WithPrefix("rpcserver").
+		WithField("hostname", hostname).
+		WithField("state", hostState.String()).
+		Info("host state changed")
+
+	hostInfo := agentToHostInfo(task.AgentCacheInfo{Hostname: hostname}, nil, hostState)
+	if agent != nil {
+		hostInfo = agentToHostInfo(*agent, m.state.taskman.GetTasks(), hostState)
+	}
+	return &pb.SetHostStateReply{Host: hostInfo}, nil
+}
+
 func (m *RpcServer) GetWorkflowTemplates(cxt context.Context, req *pb.GetWorkflowTemplatesRequest) (*pb.GetWorkflowTemplatesReply, error) {
 	m.logMethod()
 	m.state.RLock()
@@ -494,7 +685,11 @@ func (m *RpcServer) GetWorkflowTemplates(cxt context.Context, req *pb.GetWorkflo
 	i := 0
 	for repo, templates := range workflowMap {
 		for _, template := range templates {
-			workflowTemplateInfos[i] = &pb.WorkflowTemplateInfo{Repo: repo, Template: template}
+			workflowTemplateInfos[i] = &pb.WorkflowTemplateInfo{
+				Repo: repo,
+				Template: template.Name,
+				Parameters: workflowParametersToPbParameters(template.Parameters),
+			}
 			i++
 		}
 	}
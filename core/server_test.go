@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RpcServer", func() {
+	It("should refuse to resolve a task class it is not given", func() {
+		server := &RpcServer{}
+
+		_, err := server.GetTaskClass(context.Background(), nil)
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		Expect(status.Convert(err).Message()).To(Equal("received nil request"))
+
+		_, err = server.GetTaskClass(context.Background(), &pb.GetTaskClassRequest{})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		Expect(status.Convert(err).Message()).To(Equal("missing task class"))
+	})
+
+	Describe("setting host states", func() {
+		var server *RpcServer
+
+		hostnames := func(hosts []*pb.HostInfo) (names []string) {
+			for _, host := range hosts {
+				names = append(names, host.GetHostname())
+			}
+			return
+		}
+
+		BeforeEach(func() {
+			server = &RpcServer{state: state}
+		})
+
+		It("should refuse requests without a host or with an unknown state", func() {
+			_, err := server.SetHostState(context.Background(), nil)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			_, err = server.SetHostState(context.Background(), &pb.SetHostStateRequest{Host: "rpc-host-1", State: "RETIRED"})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(state.taskman.HostStates.Get("rpc-host-1")).To(Equal(task.HOST_ACTIVE))
+		})
+
+		It("should apply and persist the state of a host until it is active again", func() {
+			reply, err := server.SetHostState(context.Background(), &pb.SetHostStateRequest{Host: "rpc-host-2", State: "maintenance"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetHost().GetHostname()).To(Equal("rpc-host-2"))
+			Expect(reply.GetHost().GetState()).To(Equal("MAINTENANCE"))
+			Expect(state.taskman.HostStates.Get("rpc-host-2")).To(Equal(task.HOST_MAINTENANCE))
+			Expect(the.ConfSvc().GetHostStates()).To(HaveKeyWithValue("rpc-host-2", "MAINTENANCE"))
+
+			// An unknown host under maintenance is still listed
+			hosts, err := server.GetHosts(context.Background(), &pb.GetHostsRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hostnames(hosts.GetHosts())).To(ContainElement("rpc-host-2"))
+
+			_, err = server.SetHostState(context.Background(), &pb.SetHostStateRequest{Host: "rpc-host-2", State: "ACTIVE"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.taskman.HostStates.Get("rpc-host-2")).To(Equal(task.HOST_ACTIVE))
+			Expect(the.ConfSvc().GetHostStates()).NotTo(HaveKey("rpc-host-2"))
+		})
+
+		It("should store the state of a host given by agent ID under its hostname", func() {
+			// The agent is known once the scheduler got an offer from it
+			Eventually(func() *task.AgentCacheInfo {
+				return state.taskman.AgentCache.Get(mesos.AgentID{Value: testAgentId})
+			}, 10*time.Second).ShouldNot(BeNil())
+
+			reply, err := server.SetHostState(context.Background(), &pb.SetHostStateRequest{Host: testAgentId, State: "DRAINING"})
+			Expect(err).NotTo(HaveOccurred())
+			defer func() {
+				_, err := server.SetHostState(context.Background(), &pb.SetHostStateRequest{Host: testAgentId, State: "ACTIVE"})
+				Expect(err).NotTo(HaveOccurred())
+			}()
+			Expect(reply.GetHost().GetHostname()).To(Equal("test-host-1"))
+			Expect(state.taskman.HostStates.Get("test-host-1")).To(Equal(task.HOST_DRAINING))
+			Expect(the.ConfSvc().GetHostStates()).To(HaveKeyWithValue("test-host-1", "DRAINING"))
+			Expect(the.ConfSvc().GetHostStates()).NotTo(HaveKey(testAgentId))
+		})
+	})
+})
@@ -25,12 +25,25 @@
 package core
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/environment"
 	"github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task/channel"
 
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/looplab/fsm"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func commandInfoToPbCommandInfo(c *common.TaskCommandInfo) (pci *pb.CommandInfo) {
@@ -86,6 +99,18 @@ func outboundChannelToPbChannel(ch channel.Outbound) (pch *pb.ChannelInfo) {
 	return
 }
 
+func customResourcesToPbCustomResources(crs task.CustomResources) (pbcrs []*pb.CustomResourceInfo) {
+	pbcrs = make([]*pb.CustomResourceInfo, len(crs))
+	for i, cr := range crs {
+		pbcrs[i] = &pb.CustomResourceInfo{
+			Name: cr.Name,
+			Type: cr.Type.String(),
+			Value: cr.String(),
+		}
+	}
+	return
+}
+
 func taskToShortTaskInfo(t *task.Task) (sti *pb.ShortTaskInfo) {
 	if t == nil {
 		return
@@ -94,8 +119,10 @@ func taskToShortTaskInfo(t *task.Task) (sti *pb.ShortTaskInfo) {
 		Name:   t.GetName(),
 		Locked: t.IsLocked(),
 		TaskId: t.GetTaskId(),
-		Status: "UNKNOWN",
-		State:  "UNKNOWN",
+		// Unlocked tasks, e.g. those rebuilt through reconciliation, have no
+		// parent role to report for them
+		Status: t.GetStatus().String(),
+		State:  t.GetState().String(),
 		ClassName: t.GetClassName(),
 		DeploymentInfo: &pb.TaskDeploymentInfo{
 			Hostname: t.GetHostname(),
@@ -154,3 +181,158 @@ func workflowToRoleTree(root workflow.Role) (ri *pb.RoleInfo) {
 	}
 	return
 }
+
+// agentToHostInfo describes a host as known from the agent cache, along with
+// the tasks which run on it.
+func agentToHostInfo(agent task.AgentCacheInfo, allTasks task.Tasks, hostState task.HostState) (hi *pb.HostInfo) {
+	hostTasks := allTasks.Filtered(func(t *task.Task) bool {
+		return t.GetAgentId() == agent.AgentId.Value
+	})
+	envIds := make([]string, 0)
+	seenEnvs := make(map[string]bool)
+	for _, t := range hostTasks {
+		if !t.IsLocked() {
+			continue
+		}
+		envId := t.GetEnvironmentId().UUID().String()
+		if !seenEnvs[envId] {
+			seenEnvs[envId] = true
+			envIds = append(envIds, envId)
+		}
+	}
+	sort.Strings(envIds)
+
+	attributes := make(map[string]string)
+	for _, attr := range agent.Attributes {
+		attributes[attr.Name], _ = agent.Attributes.Get(attr.Name)
+	}
+
+	hi = &pb.HostInfo{
+		Hostname: agent.Hostname,
+		AgentId: agent.AgentId.Value,
+		Attributes: attributes,
+		Resources: resourcesToStringMap(agent.Resources),
+		Tasks: tasksToShortTaskInfos(hostTasks),
+		EnvironmentIds: envIds,
+		State: hostState.String(),
+	}
+	if !agent.LastOffer.IsZero() {
+		hi.LastOffered = agent.LastOffer.Format(time.RFC3339)
+	}
+	return
+}
+
+// unavailableHosts returns the sorted hostnames of the given tasks which are
+// drained or under maintenance.
+func unavailableHosts(tasks task.Tasks, hostStates *task.HostStates) (hostnames []string) {
+	hostnames = make([]string, 0)
+	seen := make(map[string]bool)
+	for _, t := range tasks {
+		hostname := t.GetHostname()
+		if seen[hostname] || hostStates.IsAvailable(hostname) {
+			continue
+		}
+		seen[hostname] = true
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	return
+}
+
+// resourcesToStringMap sums the resources by name, regardless of their roles
+// and reservations, and formats the totals.
+func resourcesToStringMap(rs mesos.Resources) (rm map[string]string) {
+	rm = make(map[string]string)
+	flattened := resources.Flatten(rs)
+	for name, resType := range resources.TypesOf(flattened...) {
+		sum, ok := name.Sum(flattened...)
+		if !ok || sum == nil {
+			continue
+		}
+		switch resType {
+		case mesos.SCALAR:
+			rm[name.String()] = strconv.FormatFloat(sum.GetScalar().GetValue(), 'f', -1, 64)
+		case mesos.RANGES:
+			ranges := make([]string, 0)
+			for _, rng := range sum.GetRanges().GetRange() {
+				ranges = append(ranges, fmt.Sprintf("%d-%d", rng.Begin, rng.End))
+			}
+			rm[name.String()] = strings.Join(ranges, ",")
+		case mesos.SET:
+			rm[name.String()] = strings.Join(sum.GetSet().GetItem(), ",")
+		}
+	}
+	return
+}
+
+func historyToEnvironmentEvents(history []environment.HistoryEntry) (ees []*pb.EnvironmentEvent) {
+	ees = make([]*pb.EnvironmentEvent, len(history))
+	for i, entry := range history {
+		ees[i] = &pb.EnvironmentEvent{
+			Timestamp: entry.Timestamp.Format(time.RFC3339),
+			Event: entry.Event,
+			RolePath: entry.RolePath,
+			TaskId: entry.TaskId,
+			Message: entry.Message,
+		}
+	}
+	return
+}
+
+func workflowParametersToPbParameters(params repos.WorkflowParameters) (pps []*pb.WorkflowParameterInfo) {
+	pps = make([]*pb.WorkflowParameterInfo, len(params))
+	for i, param := range params {
+		pps[i] = &pb.WorkflowParameterInfo{
+			Name: param.Name,
+			Type: param.Type,
+			Required: param.Default == nil,
+			Description: param.Description,
+			AllowedValues: param.Values,
+		}
+		if param.Default != nil {
+			pps[i].DefaultValue = *param.Default
+		}
+	}
+	return
+}
+
+// deploymentErrorToStatus returns a gRPC status for err which, if err was caused
+// by a failed task deployment, carries a DeploymentFailure detail explaining why
+// each role could not be deployed.
+func deploymentErrorToStatus(code codes.Code, msg string, err error) *status.Status {
+	st := status.Newf(code, "%s: %s", msg, err.Error())
+
+	if canceledErr, ok := err.(fsm.CanceledError); ok {
+		err = canceledErr.Err
+	}
+	deploymentErr, ok := err.(task.TasksDeploymentError)
+	if !ok {
+		return st
+	}
+
+	failure := &pb.DeploymentFailure{
+		OffersReceived: int32(deploymentErr.OffersReceived()),
+		Descriptors: make([]*pb.DescriptorExplanation, 0),
+	}
+	for _, explanation := range deploymentErr.Explanations() {
+		pde := &pb.DescriptorExplanation{
+			RolePath: explanation.RolePath,
+			TaskClass: explanation.TaskClass,
+			Rejections: make([]*pb.OfferRejection, len(explanation.Rejections)),
+		}
+		for i, rejection := range explanation.Rejections {
+			pde.Rejections[i] = &pb.OfferRejection{
+				OfferId: rejection.OfferId,
+				Hostname: rejection.Hostname,
+				Reason: rejection.Reason,
+			}
+		}
+		failure.Descriptors = append(failure.Descriptors, pde)
+	}
+
+	detailed, detailsErr := st.WithDetails(failure)
+	if detailsErr != nil {
+		return st
+	}
+	return detailed
+}
@@ -98,11 +98,14 @@ func newInternalState(shutdown func()) (*internalState, error) {
 		random:             rand.New(rand.NewSource(time.Now().Unix())),
 		shutdown:           shutdown,
 		environments:       nil,
+		executors:          newExecutorTracker(),
+		offers:             newOfferController(),
+		reservations:       newReservationTracker(reservationPolicyFromConfig()),
 	}
 
 	state.servent = controlcommands.NewServent(
 		func(command controlcommands.MesosCommand, receiver controlcommands.MesosCommandTarget) error {
-			return SendCommand(context.TODO(), state, command, receiver)
+			return state.backend.sendCommand(context.TODO(), command, receiver)
 		},
 	)
 	state.commandqueue = controlcommands.NewCommandQueue(state.servent)
@@ -113,9 +116,10 @@ func newInternalState(shutdown func()) (*internalState, error) {
 		reviveOffersTrg,
 		state.commandqueue,
 		func(task *task.Task) error {
-			return KillTask(context.TODO(), state, task.GetMesosCommandTarget())
+			return state.backend.killTask(context.TODO(), task.GetMesosCommandTarget())
 		},
 	)
+	taskman.SetExecutorReuseFilter(executorAllowsReuse)
 	state.taskman = taskman
 	state.environments = environment.NewEnvManager(state.taskman)
 	state.commandqueue.Start()	// FIXME: there should be 1 cq per env
@@ -123,6 +127,24 @@ func newInternalState(shutdown func()) (*internalState, error) {
 	return state, nil
 }
 
+// loadHostStates restores the drained and maintenance hosts persisted in the
+// configuration store.
+func loadHostStates(taskman *task.Manager) {
+	hostStates, err := the.ConfSvc().GetHostStates()
+	if err != nil {
+		log.WithError(err).Warning("cannot retrieve host states, all hosts will be considered active")
+		return
+	}
+	for hostname, hostStateName := range hostStates {
+		hostState, err := task.HostStateFromString(hostStateName)
+		if err != nil {
+			log.WithError(err).WithField("hostname", hostname).Warning("bad host state in configuration")
+			continue
+		}
+		taskman.HostStates.Set(hostname, hostState)
+	}
+}
+
 type internalState struct {
 	sync.RWMutex
 
@@ -144,6 +166,12 @@ type internalState struct {
 	role               string
 	cli                calls.Caller
 	shutdown           func()
+	backend            schedulerBackend
+
+	// uses locks, so thread safe
+	executors          *executorTracker
+	offers             *offerController
+	reservations       *reservationTracker
 
 	// uses prometheus counters, so thread safe
 	metricsAPI         *metricsAPI
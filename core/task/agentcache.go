@@ -26,7 +26,9 @@ package task
 
 import (
 	"github.com/mesos/mesos-go/api/v1/lib"
+	"sort"
 	"sync"
+	"time"
 	"github.com/AliceO2Group/Control/core/task/constraint"
 )
 
@@ -39,6 +41,8 @@ type AgentCacheInfo struct{
 	AgentId    mesos.AgentID
 	Attributes constraint.Attributes
 	Hostname   string
+	Resources  mesos.Resources // as of the last offer
+	LastOffer  time.Time
 }
 
 func (ac *AgentCache) Update(agents ...AgentCacheInfo) {
@@ -58,7 +62,7 @@ func (ac *AgentCache) Update(agents ...AgentCacheInfo) {
 }
 
 func (ac *AgentCache) Get(id mesos.AgentID) (agent *AgentCacheInfo) {
-	if ac == nil || ac.store == nil {
+	if ac == nil {
 		return
 	}
 	ac.mu.RLock()
@@ -71,9 +75,57 @@ func (ac *AgentCache) Get(id mesos.AgentID) (agent *AgentCacheInfo) {
 	return
 }
 
+// GetByHostname returns the agent running on the given host, if any.
+func (ac *AgentCache) GetByHostname(hostname string) (agent *AgentCacheInfo) {
+	if ac == nil {
+		return
+	}
+	ac.mu.RLock()
+	defer ac.mu.RUnlock()
+
+	for _, aci := range ac.store {
+		if aci.Hostname == hostname {
+			found := aci
+			return &found
+		}
+	}
+	return
+}
+
+// List returns all the agents in the cache, sorted by hostname.
+func (ac *AgentCache) List() (agents []AgentCacheInfo) {
+	agents = make([]AgentCacheInfo, 0)
+	if ac == nil {
+		return
+	}
+	ac.mu.RLock()
+	defer ac.mu.RUnlock()
+
+	for _, aci := range ac.store {
+		agents = append(agents, aci)
+	}
+	sort.Slice(agents, func(i, j int) bool {
+		return agents[i].Hostname < agents[j].Hostname
+	})
+	return
+}
+
+// Remove drops an agent from the cache, e.g. once Mesos reports it as gone.
+func (ac *AgentCache) Remove(id mesos.AgentID) {
+	if ac == nil {
+		return
+	}
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	delete(ac.store, id)
+}
+
 func (ac *AgentCache) Count() (count int) {
-	if ac == nil || ac.store == nil {
+	if ac == nil {
 		return 0
 	}
+	ac.mu.RLock()
+	defer ac.mu.RUnlock()
 	return len(ac.store)
 }
\ No newline at end of file
@@ -0,0 +1,77 @@
+package task
+
+import (
+	"github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func cachedAgent(id string, hostname string) AgentCacheInfo {
+	return AgentCacheInfo{
+		AgentId:  mesos.AgentID{Value: id},
+		Hostname: hostname,
+	}
+}
+
+var _ = Describe("AgentCache", func() {
+	var ac *AgentCache
+
+	BeforeEach(func() {
+		ac = &AgentCache{}
+		ac.Update(cachedAgent("agent-1", "flp1"), cachedAgent("agent-2", "flp2"))
+	})
+
+	Describe("looking up an agent by hostname", func() {
+		It("should return the agent running on the host", func() {
+			agent := ac.GetByHostname("flp2")
+			Expect(agent).NotTo(BeNil())
+			Expect(agent.AgentId.Value).To(Equal("agent-2"))
+		})
+
+		It("should return nil for an unknown host", func() {
+			Expect(ac.GetByHostname("flp3")).To(BeNil())
+		})
+
+		It("should return the agent which replaced another on the same host", func() {
+			ac.Remove(mesos.AgentID{Value: "agent-1"})
+			ac.Update(cachedAgent("agent-3", "flp1"))
+
+			agent := ac.GetByHostname("flp1")
+			Expect(agent).NotTo(BeNil())
+			Expect(agent.AgentId.Value).To(Equal("agent-3"))
+		})
+
+		It("should return a copy of the cached agent", func() {
+			ac.GetByHostname("flp1").Hostname = "changed"
+			Expect(ac.GetByHostname("flp1")).NotTo(BeNil())
+		})
+
+		It("should return nil from an empty or nil cache", func() {
+			Expect((&AgentCache{}).GetByHostname("flp1")).To(BeNil())
+			var nilCache *AgentCache
+			Expect(nilCache.GetByHostname("flp1")).To(BeNil())
+		})
+	})
+
+	Describe("removing an agent", func() {
+		It("should drop only that agent", func() {
+			ac.Remove(mesos.AgentID{Value: "agent-1"})
+
+			Expect(ac.Count()).To(Equal(1))
+			Expect(ac.Get(mesos.AgentID{Value: "agent-1"})).To(BeNil())
+			Expect(ac.GetByHostname("flp1")).To(BeNil())
+			Expect(ac.List()).To(Equal([]AgentCacheInfo{cachedAgent("agent-2", "flp2")}))
+		})
+
+		It("should ignore an unknown agent", func() {
+			ac.Remove(mesos.AgentID{Value: "agent-3"})
+			Expect(ac.Count()).To(Equal(2))
+		})
+
+		It("should be safe on an empty or nil cache", func() {
+			Expect(func() { (&AgentCache{}).Remove(mesos.AgentID{Value: "agent-1"}) }).NotTo(Panic())
+			var nilCache *AgentCache
+			Expect(func() { nilCache.Remove(mesos.AgentID{Value: "agent-1"}) }).NotTo(Panic())
+		})
+	})
+})
@@ -32,6 +32,8 @@ import (
 	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task/channel"
 	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/placement"
+	"sort"
 	"strconv"
 )
 
@@ -46,10 +48,13 @@ type info struct {
 		Mode    controlmode.ControlMode `yaml:"mode"`
 	}                                   `yaml:"control"`
 	Command     *common.CommandInfo     `yaml:"command"`
+	Container   *common.ContainerInfo   `yaml:"container"`
 	Wants       ResourceWants           `yaml:"wants"`
 	Bind        []channel.Inbound       `yaml:"bind"`
 	Properties  controlcommands.PropertyMap `yaml:"properties"`
 	Constraints []constraint.Constraint `yaml:"constraints"`
+	Placement   placement.Policy        `yaml:"placement"`
+	Restart     *RestartPolicy          `yaml:"restart"`
 }
 
 type taskClassIdentifier struct {
@@ -74,6 +79,7 @@ type ResourceWants struct {
 	Cpu     *float64                `yaml:"cpu"`
 	Memory  *float64                `yaml:"memory"`
 	Ports   Ranges                  `yaml:"ports"`
+	Custom  CustomResources         `yaml:"-"` // any other key, see CustomResource
 }
 
 func (rw *ResourceWants) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
@@ -110,8 +116,31 @@ func (rw *ResourceWants) UnmarshalYAML(unmarshal func(interface{}) error) (err e
 		if err != nil {
 			return
 		}
+		if port, overlap := ranges.firstOverlap(); overlap {
+			err = fmt.Errorf("static port %d wanted more than once", port)
+			return
+		}
 		rw.Ports = ranges
 	}
+
+	all := make(map[string]interface{})
+	err = unmarshal(&all)
+	if err != nil {
+		return
+	}
+	for name, value := range all {
+		switch name {
+		case "cpu", "memory", "ports":
+			continue
+		}
+		var cr CustomResource
+		cr, err = parseCustomResource(name, value)
+		if err != nil {
+			return
+		}
+		rw.Custom = append(rw.Custom, cr)
+	}
+	sort.Slice(rw.Custom, func(i, j int) bool { return rw.Custom[i].Name < rw.Custom[j].Name })
 	return
 }
 
@@ -120,8 +149,35 @@ func (this *info) Equals(other *info) (response bool) {
 		return false
 	}
 	response = this.Command.Equals(other.Command) &&
-		*this.Wants.Cpu == *other.Wants.Cpu &&
-		*this.Wants.Memory == *other.Wants.Memory &&
-		this.Wants.Ports.Equals(other.Wants.Ports)
+		this.Container.Equals(other.Container) &&
+		this.Control.Mode == other.Control.Mode &&
+		this.Wants.Equals(other.Wants) &&
+		equalInbounds(this.Bind, other.Bind)
 	return
 }
+
+func (rw ResourceWants) Equals(other ResourceWants) bool {
+	return equalFloatPtrs(rw.Cpu, other.Cpu) &&
+		equalFloatPtrs(rw.Memory, other.Memory) &&
+		rw.Ports.Equals(other.Ports) &&
+		rw.Custom.Equals(other.Custom)
+}
+
+func equalInbounds(a, b []channel.Inbound) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalFloatPtrs(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -26,6 +26,7 @@ package constraint
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/mesos/mesos-go/api/v1/lib"
@@ -47,7 +48,12 @@ func (attrs Attributes) String() string {
 func (attrs Attributes) Get(attributeName string) (value string, ok bool) {
 	for _, a := range attrs {
 		if a.Name == attributeName {
-			value = a.GetText().GetValue()
+			switch a.GetType() {
+			case mesos.SCALAR:
+				value = strconv.FormatFloat(a.GetScalar().GetValue(), 'f', -1, 64)
+			default:
+				value = a.GetText().GetValue()
+			}
 			ok = true
 			return
 		}
@@ -55,39 +61,110 @@ func (attrs Attributes) Get(attributeName string) (value string, ok bool) {
 	return
 }
 
+// getNumber returns the value of a scalar attribute, or of a text attribute
+// which can be parsed as a number.
+func (attrs Attributes) getNumber(attributeName string) (value float64, ok bool) {
+	for _, a := range attrs {
+		if a.Name == attributeName {
+			switch a.GetType() {
+			case mesos.SCALAR:
+				return a.GetScalar().GetValue(), true
+			case mesos.TEXT:
+				var err error
+				value, err = strconv.ParseFloat(a.GetText().GetValue(), 64)
+				ok = err == nil
+				return
+			}
+			return
+		}
+	}
+	return
+}
+
 func (attrs Attributes) Satisfy(cts Constraints) (ok bool) {
 	if len(cts) == 0 {
 		ok = true
 		log.Debug("no constraints to satisfy, defaulting to true")
 		return
 	}
-	if attrs == nil {
-		log.Debug("no attributes but non-null constraints, defaulting to false")
-		return
-	}
 
 	for _, constraint := range cts {
 		log.WithField("constraint", constraint.String()).Debug("processing constraint")
-		switch constraint.Operator {
-		case Equals:
-			var value string
-			if value, ok = attrs.Get(constraint.Attribute); ok {
-				if value == constraint.Value {
-					ok = true
-					continue
-				} else {
-					ok = false
-					break
-				}
-			} else { //at least 1 constraint not satisfiable, bailing out
-				log.WithField("constraint", constraint.Attribute).Warning("at least 1 constraint not satisfiable (cannot get attribute)")
-				ok = false
-				break
-			}
-		default:
-			log.WithField("constraint", constraint.Attribute).Warning("unsupported operator, skipping constraint")
-			continue
+		if !attrs.satisfy(constraint) {
+			log.WithField("constraint", constraint.String()).Debug("constraint not satisfied")
+			return false
+		}
+	}
+	return true
+}
+
+// Unsatisfied returns the constraints in cts which are not satisfied.
+func (attrs Attributes) Unsatisfied(cts Constraints) (unsatisfied Constraints) {
+	unsatisfied = make(Constraints, 0)
+	for _, constraint := range cts {
+		if !attrs.satisfy(constraint) {
+			unsatisfied = append(unsatisfied, constraint)
 		}
 	}
 	return
 }
+
+func (attrs Attributes) satisfy(ct Constraint) bool {
+	value, found := attrs.Get(ct.Attribute)
+
+	switch ct.Operator {
+	case Equals:
+		return found && value == ct.Value
+	case NotEquals:
+		return !found || value != ct.Value
+	case In:
+		return found && contains(ct.Values, value)
+	case NotIn:
+		return !found || !contains(ct.Values, value)
+	case Matches:
+		if !found {
+			return false
+		}
+		re, err := compileMatcher(ct.Value)
+		if err != nil {
+			log.WithError(err).WithField("constraint", ct.String()).Warning("invalid regular expression in constraint")
+			return false
+		}
+		return re.MatchString(value)
+	case Exists:
+		return found
+	case NotExists:
+		return !found
+	case LessThan, LessOrEqual, GreaterThan, GreaterOrEqual:
+		number, isNumber := attrs.getNumber(ct.Attribute)
+		if !isNumber {
+			return false
+		}
+		target, err := strconv.ParseFloat(ct.Value, 64)
+		if err != nil {
+			log.WithError(err).WithField("constraint", ct.String()).Warning("invalid numeric value in constraint")
+			return false
+		}
+		switch ct.Operator {
+		case LessThan:
+			return number < target
+		case LessOrEqual:
+			return number <= target
+		case GreaterThan:
+			return number > target
+		default:
+			return number >= target
+		}
+	}
+	log.WithField("constraint", ct.Attribute).Warning("unsupported operator, skipping constraint")
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
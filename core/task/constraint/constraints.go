@@ -29,45 +29,176 @@
 package constraint
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/sirupsen/logrus"
 )
 
-/* TODO:
-type Operator func(attribute string, value string) bool
-
-func Equals(attribute string, value string) bool {
-
-}*/
 var log = logger.New(logrus.StandardLogger(),"constraints")
 
+// Constraint is a predicate on a single agent attribute, written in YAML as
+//   - attribute: machine_id
+//     operator: "!="            # optional, defaults to ==
+//     value: aido2-bld4-lab101
+// The in and not_in operators take a list in values instead of value, while
+// exists and not_exists take no value at all.
 type Constraint struct {
 	Attribute string   `yaml:"attribute"`
-	Value     string   `yaml:"value"`
-	// TODO: unmarshal this ↓
-	Operator  Operator
+	Value     string   `yaml:"value,omitempty"`
+	Values    []string `yaml:"values,omitempty"`
+	Operator  Operator `yaml:"operator,omitempty"`
+}
+
+func (c *Constraint) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _constraint Constraint
+	aux := _constraint{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+	ct := Constraint(aux)
+	err = ct.validate()
+	if err != nil {
+		return
+	}
+	*c = ct
+	return
+}
+
+func (c *Constraint) validate() error {
+	if len(c.Attribute) == 0 {
+		return errors.New("constraint without attribute")
+	}
+	switch c.Operator {
+	case In, NotIn:
+		if len(c.Values) == 0 {
+			return fmt.Errorf("constraint on attribute %s: operator %s requires a list of values", c.Attribute, c.Operator.Symbol())
+		}
+	case Exists, NotExists:
+		if len(c.Value) != 0 || len(c.Values) != 0 {
+			return fmt.Errorf("constraint on attribute %s: operator %s takes no value", c.Attribute, c.Operator.Symbol())
+		}
+	case Matches:
+		if _, err := compileMatcher(c.Value); err != nil {
+			return fmt.Errorf("constraint on attribute %s: invalid regular expression: %s", c.Attribute, err.Error())
+		}
+	case LessThan, LessOrEqual, GreaterThan, GreaterOrEqual:
+		if _, err := strconv.ParseFloat(c.Value, 64); err != nil {
+			return fmt.Errorf("constraint on attribute %s: operator %s requires a numeric value", c.Attribute, c.Operator.Symbol())
+		}
+	}
+	return nil
+}
+
+// compileMatcher compiles the regular expression of a Matches constraint, which
+// must match the whole attribute value.
+func compileMatcher(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + expr + ")$")
 }
 
 type Operator int8
 const (
-	Equals Operator = 0
+	Equals Operator = iota
+	NotEquals
+	In
+	NotIn
+	Matches
+	Exists
+	NotExists
+	LessThan
+	LessOrEqual
+	GreaterThan
+	GreaterOrEqual
 )
 
+var operatorSymbols = map[Operator]string{
+	Equals:         "==",
+	NotEquals:      "!=",
+	In:             "in",
+	NotIn:          "not_in",
+	Matches:        "~=",
+	Exists:         "exists",
+	NotExists:      "not_exists",
+	LessThan:       "<",
+	LessOrEqual:    "<=",
+	GreaterThan:    ">",
+	GreaterOrEqual: ">=",
+}
+
 func (o Operator) String() string {
 	switch o {
 	case Equals:
 		return "EQUALS"
+	case NotEquals:
+		return "NOT_EQUALS"
+	case In:
+		return "IN"
+	case NotIn:
+		return "NOT_IN"
+	case Matches:
+		return "MATCHES"
+	case Exists:
+		return "EXISTS"
+	case NotExists:
+		return "NOT_EXISTS"
+	case LessThan:
+		return "LESS_THAN"
+	case LessOrEqual:
+		return "LESS_OR_EQUAL"
+	case GreaterThan:
+		return "GREATER_THAN"
+	case GreaterOrEqual:
+		return "GREATER_OR_EQUAL"
 	}
 	return ""
 }
 
+// Symbol returns the operator as written in YAML.
+func (o Operator) Symbol() string {
+	return operatorSymbols[o]
+}
+
+func OperatorFromSymbol(symbol string) (o Operator, err error) {
+	if len(symbol) == 0 {
+		return Equals, nil
+	}
+	for op, sym := range operatorSymbols {
+		if sym == symbol {
+			return op, nil
+		}
+	}
+	return Equals, fmt.Errorf("unknown constraint operator %s", symbol)
+}
+
+func (o *Operator) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	var symbol string
+	err = unmarshal(&symbol)
+	if err != nil {
+		return
+	}
+	*o, err = OperatorFromSymbol(strings.TrimSpace(symbol))
+	return
+}
+
+func (o Operator) MarshalYAML() (interface{}, error) {
+	return o.Symbol(), nil
+}
+
 func (c *Constraint) String() string {
 	if c == nil {
 		return ""
 	}
+	switch c.Operator {
+	case In, NotIn:
+		return fmt.Sprintf("ATTR:'%s' %s ['%s']", c.Attribute, c.Operator.String(), strings.Join(c.Values, "', '"))
+	case Exists, NotExists:
+		return fmt.Sprintf("ATTR:'%s' %s", c.Attribute, c.Operator.String())
+	}
 	return fmt.Sprintf("ATTR:'%s' %s '%s'", c.Attribute, c.Operator.String(), c.Value)
 }
 
@@ -84,24 +215,23 @@ func (cts Constraints) String() string {
 	return fmt.Sprintf("[%s]", strings.Join(strs, "; "))
 }
 
+// MergeParent returns the parent constraints overridden by cts.
+// Overrides are done per attribute: if cts has one or more constraints on an
+// attribute, they replace all the parent constraints on that attribute,
+// whatever their operators. Constraints on other attributes are kept from both
+// sides.
 func (cts Constraints) MergeParent(parentConstraints Constraints) (merged Constraints) {
-	merged = make(Constraints, len(parentConstraints))
-	copy(merged, parentConstraints)
-
+	overridden := make(map[string]bool)
 	for _, ct := range cts {
-		updated := false
-		for j, pCt := range merged {
-			// If we find that both new and parent have a constraint for the same attribute,
-			// then the new one replaces the parent's constraint in the merged Constraints
-			if ct.Attribute == pCt.Attribute {
-				merged[j] = ct
-				updated = true
-				break
-			}
-		}
-		if !updated {
-			merged = append(merged, ct)
+		overridden[ct.Attribute] = true
+	}
+
+	merged = make(Constraints, 0, len(parentConstraints) + len(cts))
+	for _, pCt := range parentConstraints {
+		if !overridden[pCt.Attribute] {
+			merged = append(merged, pCt)
 		}
 	}
+	merged = append(merged, cts...)
 	return
 }
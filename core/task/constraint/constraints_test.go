@@ -0,0 +1,241 @@
+package constraint_test
+
+import (
+	. "github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+func textAttribute(name string, value string) mesos.Attribute {
+	return mesos.Attribute{
+		Name: name,
+		Type: mesos.TEXT,
+		Text: &mesos.Value_Text{Value: value},
+	}
+}
+
+func scalarAttribute(name string, value float64) mesos.Attribute {
+	return mesos.Attribute{
+		Name: name,
+		Type: mesos.SCALAR,
+		Scalar: &mesos.Value_Scalar{Value: value},
+	}
+}
+
+var _ = Describe("Constraints", func() {
+	var (
+		cts Constraints
+		err error
+	)
+
+	Describe("unmarshaling from YAML", func() {
+		It("should default to the equals operator", func() {
+			err = yaml.Unmarshal([]byte(`
+- attribute: machine_id
+  value: flp1
+`), &cts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cts).To(Equal(Constraints{
+				{Attribute: "machine_id", Value: "flp1", Operator: Equals},
+			}))
+		})
+
+		It("should read all the supported operators", func() {
+			err = yaml.Unmarshal([]byte(`
+- attribute: a
+  operator: "=="
+  value: x
+- attribute: b
+  operator: "!="
+  value: x
+- attribute: c
+  operator: in
+  values: [x, y]
+- attribute: d
+  operator: not_in
+  values: [x, y]
+- attribute: e
+  operator: "~="
+  value: "flp[0-9]+"
+- attribute: f
+  operator: exists
+- attribute: g
+  operator: not_exists
+- attribute: h
+  operator: "<"
+  value: "1"
+- attribute: i
+  operator: "<="
+  value: "1"
+- attribute: j
+  operator: ">"
+  value: "1"
+- attribute: k
+  operator: ">="
+  value: "1.5"
+`), &cts)
+			Expect(err).NotTo(HaveOccurred())
+			operators := make([]Operator, len(cts))
+			for i, ct := range cts {
+				operators[i] = ct.Operator
+			}
+			Expect(operators).To(Equal([]Operator{
+				Equals, NotEquals, In, NotIn, Matches, Exists, NotExists,
+				LessThan, LessOrEqual, GreaterThan, GreaterOrEqual,
+			}))
+			Expect(cts[2].Values).To(Equal([]string{"x", "y"}))
+		})
+
+		It("should reject an unknown operator", func() {
+			err = yaml.Unmarshal([]byte(`
+- attribute: a
+  operator: "=~"
+  value: x
+`), &cts)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject in without a list of values", func() {
+			err = yaml.Unmarshal([]byte(`
+- attribute: a
+  operator: in
+  value: x
+`), &cts)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject exists with a value", func() {
+			err = yaml.Unmarshal([]byte(`
+- attribute: a
+  operator: exists
+  value: x
+`), &cts)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject an invalid regular expression", func() {
+			err = yaml.Unmarshal([]byte(`
+- attribute: a
+  operator: "~="
+  value: "flp[0-9"
+`), &cts)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject a numeric comparison with a non numeric value", func() {
+			err = yaml.Unmarshal([]byte(`
+- attribute: a
+  operator: "<"
+  value: many
+`), &cts)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("checking against agent attributes", func() {
+		var attrs Attributes
+
+		BeforeEach(func() {
+			attrs = Attributes{
+				textAttribute("machine_id", "flp12"),
+				textAttribute("rack", "4"),
+				scalarAttribute("cores", 32),
+			}
+		})
+
+		It("should be satisfied by anything when empty", func() {
+			Expect(attrs.Satisfy(Constraints{})).To(BeTrue())
+			Expect(Attributes(nil).Satisfy(nil)).To(BeTrue())
+		})
+
+		It("should check equality", func() {
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Value: "flp12"}})).To(BeTrue())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Value: "flp13"}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "missing", Value: "flp12"}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "cores", Value: "32"}})).To(BeTrue())
+		})
+
+		It("should check inequality, which a missing attribute satisfies", func() {
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Operator: NotEquals, Value: "flp13"}})).To(BeTrue())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Operator: NotEquals, Value: "flp12"}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "maintenance", Operator: NotEquals, Value: "true"}})).To(BeTrue())
+		})
+
+		It("should check set membership", func() {
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Operator: In, Values: []string{"flp11", "flp12"}}})).To(BeTrue())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Operator: In, Values: []string{"flp1", "flp2"}}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "missing", Operator: In, Values: []string{"flp12"}}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Operator: NotIn, Values: []string{"flp11", "flp12"}}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Operator: NotIn, Values: []string{"flp1", "flp2"}}})).To(BeTrue())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "missing", Operator: NotIn, Values: []string{"flp12"}}})).To(BeTrue())
+		})
+
+		It("should match regular expressions against the whole value", func() {
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Operator: Matches, Value: "flp[0-9]+"}})).To(BeTrue())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Operator: Matches, Value: "flp1"}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "missing", Operator: Matches, Value: ".*"}})).To(BeFalse())
+		})
+
+		It("should check whether an attribute exists", func() {
+			Expect(attrs.Satisfy(Constraints{{Attribute: "rack", Operator: Exists}})).To(BeTrue())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "missing", Operator: Exists}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "rack", Operator: NotExists}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "missing", Operator: NotExists}})).To(BeTrue())
+		})
+
+		It("should compare numeric attributes", func() {
+			Expect(attrs.Satisfy(Constraints{{Attribute: "cores", Operator: GreaterOrEqual, Value: "32"}})).To(BeTrue())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "cores", Operator: GreaterThan, Value: "32"}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "cores", Operator: LessThan, Value: "64"}})).To(BeTrue())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "cores", Operator: LessOrEqual, Value: "16"}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "rack", Operator: LessThan, Value: "5"}})).To(BeTrue())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "machine_id", Operator: LessThan, Value: "5"}})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{{Attribute: "missing", Operator: GreaterThan, Value: "0"}})).To(BeFalse())
+		})
+
+		It("should require all constraints to be satisfied", func() {
+			Expect(attrs.Satisfy(Constraints{
+				{Attribute: "machine_id", Value: "flp13"},
+				{Attribute: "rack", Value: "4"},
+			})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{
+				{Attribute: "machine_id", Operator: Matches, Value: "flp.*"},
+				{Attribute: "maintenance", Operator: NotExists},
+				{Attribute: "cores", Operator: GreaterOrEqual, Value: "8"},
+			})).To(BeTrue())
+		})
+	})
+
+	Describe("merging with parent constraints", func() {
+		It("should keep constraints on different attributes from both sides", func() {
+			parent := Constraints{{Attribute: "maintenance", Operator: NotExists}}
+			child := Constraints{{Attribute: "machine_id", Value: "flp1"}}
+			Expect(child.MergeParent(parent)).To(Equal(Constraints{
+				{Attribute: "maintenance", Operator: NotExists},
+				{Attribute: "machine_id", Value: "flp1"},
+			}))
+		})
+
+		It("should replace all the parent constraints on an attribute the child constrains", func() {
+			parent := Constraints{
+				{Attribute: "cores", Operator: GreaterOrEqual, Value: "8"},
+				{Attribute: "cores", Operator: LessThan, Value: "64"},
+				{Attribute: "rack", Operator: In, Values: []string{"1", "2"}},
+			}
+			child := Constraints{{Attribute: "cores", Operator: GreaterThan, Value: "16"}}
+			Expect(child.MergeParent(parent)).To(Equal(Constraints{
+				{Attribute: "rack", Operator: In, Values: []string{"1", "2"}},
+				{Attribute: "cores", Operator: GreaterThan, Value: "16"},
+			}))
+		})
+
+		It("should leave the parent constraints untouched", func() {
+			parent := Constraints{{Attribute: "machine_id", Value: "flp1"}}
+			child := Constraints{{Attribute: "machine_id", Operator: NotEquals, Value: "flp1"}}
+			child.MergeParent(parent)
+			Expect(parent).To(Equal(Constraints{{Attribute: "machine_id", Value: "flp1"}}))
+		})
+	})
+})
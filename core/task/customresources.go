@@ -0,0 +1,208 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+)
+
+// CustomResource is a named Mesos resource wanted by a task other than cpus,
+// mem and ports, such as hugepages, disk, or the CRU and NIC slots of a host.
+// In the wants of a task class or role, a number is a scalar resource, a
+// string such as "0-3,8" is a ranges resource, and a list is a set resource:
+//   wants:
+//     cpu: 1
+//     hugepages_1g: 4
+//     nic_queues: "0-3"
+//     cru: [ cru0 ]
+type CustomResource struct {
+	Name   string
+	Type   mesos.Value_Type
+	Scalar float64
+	Ranges Ranges
+	Set    []string
+}
+
+type CustomResources []CustomResource
+
+func parseCustomResource(name string, value interface{}) (cr CustomResource, err error) {
+	cr.Name = name
+	switch v := value.(type) {
+	case int:
+		cr.Type = mesos.SCALAR
+		cr.Scalar = float64(v)
+	case float64:
+		cr.Type = mesos.SCALAR
+		cr.Scalar = v
+	case string:
+		cr.Type = mesos.RANGES
+		cr.Ranges, err = parsePortRanges(v)
+		if err != nil {
+			err = fmt.Errorf("bad ranges for resource %s: %s", name, err.Error())
+		}
+	case []interface{}:
+		cr.Type = mesos.SET
+		cr.Set = make([]string, len(v))
+		for i, item := range v {
+			cr.Set[i] = fmt.Sprintf("%v", item)
+		}
+		sort.Strings(cr.Set)
+	default:
+		err = fmt.Errorf("bad value for resource %s: expected number, ranges or list", name)
+	}
+	return
+}
+
+func (cr CustomResource) mesosRanges() mesos.Ranges {
+	builder := resources.BuildRanges()
+	for _, rng := range cr.Ranges {
+		builder = builder.Span(rng.Begin, rng.End)
+	}
+	return builder.Ranges.Sort().Squash()
+}
+
+// Resource returns the Mesos resource to request for cr.
+func (cr CustomResource) Resource() mesos.Resource {
+	builder := resources.Build().Name(resources.Name(cr.Name))
+	switch cr.Type {
+	case mesos.RANGES:
+		builder = builder.Ranges(cr.mesosRanges())
+	case mesos.SET:
+		builder = builder.Set(cr.Set...)
+	default:
+		builder = builder.Scalar(cr.Scalar)
+	}
+	return builder.Resource
+}
+
+func (cr CustomResource) String() string {
+	switch cr.Type {
+	case mesos.RANGES:
+		return formatRanges(cr.mesosRanges())
+	case mesos.SET:
+		return "{" + strings.Join(cr.Set, ",") + "}"
+	default:
+		return strconv.FormatFloat(cr.Scalar, 'g', -1, 64)
+	}
+}
+
+// unsatisfied returns the reason why the available resources cannot satisfy
+// cr, or an empty string if they can.
+func (cr CustomResource) unsatisfied(avail Resources) (reason string) {
+	sum, ok := resources.Name(cr.Name).Sum(resources.Flatten(mesos.Resources(avail))...)
+	if !ok || sum == nil {
+		return fmt.Sprintf("no %s resource available", cr.Name)
+	}
+	switch cr.Type {
+	case mesos.RANGES:
+		availRanges := mesos.Ranges(sum.GetRanges().GetRange()).Sort().Squash()
+		if cr.mesosRanges().Compare(availRanges) > 0 {
+			return fmt.Sprintf("%s unavailable: %s wanted, %s available",
+				cr.Name, cr.String(), formatRanges(availRanges))
+		}
+	case mesos.SET:
+		wanted := &mesos.Value_Set{Item: cr.Set}
+		if wanted.Compare(sum.GetSet()) > 0 {
+			availItems := append([]string{}, sum.GetSet().GetItem()...)
+			sort.Strings(availItems)
+			return fmt.Sprintf("%s unavailable: %s wanted, {%s} available",
+				cr.Name, cr.String(), strings.Join(availItems, ","))
+		}
+	default:
+		if availScalar := sum.GetScalar().GetValue(); cr.Scalar > availScalar {
+			return fmt.Sprintf("insufficient %s: %g wanted, %g available", cr.Name, cr.Scalar, availScalar)
+		}
+	}
+	return ""
+}
+
+func (cr CustomResource) equals(other CustomResource) bool {
+	if cr.Name != other.Name || cr.Type != other.Type || cr.Scalar != other.Scalar ||
+		!cr.Ranges.Equals(other.Ranges) || len(cr.Set) != len(other.Set) {
+		return false
+	}
+	for i := range cr.Set {
+		if cr.Set[i] != other.Set[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals assumes both lists are sorted by name, as they are when unmarshaled.
+func (crs CustomResources) Equals(other CustomResources) bool {
+	if len(crs) != len(other) {
+		return false
+	}
+	for i := range crs {
+		if !crs[i].equals(other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergedWith returns the resources in crs, replaced by those with the same
+// name in override, and followed by the other resources in override.
+func (crs CustomResources) MergedWith(override CustomResources) (merged CustomResources) {
+	merged = make(CustomResources, 0, len(crs) + len(override))
+	overridden := make(map[string]CustomResource)
+	for _, cr := range override {
+		overridden[cr.Name] = cr
+	}
+	for _, cr := range crs {
+		if o, ok := overridden[cr.Name]; ok {
+			merged = append(merged, o)
+			delete(overridden, cr.Name)
+		} else {
+			merged = append(merged, cr)
+		}
+	}
+	for _, cr := range override {
+		if _, ok := overridden[cr.Name]; ok {
+			merged = append(merged, cr)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].Name < merged[j].Name })
+	return
+}
+
+// Resources returns the Mesos resources to request for crs.
+func (crs CustomResources) Resources() (rs mesos.Resources) {
+	rs = make(mesos.Resources, 0, len(crs))
+	for _, cr := range crs {
+		rs.Add1(cr.Resource())
+	}
+	return
+}
@@ -0,0 +1,104 @@
+package task
+
+import (
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("custom resources", func() {
+	Describe("parsing", func() {
+		table.DescribeTable("should parse the type of a resource from its value",
+			func(value interface{}, expected CustomResource) {
+				cr, err := parseCustomResource(expected.Name, value)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cr).To(Equal(expected))
+			},
+			table.Entry("an integer as a scalar", 4,
+				CustomResource{Name: "hugepages_1g", Type: mesos.SCALAR, Scalar: 4}),
+			table.Entry("a float as a scalar", 0.5,
+				CustomResource{Name: "disk", Type: mesos.SCALAR, Scalar: 0.5}),
+			table.Entry("a string as ranges", "0-3,8",
+				CustomResource{Name: "nic_queues", Type: mesos.RANGES, Ranges: Ranges{{0, 3}, {8, 8}}}),
+			table.Entry("a list as a sorted set", []interface{}{"cru1", "cru0"},
+				CustomResource{Name: "cru", Type: mesos.SET, Set: []string{"cru0", "cru1"}}),
+		)
+
+		It("should reject malformed ranges", func() {
+			_, err := parseCustomResource("nic_queues", "0-a")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("bad ranges for resource nic_queues"))
+		})
+
+		It("should reject a value of another kind", func() {
+			_, err := parseCustomResource("cru", map[interface{}]interface{}{"a": 1})
+			Expect(err).To(MatchError("bad value for resource cru: expected number, ranges or list"))
+		})
+
+		It("should collect the keys other than cpu, memory and ports of the wants", func() {
+			var rw ResourceWants
+			Expect(yaml.Unmarshal([]byte(`
+cpu: 1
+memory: 512
+ports: "10000-10010"
+nic_queues: "0-3"
+hugepages_1g: 4
+`), &rw)).To(Succeed())
+			Expect(rw.Custom).To(Equal(CustomResources{
+				{Name: "hugepages_1g", Type: mesos.SCALAR, Scalar: 4},
+				{Name: "nic_queues", Type: mesos.RANGES, Ranges: Ranges{{0, 3}}},
+			}))
+		})
+	})
+
+	Describe("matching", func() {
+		var available Resources
+
+		BeforeEach(func() {
+			available = Resources{
+				resources.NewCPUs(4).Resource,
+				resources.NewMemory(4096).Resource,
+				resources.Build().Name(resources.NamePorts).
+					Ranges(resources.BuildRanges().Span(31000, 32000).Ranges).Resource,
+				resources.Build().Name(resources.Name("hugepages_1g")).Scalar(8).Resource,
+				resources.Build().Name(resources.Name("nic_queues")).
+					Ranges(resources.BuildRanges().Span(0, 7).Ranges).Resource,
+				resources.Build().Name(resources.Name("cru")).Set("cru0", "cru1").Resource,
+			}
+		})
+
+		table.DescribeTable("should report why the resources cannot satisfy the wants",
+			func(custom CustomResources, reason string) {
+				Expect(available.Unsatisfied(&Wants{Cpu: 1, Memory: 512, Custom: custom})).To(Equal(reason))
+			},
+			table.Entry("all satisfied",
+				CustomResources{
+					{Name: "cru", Type: mesos.SET, Set: []string{"cru1"}},
+					{Name: "hugepages_1g", Type: mesos.SCALAR, Scalar: 8},
+					{Name: "nic_queues", Type: mesos.RANGES, Ranges: Ranges{{0, 3}}},
+				},
+				""),
+			table.Entry("missing resource",
+				CustomResources{{Name: "gpus", Type: mesos.SCALAR, Scalar: 1}},
+				"no gpus resource available"),
+			table.Entry("insufficient scalar",
+				CustomResources{{Name: "hugepages_1g", Type: mesos.SCALAR, Scalar: 16}},
+				"insufficient hugepages_1g: 16 wanted, 8 available"),
+			table.Entry("ranges out of the available ones",
+				CustomResources{{Name: "nic_queues", Type: mesos.RANGES, Ranges: Ranges{{6, 9}}}},
+				"nic_queues unavailable: [6-9] wanted, [0-7] available"),
+			table.Entry("set item not available",
+				CustomResources{{Name: "cru", Type: mesos.SET, Set: []string{"cru2"}}},
+				"cru unavailable: {cru2} wanted, {cru0,cru1} available"),
+		)
+
+		It("should check the standard resources before the custom ones", func() {
+			wants := &Wants{Cpu: 8, Custom: CustomResources{{Name: "gpus", Type: mesos.SCALAR, Scalar: 1}}}
+			Expect(available.Unsatisfied(wants)).To(Equal("insufficient CPU: 8 wanted, 4 available"))
+			Expect(available.Satisfy(wants)).To(BeFalse())
+		})
+	})
+})
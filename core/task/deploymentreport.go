@@ -0,0 +1,118 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package task
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/mesos/mesos-go/api/v1/lib"
+)
+
+// OfferRejection is the reason why an offer could not host the task of a
+// descriptor.
+type OfferRejection struct {
+	OfferId  string
+	Hostname string
+	Reason   string
+}
+
+// DescriptorExplanation tells why no task could be deployed for a descriptor.
+type DescriptorExplanation struct {
+	RolePath   string
+	TaskClass  string
+	Rejections []OfferRejection
+}
+
+func (e DescriptorExplanation) String() string {
+	if len(e.Rejections) == 0 {
+		return fmt.Sprintf("%s: no offer considered", e.RolePath)
+	}
+	reasons := make([]string, len(e.Rejections))
+	for i, rej := range e.Rejections {
+		reasons[i] = fmt.Sprintf("%s on %s", rej.Reason, rej.Hostname)
+	}
+	return fmt.Sprintf("%s: %s", e.RolePath, strings.Join(reasons, "; "))
+}
+
+// DeploymentReport collects, during a deployment, the reasons why each offer
+// was rejected for each descriptor, so that a failed deployment can be
+// explained to the user.
+type DeploymentReport struct {
+	mu             sync.Mutex
+	offersReceived int
+	rejections     map[*Descriptor][]OfferRejection
+}
+
+func NewDeploymentReport() *DeploymentReport {
+	return &DeploymentReport{
+		rejections: make(map[*Descriptor][]OfferRejection),
+	}
+}
+
+func (r *DeploymentReport) AddOffersReceived(n int) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.offersReceived += n
+}
+
+// Reject records that offer cannot host the task of descriptor, and why.
+func (r *DeploymentReport) Reject(descriptor *Descriptor, offer *mesos.Offer, reason string) {
+	if r == nil || descriptor == nil || offer == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rejections[descriptor] = append(r.rejections[descriptor], OfferRejection{
+		OfferId:  offer.ID.Value,
+		Hostname: offer.Hostname,
+		Reason:   reason,
+	})
+}
+
+func (r *DeploymentReport) explain(descriptors Descriptors) (offersReceived int, explanations []DescriptorExplanation) {
+	explanations = make([]DescriptorExplanation, 0, len(descriptors))
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, descriptor := range descriptors {
+		explanation := DescriptorExplanation{
+			TaskClass:  descriptor.TaskClassName,
+			Rejections: append([]OfferRejection{}, r.rejections[descriptor]...),
+		}
+		if descriptor.TaskRole != nil {
+			explanation.RolePath = descriptor.TaskRole.GetPath()
+		}
+		explanations = append(explanations, explanation)
+	}
+	return r.offersReceived, explanations
+}
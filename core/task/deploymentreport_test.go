@@ -0,0 +1,97 @@
+package task
+
+import (
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeploymentReport", func() {
+	var (
+		report      *DeploymentReport
+		descriptors Descriptors
+		offer       *mesos.Offer
+	)
+
+	BeforeEach(func() {
+		report = NewDeploymentReport()
+		descriptors = Descriptors{
+			{TaskRole: &fakeParentRole{}, TaskClassName: "readout"},
+		}
+		offer = &mesos.Offer{ID: mesos.OfferID{Value: "offer-1"}, Hostname: "flp001"}
+	})
+
+	It("should collect the reasons why offers were rejected for each descriptor", func() {
+		report.AddOffersReceived(2)
+		report.Reject(descriptors[0], offer, "insufficient CPU: 8 wanted, 4 available")
+		report.Reject(descriptors[0], &mesos.Offer{ID: mesos.OfferID{Value: "offer-2"}, Hostname: "flp002"},
+			"constraints [ATTR:'machine_id' EQUALS 'flp001'] unsatisfied")
+
+		offersReceived, explanations := report.explain(descriptors)
+		Expect(offersReceived).To(Equal(2))
+		Expect(explanations).To(Equal([]DescriptorExplanation{{
+			RolePath:  "wf.readout",
+			TaskClass: "readout",
+			Rejections: []OfferRejection{
+				{OfferId: "offer-1", Hostname: "flp001", Reason: "insufficient CPU: 8 wanted, 4 available"},
+				{OfferId: "offer-2", Hostname: "flp002", Reason: "constraints [ATTR:'machine_id' EQUALS 'flp001'] unsatisfied"},
+			},
+		}}))
+
+		err := TasksDeploymentError{offersReceived: offersReceived, explanations: explanations}
+		Expect(err.Error()).To(Equal("deployment failed for 1 roles: wf.readout: " +
+			"insufficient CPU: 8 wanted, 4 available on flp001; " +
+			"constraints [ATTR:'machine_id' EQUALS 'flp001'] unsatisfied on flp002"))
+	})
+
+	It("should explain a deployment without offers", func() {
+		offersReceived, explanations := report.explain(descriptors)
+		Expect(offersReceived).To(BeZero())
+		Expect(explanations).To(HaveLen(1))
+		Expect(explanations[0].String()).To(Equal("wf.readout: no offer considered"))
+
+		err := TasksDeploymentError{offersReceived: offersReceived, explanations: explanations}
+		Expect(err.Error()).To(Equal("deployment failed for 1 roles: no offers received"))
+	})
+
+	It("should fall back to the task IDs without explanations", func() {
+		err := TasksDeploymentError{tasksErrorBase: tasksErrorBase{taskIds: []string{"task-1"}}}
+		Expect(err.Error()).To(Equal("deployment failed for tasks [task-1]"))
+	})
+
+	It("should ignore rejections outside of a deployment", func() {
+		var none *DeploymentReport
+		none.AddOffersReceived(1)
+		none.Reject(descriptors[0], offer, "insufficient CPU")
+		offersReceived, explanations := none.explain(descriptors)
+		Expect(offersReceived).To(BeZero())
+		Expect(explanations).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Resources", func() {
+	available := Resources{
+		resources.NewCPUs(4).Resource,
+		resources.NewMemory(1024).Resource,
+		resources.Build().
+			Name(resources.Name("ports")).
+			Ranges(resources.BuildRanges().Span(31000, 31010).Ranges).
+			Resource,
+	}
+
+	table.DescribeTable("explaining unsatisfied wants",
+		func(wants Wants, reason string) {
+			Expect(available.Unsatisfied(&wants)).To(Equal(reason))
+		},
+		table.Entry("satisfied", Wants{Cpu: 1, Memory: 512}, ""),
+		table.Entry("insufficient CPU", Wants{Cpu: 8, Memory: 512},
+			"insufficient CPU: 8 wanted, 4 available"),
+		table.Entry("insufficient memory", Wants{Cpu: 1, Memory: 2048},
+			"insufficient memory: 2048 MB wanted, 1024 MB available"),
+		table.Entry("unavailable port range", Wants{Cpu: 1, Memory: 512, StaticPorts: Ranges{{Begin: 80, End: 81}}},
+			"port ranges unavailable: [80-81] wanted, [31000-31010] available"),
+	)
+})
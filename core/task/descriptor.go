@@ -24,7 +24,10 @@
 
 package task
 
-import "github.com/AliceO2Group/Control/core/task/constraint"
+import (
+	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/placement"
+)
 
 /*
 1) Role has a method GenerateTaskConfiguration []Descriptor.
@@ -46,8 +49,17 @@ type Descriptor struct {
 	TaskRole          parentRole
 	TaskClassName     string
 	RoleConstraints   constraint.Constraints
-	//CmdExtraEnv       []string
-	//CmdExtraArguments []string
+	RolePlacement     placement.Policy
+	RoleWants         ResourceWants
+	CmdExtraEnv       []string
+	CmdExtraArguments []string
+	RoleRestart       *RestartPolicy
+
+	// When a failed task is replaced, the new task should preferably run on
+	// the same host and bind the same ports, so that peers need no rewiring.
+	PreferredHostname    string
+	PreferredBindPorts   map[string]uint64
+	PreferredControlPort uint64
 }
 type Descriptors []*Descriptor
 
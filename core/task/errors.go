@@ -70,9 +70,32 @@ func (r GenericTasksError) Error() string {
 	return fmt.Sprintf("tasks [%s] error: %s", strings.Join(r.taskIds, ", "), r.message)
 }
 
-type TasksDeploymentError tasksErrorBase
+type TasksDeploymentError struct {
+	tasksErrorBase
+	offersReceived int
+	explanations   []DescriptorExplanation
+}
 func (r TasksDeploymentError) Error() string {
-	return fmt.Sprintf("deployment failed for tasks [%s]", strings.Join(r.taskIds, ", "))
+	if len(r.explanations) == 0 {
+		return fmt.Sprintf("deployment failed for tasks [%s]", strings.Join(r.taskIds, ", "))
+	}
+	if r.offersReceived == 0 {
+		return fmt.Sprintf("deployment failed for %d roles: no offers received", len(r.explanations))
+	}
+	reasons := make([]string, len(r.explanations))
+	for i, explanation := range r.explanations {
+		reasons[i] = explanation.String()
+	}
+	return fmt.Sprintf("deployment failed for %d roles: %s", len(r.explanations), strings.Join(reasons, " | "))
+}
+
+// Explanations returns, for each descriptor which could not be deployed, the
+// reasons why the offers considered were rejected.
+func (r TasksDeploymentError) Explanations() []DescriptorExplanation {
+	return r.explanations
+}
+func (r TasksDeploymentError) OffersReceived() int {
+	return r.offersReceived
 }
 
 type TaskAlreadyReleasedError taskErrorBase
@@ -0,0 +1,130 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// HostState is the administrative state of a host, set by an operator ahead
+// of a hardware intervention.
+// A host which is not ACTIVE keeps running the tasks it already has, but it is
+// excluded from new deployments and its idle tasks are never reused.
+type HostState int
+const (
+	HOST_ACTIVE HostState = iota
+	HOST_DRAINING
+	HOST_MAINTENANCE
+)
+
+var _hostStateNames = []string{
+	"ACTIVE",
+	"DRAINING",
+	"MAINTENANCE",
+}
+
+func (s HostState) String() string {
+	if s < HOST_ACTIVE || s > HOST_MAINTENANCE {
+		return "ACTIVE"
+	}
+	return _hostStateNames[s]
+}
+
+// HostStateFromString parses a host state name, case insensitive.
+func HostStateFromString(s string) (state HostState, err error) {
+	for i, v := range _hostStateNames {
+		if strings.EqualFold(strings.TrimSpace(s), v) {
+			return HostState(i), nil
+		}
+	}
+	return HOST_ACTIVE, fmt.Errorf("unknown host state %s", s)
+}
+
+// HostStates keeps the host state of every host which is not ACTIVE, keyed by
+// hostname. Persistence is left to the caller.
+type HostStates struct {
+	mu sync.RWMutex
+	store map[string]HostState
+}
+
+func (hs *HostStates) Set(hostname string, state HostState) {
+	if hs == nil {
+		return
+	}
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	if hs.store == nil {
+		hs.store = make(map[string]HostState)
+	}
+	if state == HOST_ACTIVE {
+		delete(hs.store, hostname)
+		return
+	}
+	hs.store[hostname] = state
+}
+
+func (hs *HostStates) Get(hostname string) HostState {
+	if hs == nil {
+		return HOST_ACTIVE
+	}
+	hs.mu.RLock()
+	defer hs.mu.RUnlock()
+
+	state, ok := hs.store[hostname]
+	if !ok {
+		return HOST_ACTIVE
+	}
+	return state
+}
+
+// IsAvailable returns true if new tasks may be deployed on or reused from the
+// given host.
+func (hs *HostStates) IsAvailable(hostname string) bool {
+	return hs.Get(hostname) == HOST_ACTIVE
+}
+
+// Unavailable returns the hostnames of all the hosts which are not ACTIVE,
+// sorted.
+func (hs *HostStates) Unavailable() (hostnames []string) {
+	hostnames = make([]string, 0)
+	if hs == nil {
+		return
+	}
+	hs.mu.RLock()
+	defer hs.mu.RUnlock()
+
+	for hostname := range hs.store {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	return
+}
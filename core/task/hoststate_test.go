@@ -0,0 +1,60 @@
+package task
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("host states", func() {
+	DescribeTable("parsing host state names",
+		func(name string, expected HostState, valid bool) {
+			state, err := HostStateFromString(name)
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+			Expect(state).To(Equal(expected))
+		},
+		Entry("active", "ACTIVE", HOST_ACTIVE, true),
+		Entry("draining, in lower case", "draining", HOST_DRAINING, true),
+		Entry("maintenance, with spaces", " Maintenance ", HOST_MAINTENANCE, true),
+		Entry("an unknown state", "RETIRED", HOST_ACTIVE, false),
+	)
+
+	It("should go through the states an operator sets and back to ACTIVE", func() {
+		var hs HostStates
+		Expect(hs.Get("flp1")).To(Equal(HOST_ACTIVE))
+		Expect(hs.IsAvailable("flp1")).To(BeTrue())
+
+		for _, state := range []HostState{HOST_DRAINING, HOST_MAINTENANCE} {
+			hs.Set("flp1", state)
+			Expect(hs.Get("flp1")).To(Equal(state))
+			Expect(hs.IsAvailable("flp1")).To(BeFalse())
+			Expect(hs.Unavailable()).To(Equal([]string{"flp1"}))
+		}
+
+		hs.Set("flp1", HOST_ACTIVE)
+		Expect(hs.Get("flp1")).To(Equal(HOST_ACTIVE))
+		Expect(hs.IsAvailable("flp1")).To(BeTrue())
+		Expect(hs.Unavailable()).To(BeEmpty())
+	})
+
+	It("should list the unavailable hosts sorted", func() {
+		var hs HostStates
+		hs.Set("flp3", HOST_MAINTENANCE)
+		hs.Set("flp1", HOST_DRAINING)
+		hs.Set("flp2", HOST_ACTIVE)
+
+		Expect(hs.Unavailable()).To(Equal([]string{"flp1", "flp3"}))
+	})
+
+	It("should tell every host active without any state", func() {
+		var hs *HostStates
+		hs.Set("flp1", HOST_MAINTENANCE)
+		Expect(hs.Get("flp1")).To(Equal(HOST_ACTIVE))
+		Expect(hs.Unavailable()).To(BeEmpty())
+		Expect(HostState(42).String()).To(Equal("ACTIVE"))
+	})
+})
@@ -0,0 +1,236 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/AliceO2Group/Control/core/repos"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+// A task class can inherit the definition of another task class by naming it
+// with the extends key, e.g.
+//   name: readout-flp-variant
+//   extends: readout
+// The parent class is resolved like the task classes of a workflow template,
+// i.e. relative to the repository of the child class unless a repository is
+// specified.
+// The child class is deep-merged into its parent: maps are merged key by key,
+// lists of items with a name (such as bind) are merged item by item, and other
+// lists are appended to those of the parent.
+// A key suffixed with the override marker replaces the value inherited from the
+// parent instead of being merged into it, e.g.
+//   command:
+//     arguments!:
+//       - "--only-these"
+const (
+	extendsKey     = "extends"
+	overrideMarker = "!"
+)
+
+type classTree = map[interface{}]interface{}
+
+// classFileLoader reads the file of the task class with the given full
+// identifier, and returns its tree along with the repository it belongs to.
+type classFileLoader func(taskClassIdentifier string) (tree classTree, repo *repos.Repo, err error)
+
+// ResolveTaskClass loads the task class with the given full identifier, along
+// with all the classes it extends.
+// It returns the resolved class, the identifiers of its ancestors (nearest
+// first) and the resolved class definition as YAML.
+func ResolveTaskClass(taskClassIdentifier string) (class *TaskClass, ancestors []string, resolvedYaml []byte, err error) {
+	var (
+		tree classTree
+		repo *repos.Repo
+	)
+	tree, repo, ancestors, err = loadClassTree(taskClassIdentifier, loadClassFile, make(map[string]bool))
+	if err != nil {
+		return
+	}
+
+	resolvedYaml, err = yaml.Marshal(tree)
+	if err != nil {
+		return
+	}
+	class = &TaskClass{}
+	err = yaml.Unmarshal(resolvedYaml, class)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot load resolved task class %s: %s", taskClassIdentifier, err.Error())
+	}
+	class.Identifier.repo = *repo
+	return
+}
+
+// loadClassFile is the classFileLoader which reads task classes from the
+// repositories of the repo manager, and makes sure the repository of the
+// class is present and checked out.
+func loadClassFile(taskClassIdentifier string) (tree classTree, repo *repos.Repo, err error) {
+	err = the.RepoManager().EnsureReposPresent([]string{taskClassIdentifier})
+	if err != nil {
+		return
+	}
+
+	taskClassFile := strings.Split(taskClassIdentifier, "@")[0] + ".yaml"
+	repo, err = repos.NewRepo(strings.Split(taskClassFile, "tasks/")[0])
+	if err != nil {
+		return
+	}
+	repo = the.RepoManager().GetRepos()[repo.GetIdentifier()] //get repo pointer from repomanager
+	if repo == nil {
+		return nil, nil, errors.New("repo not found for task class " + taskClassIdentifier)
+	}
+
+	var yamlData []byte
+	yamlData, err = ioutil.ReadFile(viper.GetString("repositoriesPath") + taskClassFile)
+	if err != nil {
+		return
+	}
+	tree = make(classTree)
+	err = yaml.Unmarshal(yamlData, &tree)
+	if err != nil {
+		return
+	}
+
+	if _, ok := tree["name"]; !ok {
+		tree["name"] = strings.TrimSuffix(path.Base(taskClassFile), ".yaml")
+	}
+	return
+}
+
+// loadClassTree loads the task class for taskClassIdentifier, and merges it
+// into the tree of its parent class, if any.
+func loadClassTree(taskClassIdentifier string, load classFileLoader, visited map[string]bool) (tree classTree, repo *repos.Repo, ancestors []string, err error) {
+	if visited[taskClassIdentifier] {
+		return nil, nil, nil, fmt.Errorf("task class inheritance cycle through %s", taskClassIdentifier)
+	}
+	visited[taskClassIdentifier] = true
+
+	var child classTree
+	child, repo, err = load(taskClassIdentifier)
+	if err != nil {
+		return
+	}
+
+	extends, hasParent := child[extendsKey]
+	delete(child, extendsKey)
+	if !hasParent {
+		tree, _ = mergeClassTrees(nil, child).(classTree)
+		ancestors = make([]string, 0)
+		return
+	}
+
+	parentName, ok := extends.(string)
+	if !ok || len(parentName) == 0 {
+		return nil, nil, nil, fmt.Errorf("invalid %s value in task class %s", extendsKey, taskClassIdentifier)
+	}
+	parentIdentifier := repo.ResolveTaskClassIdentifier(parentName)
+
+	var (
+		parent classTree
+		parentAncestors []string
+	)
+	parent, _, parentAncestors, err = loadClassTree(parentIdentifier, load, visited)
+	if err != nil {
+		return
+	}
+	tree, _ = mergeClassTrees(parent, child).(classTree)
+	ancestors = append([]string{parentIdentifier}, parentAncestors...)
+	return
+}
+
+// mergeClassTrees deep-merges child into parent and returns the result, which
+// shares no maps or lists with its arguments. Override markers are stripped.
+func mergeClassTrees(parent interface{}, child interface{}) interface{} {
+	switch childValue := child.(type) {
+	case classTree:
+		merged := make(classTree)
+		if parentMap, ok := parent.(classTree); ok {
+			for k, v := range parentMap {
+				merged[k] = mergeClassTrees(nil, v)
+			}
+		}
+		for k, v := range childValue {
+			key := fmt.Sprintf("%v", k)
+			if strings.HasSuffix(key, overrideMarker) {
+				merged[strings.TrimSuffix(key, overrideMarker)] = mergeClassTrees(nil, v)
+				continue
+			}
+			if _, ok := merged[key]; ok {
+				merged[key] = mergeClassTrees(merged[key], v)
+			} else {
+				merged[key] = mergeClassTrees(nil, v)
+			}
+		}
+		return merged
+	case []interface{}:
+		parentList, _ := parent.([]interface{})
+		merged := make([]interface{}, 0, len(parentList) + len(childValue))
+		for _, item := range parentList {
+			merged = append(merged, mergeClassTrees(nil, item))
+		}
+		for _, item := range childValue {
+			if name, ok := itemName(item); ok {
+				found := false
+				for i, mergedItem := range merged {
+					if mergedName, ok := itemName(mergedItem); ok && mergedName == name {
+						merged[i] = mergeClassTrees(mergedItem, item)
+						found = true
+						break
+					}
+				}
+				if found {
+					continue
+				}
+			}
+			merged = append(merged, mergeClassTrees(nil, item))
+		}
+		return merged
+	default:
+		return child
+	}
+}
+
+// itemName returns the name of a list item, if it is a map with a name key.
+func itemName(item interface{}) (name string, ok bool) {
+	itemMap, isMap := item.(classTree)
+	if !isMap {
+		return
+	}
+	value, hasName := itemMap["name"]
+	if !hasName {
+		return
+	}
+	return fmt.Sprintf("%v", value), true
+}
@@ -0,0 +1,191 @@
+package task
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/core/repos"
+	"gopkg.in/yaml.v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const inheritanceTestRepo = "github.com/o2/classes"
+
+// fileLoader is a classFileLoader which reads the task classes of a single
+// repository from a map of class names to YAML documents.
+func fileLoader(files map[string]string) classFileLoader {
+	return func(taskClassIdentifier string) (tree classTree, repo *repos.Repo, err error) {
+		repo, err = repos.NewRepo(inheritanceTestRepo)
+		if err != nil {
+			return
+		}
+		for name, doc := range files {
+			if repo.ResolveTaskClassIdentifier(name) == taskClassIdentifier {
+				tree = make(classTree)
+				err = yaml.Unmarshal([]byte(doc), &tree)
+				return
+			}
+		}
+		return nil, nil, fmt.Errorf("no task class %s", taskClassIdentifier)
+	}
+}
+
+func resolveTestClass(files map[string]string, name string) (class *TaskClass, ancestors []string, err error) {
+	var tree classTree
+	tree, _, ancestors, err = loadClassTree(inheritanceTestRepo+"/tasks/"+name+"@master", fileLoader(files), make(map[string]bool))
+	if err != nil {
+		return
+	}
+	resolvedYaml, err := yaml.Marshal(tree)
+	Expect(err).NotTo(HaveOccurred())
+	class = &TaskClass{}
+	Expect(yaml.Unmarshal(resolvedYaml, class)).To(Succeed())
+	return
+}
+
+var _ = Describe("task class inheritance", func() {
+	const readout = `
+name: readout
+command:
+  value: readout.exe
+  env: ["O2_INFOLOGGER_MODE=infoLogger"]
+  arguments: ["--rate", "10"]
+wants:
+  cpu: 1
+  memory: 128
+bind:
+  - name: readout
+    type: push
+    rateLogging: "1"
+properties:
+  severity: info
+  verbosity: low
+`
+
+	It("should merge a class into the class it extends", func() {
+		class, ancestors, err := resolveTestClass(map[string]string{
+			"readout": readout,
+			"readout-flp": `
+name: readout-flp
+extends: readout
+command:
+  arguments: ["--flp"]
+wants:
+  memory: 512
+bind:
+  - name: readout
+    type: pub
+  - name: monitoring
+    type: push
+properties:
+  severity: debug
+`,
+		}, "readout-flp")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ancestors).To(Equal([]string{inheritanceTestRepo + "/tasks/readout@master"}))
+
+		Expect(class.Identifier.Name).To(Equal("readout-flp"))
+		Expect(*class.Command.Value).To(Equal("readout.exe"))
+		// Lists are appended, maps and named items merged
+		Expect(class.Command.Arguments).To(Equal([]string{"--rate", "10", "--flp"}))
+		Expect(*class.Wants.Cpu).To(Equal(1.0))
+		Expect(*class.Wants.Memory).To(Equal(512.0))
+		Expect(class.Bind).To(HaveLen(2))
+		Expect(class.Bind[0].Name).To(Equal("readout"))
+		Expect(class.Bind[0].Type.String()).To(Equal("pub"))
+		Expect(class.Bind[1].Name).To(Equal("monitoring"))
+		Expect(class.Properties).To(HaveKeyWithValue("severity", "debug"))
+		Expect(class.Properties).To(HaveKeyWithValue("verbosity", "low"))
+	})
+
+	It("should replace the inherited values marked with !", func() {
+		class, _, err := resolveTestClass(map[string]string{
+			"readout": readout,
+			"readout-only": `
+name: readout-only
+extends: readout
+command:
+  arguments!: ["--only-these"]
+properties!:
+  severity: error
+`,
+		}, "readout-only")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(class.Command.Arguments).To(Equal([]string{"--only-these"}))
+		Expect(class.Command.Env).To(Equal([]string{"O2_INFOLOGGER_MODE=infoLogger"}))
+		Expect(class.Properties).To(BeEquivalentTo(map[string]string{"severity": "error"}))
+	})
+
+	It("should resolve several levels of inheritance", func() {
+		class, ancestors, err := resolveTestClass(map[string]string{
+			"readout": readout,
+			"readout-flp": `
+name: readout-flp
+extends: readout
+command:
+  arguments: ["--flp"]
+wants:
+  memory: 512
+`,
+			"readout-flp-test": `
+name: readout-flp-test
+extends: readout-flp
+command:
+  arguments: ["--test"]
+wants:
+  cpu: 0.5
+`,
+		}, "readout-flp-test")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ancestors).To(Equal([]string{
+			inheritanceTestRepo + "/tasks/readout-flp@master",
+			inheritanceTestRepo + "/tasks/readout@master",
+		}))
+
+		Expect(class.Identifier.Name).To(Equal("readout-flp-test"))
+		Expect(*class.Command.Value).To(Equal("readout.exe"))
+		Expect(class.Command.Arguments).To(Equal([]string{"--rate", "10", "--flp", "--test"}))
+		Expect(*class.Wants.Cpu).To(Equal(0.5))
+		Expect(*class.Wants.Memory).To(Equal(512.0))
+	})
+
+	It("should detect inheritance cycles", func() {
+		_, _, err := resolveTestClass(map[string]string{
+			"a": "name: a\nextends: b\n",
+			"b": "name: b\nextends: c\n",
+			"c": "name: c\nextends: a\n",
+		}, "a")
+		Expect(err).To(MatchError(ContainSubstring("task class inheritance cycle through " +
+			inheritanceTestRepo + "/tasks/a@master")))
+
+		_, _, err = resolveTestClass(map[string]string{
+			"self": "name: self\nextends: self\n",
+		}, "self")
+		Expect(err).To(MatchError(ContainSubstring("cycle")))
+	})
+
+	It("should reject invalid or missing parents", func() {
+		_, _, err := resolveTestClass(map[string]string{
+			"orphan": "name: orphan\nextends: nobody\n",
+		}, "orphan")
+		Expect(err).To(MatchError(ContainSubstring("no task class")))
+
+		_, _, err = resolveTestClass(map[string]string{
+			"invalid": "name: invalid\nextends: [a, b]\n",
+		}, "invalid")
+		Expect(err).To(MatchError(ContainSubstring("invalid extends value")))
+	})
+
+	It("should leave the trees it merges untouched", func() {
+		parent := classTree{"command": classTree{"arguments": []interface{}{"--rate"}}}
+		child := classTree{"command": classTree{"arguments": []interface{}{"--flp"}}}
+
+		merged := mergeClassTrees(parent, child).(classTree)
+		merged["command"].(classTree)["arguments"] = nil
+
+		Expect(parent["command"].(classTree)["arguments"]).To(Equal([]interface{}{"--rate"}))
+		Expect(child["command"].(classTree)["arguments"]).To(Equal([]interface{}{"--flp"}))
+	})
+})
@@ -28,17 +28,15 @@ import (
 	"errors"
 	"fmt"
 	"github.com/AliceO2Group/Control/common/utils"
-	"github.com/AliceO2Group/Control/core/repos"
-	"github.com/AliceO2Group/Control/core/the"
-	"github.com/spf13/viper"
-	"gopkg.in/yaml.v2"
-	"io/ioutil"
 
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/AliceO2Group/Control/core/task/placement"
+	"github.com/gogo/protobuf/proto"
 	"github.com/k0kubun/pp"
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/pborman/uuid"
@@ -47,12 +45,25 @@ import (
 
 type KillTaskFunc func(*Task) error
 
+// TaskFailedFunc is called when a task which belongs to an environment fails,
+// see IsFailure.
+type TaskFailedFunc func(envId uuid.Array, task *Task, status mesos.TaskStatus)
+
+// ExecutorReuseFunc tells whether an idle task which runs in the given
+// executor may be acquired for the role of descriptor.
+type ExecutorReuseFunc func(executorId string, descriptor *Descriptor) bool
+
 type Manager struct {
 	AgentCache         AgentCache
+	HostStates         HostStates
+	PortPools          PortPools
 
 	mu                 sync.RWMutex
 	classes            map[string]*TaskClass
 	roster             Tasks
+	occupancy          *placement.Occupancy // of the deployment in progress, if any
+	ports              *PortAllocator       // of the deployment in progress, if any
+	report             *DeploymentReport    // of the deployment in progress, if any
 
 	resourceOffersDone <-chan DeploymentMap
 	tasksToDeploy      chan<- Descriptors
@@ -60,6 +71,8 @@ type Manager struct {
 	cq                 *controlcommands.CommandQueue
 
 	doKillTask         KillTaskFunc
+	onTaskFailed       TaskFailedFunc
+	canReuseExecutor   ExecutorReuseFunc
 }
 
 func NewManager(resourceOffersDone <-chan DeploymentMap,
@@ -98,12 +111,21 @@ func (m*Manager) NewTaskForMesosOffer(offer *mesos.Offer, descriptor *Descriptor
 		executorId:   executorId.Value,
 		GetTaskClass: nil,
 		bindPorts:    nil,
+		roleWants:    descriptor.RoleWants,
 		state:        STANDBY,
 		status:       INACTIVE,
 	}
+	t.cmdExtraEnv = append([]string{}, descriptor.CmdExtraEnv...)
+	t.cmdExtraArguments = append([]string{}, descriptor.CmdExtraArguments...)
+	t.placement = m.getPlacementForDescriptor(descriptor)
+	t.restartPolicy = m.getRestartPolicyForDescriptor(descriptor)
 	t.GetTaskClass = func() *TaskClass {
 		return m.GetTaskClass(t.className)
 	}
+	if class := m.GetTaskClass(t.className); class != nil {
+		deployedClass := *class
+		t.deployedClass = &deployedClass
+	}
 	t.bindPorts = make(map[string]uint64)
 	for k, v := range bindPorts {
 		t.bindPorts[k] = v
@@ -111,37 +133,18 @@ func (m*Manager) NewTaskForMesosOffer(offer *mesos.Offer, descriptor *Descriptor
 	return
 }
 
+// getTaskClassList loads the required task classes, with the classes they
+// extend merged in, see ResolveTaskClass.
 func getTaskClassList(taskClassesRequired []string) (taskClassList []*TaskClass, err error) {
-	repoManager := the.RepoManager()
-	var yamlData []byte
-
 	taskClassList = make([]*TaskClass, 0)
 
 	for _, taskClass := range taskClassesRequired {
-		taskClassString := strings.Split(taskClass, "@")
-		taskClassFile := taskClassString[0] + ".yaml"
-		var repo *repos.Repo
-		repo, err = repos.NewRepo(strings.Split(taskClassFile, "tasks/")[0])
-		if err != nil {
-			return
-		}
-		repo = repoManager.GetRepos()[repo.GetIdentifier()] //get repo pointer from repomanager
-	 	if repo == nil { //should never end up here
-			return nil, errors.New("getTaskClassList: repo not found for " + taskClass)
-		}
-
-		yamlData, err = ioutil.ReadFile(viper.GetString("repositoriesPath") + taskClassFile)
+		var taskClassStruct *TaskClass
+		taskClassStruct, _, _, err = ResolveTaskClass(taskClass)
 		if err != nil {
 			return nil, err
 		}
-		taskClassStruct := TaskClass{}
-		err = yaml.Unmarshal(yamlData, &taskClassStruct)
-		if err != nil {
-			return nil, err
-		}
-
-		taskClassStruct.Identifier.repo = *repo
-		taskClassList = append(taskClassList, &taskClassStruct)
+		taskClassList = append(taskClassList, taskClassStruct)
 	}
 	return taskClassList, nil
 }
@@ -197,6 +200,22 @@ func (m *Manager) RefreshClasses(taskClassesRequired []string) (err error) {
 	return
 }
 
+// AddClasses adds task classes which do not come from a configuration
+// repository, such as those built in place by tests, or updates them.
+func (m *Manager) AddClasses(classes ...*TaskClass) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, class := range classes {
+		taskClassIdentifier := class.Identifier.String()
+		if _, ok := m.classes[taskClassIdentifier]; ok {
+			*m.classes[taskClassIdentifier] = *class
+		} else {
+			m.classes[taskClassIdentifier] = class
+		}
+	}
+}
+
 func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -206,21 +225,40 @@ func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (e
 	1) check if any tasks are already in Roster, whether they are already locked
 	   in an environment, and whether their host has attributes that satisfy the
 	   constraints
-	  1a) TODO: for each of them in Roster with matching attributes but wrong class,
-	      mark for teardown and mesos-deployment
+	  1a) for each of them in Roster whose class definition changed since they
+	      were deployed, mark for teardown and mesos-deployment; idle tasks of
+	      other classes are left running, since later environments may need them
 	  1b) for each of them in Roster with matching attributes and class, mark for
 	      takeover and reconfiguration
-	3) TODO: teardown the tasks in tasksToTeardown
+	3) teardown the tasks in tasksToTeardown
 	4) start the tasks in tasksToRun
 	5) ensure that all of them reach a CONFIGURED state
 	*/
 
 	tasksToRun := make(Descriptors, 0)
-	// TODO: also filter for tasks that satisfy attributes but are of wrong class,
-	// to be torn down and mesosed.
-	// tasksToTeardown := make([]TaskPtr, 0)
+	// Idle tasks which run an outdated version of their class are never taken
+	// over, instead we kill them so that they are replaced by new tasks.
+	tasksToTeardown := m.roster.Filtered(func(taskPtr *Task) bool {
+		return !taskPtr.IsLocked() && m.isStale(taskPtr)
+	})
 	tasksAlreadyRunning := make(DeploymentMap)
+
+	// The occupancy tracks where the tasks of this environment are, so that
+	// placement policies are honored both by the tasks we claim here and by
+	// those the scheduler deploys afterwards.
+	m.occupancy = m.buildOccupancy(envId)
+	// Likewise the port allocator knows the ports held by all the tasks in the
+	// roster, so that new tasks never get a port already in use on their host.
+	m.ports = NewPortAllocator(m.PortPools, m.roster)
+	defer func() {
+		m.occupancy = nil
+		m.ports = nil
+	}()
+	descriptorPlacements := m.BuildDescriptorPlacements(taskDescriptors)
+
 	for _, descriptor := range taskDescriptors {
+		policy := descriptorPlacements[descriptor]
+		rolePath := descriptor.TaskRole.GetPath()
 		/*
 		For each descriptor we check m.AgentCache for agent attributes:
 		this allows us for each idle task in roster, get agentid and plug it in cache to get the
@@ -233,16 +271,27 @@ func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (e
 		// a) it's !Locked
 		// b) has className matching Descriptor
 		// c) its Agent's Attributes satisfy the Descriptor's Constraints
+		// d) its host is not drained or under maintenance
+		// e) it's in STANDBY, which isn't a given for tasks rebuilt through
+		//    reconciliation
+		// f) it was deployed with the same role overrides (wants, extra env
+		//    vars and arguments) as the Descriptor's, so it runs the same
+		//    command with the same resources
+		// g) its executor may run the tasks of the Descriptor's environment
 		taskMatches := func(taskPtr *Task) (ok bool) {
 			if taskPtr != nil {
-				if !taskPtr.IsLocked() && taskPtr.className == descriptor.TaskClassName {
+				if !taskPtr.IsLocked() && taskPtr.className == descriptor.TaskClassName &&
+					taskPtr.state == STANDBY && !m.isStale(taskPtr) &&
+					taskPtr.matchesOverrides(descriptor) &&
+					m.HostStates.IsAvailable(taskPtr.hostname) &&
+					(m.canReuseExecutor == nil || m.canReuseExecutor(taskPtr.executorId, descriptor)) {
 					agentInfo := m.AgentCache.Get(mesos.AgentID{Value: taskPtr.agentId})
 					taskClass, classFound := m.classes[descriptor.TaskClassName]
 					if classFound && taskClass != nil && agentInfo != nil {
 						targetConstraints := descriptor.
 							RoleConstraints.MergeParent(taskClass.Constraints)
 						if agentInfo.Attributes.Satisfy(targetConstraints) {
-							ok = true
+							ok, _ = m.occupancy.Allows(taskPtr.hostname, rolePath, policy)
 							return
 						}
 					}
@@ -251,6 +300,13 @@ func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (e
 			return
 		}
 		runningTasksForThisDescriptor := m.roster.Filtered(taskMatches)
+		if policy.Strategy == placement.Pack {
+			// Prefer the hosts which already run the most tasks of the group
+			sort.SliceStable(runningTasksForThisDescriptor, func(i, j int) bool {
+				return m.occupancy.GroupCount(runningTasksForThisDescriptor[i].hostname, policy.Group) >
+					m.occupancy.GroupCount(runningTasksForThisDescriptor[j].hostname, policy.Group)
+			})
+		}
 		claimed := false
 		if len(runningTasksForThisDescriptor) > 0 {
 			// We have received a list of running, unlocked candidates to take over
@@ -262,6 +318,7 @@ func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (e
 					continue
 				} else { // task not claimed yet, we do so now
 					tasksAlreadyRunning[taskPtr] = descriptor
+					m.occupancy.Add(taskPtr.hostname, rolePath, policy)
 					claimed = true
 					break
 				}
@@ -273,13 +330,18 @@ func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (e
 		}
 	}
 
-	// TODO: fill out tasksToTeardown in the previous loop
-	//if len(tasksToTeardown) > 0 {
-	//	err = m.TeardownTasks(tasksToTeardown)
-	//	if err != nil {
-	//		return errors.New(fmt.Sprintf("cannot restart roles: %s", err.Error()))
-	//	}
-	//}
+	if len(tasksToTeardown) > 0 {
+		// The executor brings each task down through its state machine before
+		// it exits, and the freed resources come back to us with the next offers.
+		var killed Tasks
+		killed, _, err = m.doKillTasks(tasksToTeardown)
+		log.WithField("count", len(killed)).
+			Info("outdated tasks torn down")
+		if err != nil {
+			log.WithError(err).Warning("cannot tear down some outdated tasks")
+			err = nil
+		}
+	}
 
 	// At this point, all descriptors are either
 	// - matched to a TaskPtr in tasksAlreadyRunning
@@ -296,12 +358,16 @@ func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (e
 		m.reviveOffersTrg <- struct{}{} // signal scheduler to revive offers
 		<- m.reviveOffersTrg            // we only continue when it's done
 
+		m.report = NewDeploymentReport()
+		defer func() {
+			m.report = nil
+		}()
 		m.tasksToDeploy <- tasksToRun // blocks until received
 		log.WithField("environmentId", envId).
 			Debug("scheduler should have received request to deploy")
 
-		// IDEA: a flps mesos-role assigned to all mesos agents on flp hosts, and then a static
-		//       reservation for that mesos-role on behalf of our scheduler
+		// With mesosReservedRole, e.g. a flp Mesos role with static reservations on all
+		// FLP hosts, the scheduler only deploys on resources reserved for that role.
 
 		deployedTasks = <- m.resourceOffersDone
 		log.WithField("tasks", deployedTasks).
@@ -336,7 +402,25 @@ func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (e
 			taskPtr.parent = nil
 			deployedTaskIds = append(deployedTaskIds, taskPtr.taskId)
 		}
-		err = TasksDeploymentError{taskIds: deployedTaskIds}
+		undeployed := make(Descriptors, 0)
+		for _, descriptor := range tasksToRun {
+			found := false
+			for _, deployedDescriptor := range deployedTasks {
+				if deployedDescriptor == descriptor {
+					found = true
+					break
+				}
+			}
+			if !found {
+				undeployed = append(undeployed, descriptor)
+			}
+		}
+		offersReceived, explanations := m.report.explain(undeployed)
+		err = TasksDeploymentError{
+			tasksErrorBase: tasksErrorBase{taskIds: deployedTaskIds},
+			offersReceived: offersReceived,
+			explanations:   explanations,
+		}
 	}
 
 	// Finally, we write to the roster. Point of no return!
@@ -347,6 +431,8 @@ func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (e
 	if deploymentSuccess {
 		for taskPtr, descriptor := range tasksAlreadyRunning {
 			taskPtr.parent = descriptor.TaskRole
+			taskPtr.placement = descriptorPlacements[descriptor]
+			taskPtr.restartPolicy = m.getRestartPolicyForDescriptor(descriptor)
 			taskPtr.parent.SetTask(taskPtr)
 		}
 	}
@@ -386,17 +472,18 @@ func (m *Manager) releaseTask(envId uuid.Array, task *Task) error {
 	return nil
 }
 
-func (m *Manager) ConfigureTasks(envId uuid.Array, tasks Tasks) error {
-	notify := make(chan controlcommands.MesosCommandResponse)
-	receivers, err := tasks.GetMesosCommandTargets()
-	if err != nil {
-		return err
-	}
+func (m *Manager) ConfigureTasks(envId uuid.Array, stages Stages) error {
+	tasks := stages.Flatten()
 
 	m.mu.RLock()
-	// We generate a "bindMap" i.e. a map of the paths of registered inbound channels and their ports
+	// We generate a "bindMap" i.e. a map of the paths of registered inbound channels and their ports.
+	// We include all the tasks of the environment, and not only those we're about to configure,
+	// so that tasks added to an already configured environment can connect to the existing ones.
+	envTasks := m.roster.Filtered(func(t *Task) bool {
+		return t.IsLocked() && t.GetEnvironmentId() == envId
+	})
 	bindMap := make(channel.BindMap)
-	for _, task := range tasks {
+	for _, task := range envTasks {
 		taskPath := task.parent.GetPath()
 		for inbChName, port := range task.GetBindPorts() {
 			bindMap[taskPath + ":" + inbChName] = channel.Endpoint{Host: task.GetHostname(), Port: port}
@@ -408,8 +495,7 @@ func (m *Manager) ConfigureTasks(envId uuid.Array, tasks Tasks) error {
 	src := STANDBY.String()
 	event := "CONFIGURE"
 	dest := CONFIGURED.String()
-	args := make(controlcommands.PropertyMapsMap)
-	args, err = tasks.BuildPropertyMaps(bindMap)
+	args, err := tasks.BuildPropertyMaps(bindMap)
 	if err != nil {
 		m.mu.RUnlock()
 		return err
@@ -417,27 +503,67 @@ func (m *Manager) ConfigureTasks(envId uuid.Array, tasks Tasks) error {
 	log.WithField("map", pp.Sprint(args)).Debug("pushing configuration to tasks")
 	m.mu.RUnlock()
 
-	cmd := controlcommands.NewMesosCommand_Transition(receivers, src, event, dest, args)
-	m.cq.Enqueue(cmd, notify)
-
-	response := <- notify
-	close(notify)
+	// The bindMap and property maps are built for the whole environment, but
+	// we only commit one stage at a time, each stage with its own arguments.
+	for i, stage := range stages {
+		if len(stage) == 0 {
+			continue
+		}
+		stageArgs := make(controlcommands.PropertyMapsMap)
+		for _, task := range stage {
+			receiver := task.GetMesosCommandTarget()
+			stageArgs[receiver] = args[receiver]
+		}
+		log.WithFields(logrus.Fields{"stage": i, "tasks": len(stage), "envId": envId.String()}).
+			Debug("configuring stage")
 
-	if response == nil {
-		return errors.New("nil response")
+		err = m.transitionStage(stage, src, event, dest, stageArgs)
+		if err != nil {
+			return err
+		}
 	}
 
-	errText := response.Err().Error()
-	if len(strings.TrimSpace(errText)) != 0 {
-		return errors.New(response.Err().Error())
-	}
+	return nil
+}
 
-	// FIXME: improve error handling ↑
+func (m *Manager) TransitionTasks(tasks Tasks, src string, event string, dest string, commonArgs controlcommands.PropertyMap) error {
+	return m.TransitionStages(Stages{tasks}, src, event, dest, commonArgs)
+}
 
+// TransitionStages commits a transition on each stage of tasks in turn, and
+// only moves on to the next stage once the previous one has completed.
+func (m *Manager) TransitionStages(stages Stages, src string, event string, dest string, commonArgs controlcommands.PropertyMap) error {
+	for i, stage := range stages {
+		if len(stage) == 0 {
+			continue
+		}
+		args := make(controlcommands.PropertyMapsMap)
+
+		// If we're pushing some arg values to all targets...
+		if len(commonArgs) > 0 {
+			for _, task := range stage {
+				rec := task.GetMesosCommandTarget()
+				args[rec] = make(controlcommands.PropertyMap)
+				for k, v := range commonArgs {
+					args[rec][k] = v
+				}
+			}
+		}
+
+		if len(stages) > 1 {
+			log.WithFields(logrus.Fields{"stage": i, "tasks": len(stage), "event": event}).
+				Debug("transitioning stage")
+		}
+
+		err := m.transitionStage(stage, src, event, dest, args)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (m *Manager) TransitionTasks(tasks Tasks, src string, event string, dest string, commonArgs controlcommands.PropertyMap) error {
+func (m *Manager) transitionStage(tasks Tasks, src string, event string, dest string, args controlcommands.PropertyMapsMap) error {
 	notify := make(chan controlcommands.MesosCommandResponse)
 	receivers, err := tasks.GetMesosCommandTargets()
 
@@ -445,24 +571,16 @@ func (m *Manager) TransitionTasks(tasks Tasks, src string, event string, dest st
 		return err
 	}
 
-	args := make(controlcommands.PropertyMapsMap)
-
-	// If we're pushing some arg values to all targets...
-	if len(commonArgs) > 0 {
-		for _, rec := range receivers {
-			args[rec] = make(controlcommands.PropertyMap)
-			for k, v := range commonArgs {
-				args[rec][k] = v
-			}
-		}
-	}
-
 	cmd := controlcommands.NewMesosCommand_Transition(receivers, src, event, dest, args)
 	m.cq.Enqueue(cmd, notify)
 
 	response := <- notify
 	close(notify)
 
+	if response == nil {
+		return errors.New("nil response")
+	}
+
 	errText := response.Err().Error()
 	if len(strings.TrimSpace(errText)) != 0 {
 		return errors.New(response.Err().Error())
@@ -473,6 +591,19 @@ func (m *Manager) TransitionTasks(tasks Tasks, src string, event string, dest st
 	return nil
 }
 
+// isStale tells whether the class definition of a task changed since the
+// task was deployed, e.g. after a repository refresh.
+func (m *Manager) isStale(t *Task) bool {
+	if t == nil || t.deployedClass == nil {
+		return false
+	}
+	current := m.GetTaskClass(t.className)
+	if current == nil {
+		return false
+	}
+	return !(*info)(t.deployedClass).Equals((*info)(current))
+}
+
 func (m *Manager) GetTaskClass(name string) (b *TaskClass) {
 	if m == nil {
 		return
@@ -495,10 +626,25 @@ func (m *Manager) GetTasks() Tasks {
 	return m.roster
 }
 
+// CopyTasks returns a copy of the roster, taken under the lock of the manager,
+// for callers which may run while a deployment changes it.
+func (m *Manager) CopyTasks() Tasks {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return append(Tasks{}, m.roster...)
+}
+
 func (m *Manager) GetTask(id string) *Task {
 	if m == nil {
 		return nil
 	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
 	for _, t := range m.roster {
 		if t.taskId == id {
 			return t
@@ -526,9 +672,47 @@ func (m *Manager) UpdateTaskState(taskId string, state string) {
 }
 
 func (m *Manager) UpdateTaskStatus(status *mesos.TaskStatus) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.updateTaskStatus(status)
+}
 
+// AgentLost handles the loss of a Mesos agent, e.g. because it was removed or
+// became unreachable. The running tasks of an environment on that agent are
+// marked as lost, as if Mesos had sent TASK_LOST for them, so that their roles
+// and environments are notified and the tasks can be replaced. Idle tasks on
+// that agent are dropped from the roster.
+func (m *Manager) AgentLost(agentId mesos.AgentID) (lost Tasks) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lost = m.roster.Filtered(func(t *Task) bool {
+		return t.agentId == agentId.Value
+	})
+	for _, taskPtr := range lost {
+		if !taskPtr.IsLocked() || taskPtr.status != ACTIVE {
+			// Mesos already told us, or the task will be dropped below
+			continue
+		}
+		executorId := mesos.ExecutorID{Value: taskPtr.executorId}
+		m.updateTaskStatus(&mesos.TaskStatus{
+			TaskID:     mesos.TaskID{Value: taskPtr.taskId},
+			State:      mesos.TASK_LOST.Enum(),
+			Reason:     mesos.REASON_AGENT_REMOVED.Enum(),
+			Source:     mesos.SOURCE_MASTER.Enum(),
+			AgentID:    &agentId,
+			ExecutorID: &executorId,
+			Message:    proto.String("agent lost"),
+		})
+	}
+	m.roster = m.roster.Filtered(func(t *Task) bool {
+		return t.agentId != agentId.Value || t.IsLocked()
+	})
+	return
+}
+
+func (m *Manager) updateTaskStatus(status *mesos.TaskStatus) {
 	taskId := status.GetTaskID().Value
 	taskPtr := m.roster.GetByTaskId(taskId)
 	if taskPtr == nil {
@@ -546,12 +730,82 @@ func (m *Manager) UpdateTaskStatus(status *mesos.TaskStatus) {
 		if taskPtr.parent != nil {
 			taskPtr.parent.UpdateStatus(ACTIVE)
 		}
-	case mesos.TASK_DROPPED, mesos.TASK_LOST, mesos.TASK_KILLED, mesos.TASK_FAILED, mesos.TASK_ERROR:
+	case mesos.TASK_DROPPED, mesos.TASK_LOST, mesos.TASK_KILLED, mesos.TASK_FAILED, mesos.TASK_ERROR,
+		mesos.TASK_GONE, mesos.TASK_GONE_BY_OPERATOR, mesos.TASK_UNREACHABLE:
 		taskPtr.status = INACTIVE
 		if taskPtr.parent != nil {
 			taskPtr.parent.UpdateStatus(INACTIVE)
 		}
 	}
+
+	// A lost task took its state machine with it
+	if IsLost(status) {
+		log.WithFields(logrus.Fields{
+				"taskId": taskId,
+				"name": taskPtr.GetName(),
+				"hostname": taskPtr.hostname,
+				"reason": status.GetReason().String(),
+			}).
+			Warning("task lost")
+		taskPtr.state = ERROR
+		if taskPtr.parent != nil {
+			taskPtr.parent.UpdateState(ERROR)
+		}
+	}
+
+	// An idle task which is gone has nothing left to be reused or cleaned up
+	if !taskPtr.IsLocked() && (IsFailure(status.GetState()) || status.GetState() == mesos.TASK_FINISHED) {
+		m.roster = m.roster.Filtered(func(t *Task) bool {
+			return t.taskId != taskId
+		})
+		return
+	}
+
+	if IsFailure(status.GetState()) && taskPtr.IsLocked() && m.onTaskFailed != nil {
+		// The handler may deploy and transition tasks, so it must not run
+		// while we hold the lock.
+		go m.onTaskFailed(taskPtr.GetEnvironmentId(), taskPtr, *status)
+	}
+}
+
+// SetTaskFailedHandler sets the function to call when a task which belongs to
+// an environment fails, e.g. to restart it.
+func (m *Manager) SetTaskFailedHandler(handler TaskFailedFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onTaskFailed = handler
+}
+
+// SetExecutorReuseFilter sets the function which tells whether the executor of
+// an idle task allows acquiring it for a descriptor. By default any does.
+func (m *Manager) SetExecutorReuseFilter(filter ExecutorReuseFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.canReuseExecutor = filter
+}
+
+// DetachTask removes a dead task of an environment from its role and from the
+// roster, so that a new task can be acquired for the role.
+func (m *Manager) DetachTask(envId uuid.Array, task *Task) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if task == nil || m.roster.GetByTaskId(task.taskId) == nil {
+		return TaskNotFoundError{}
+	}
+	if !task.IsLocked() || task.GetEnvironmentId() != envId {
+		return TaskLockedError{taskErrorBase: taskErrorBase{taskId: task.name}, envId: envId}
+	}
+	if task.status == ACTIVE {
+		return fmt.Errorf("task %s is still active", task.name)
+	}
+
+	task.parent.SetTask(nil)
+	task.parent = nil
+	m.roster = m.roster.Filtered(func(t *Task) bool {
+		return t.taskId != task.taskId
+	})
+	return nil
 }
 
 // Kill all tasks outside an environment (all unlocked tasks)
@@ -0,0 +1,109 @@
+package task
+
+import (
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"gopkg.in/yaml.v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+const staleTestClass = `
+name: readout
+control:
+  mode: direct
+command:
+  value: readout.exe
+  arguments: ["--rate", "10"]
+wants:
+  cpu: 1
+  memory: 128
+  ports: "5000"
+bind:
+  - name: readout
+    type: push
+`
+
+func float64Ptr(f float64) *float64 { return &f }
+
+var _ = Describe("Manager", func() {
+	DescribeTable("telling stale tasks",
+		func(update func(class *TaskClass), expected bool) {
+			deployed := &TaskClass{}
+			Expect(yaml.Unmarshal([]byte(staleTestClass), deployed)).To(Succeed())
+			current := &TaskClass{}
+			Expect(yaml.Unmarshal([]byte(staleTestClass), current)).To(Succeed())
+			if update != nil {
+				update(current)
+			}
+			m := &Manager{classes: map[string]*TaskClass{"readout": current}}
+			t := &Task{className: "readout", deployedClass: deployed}
+
+			Expect(m.isStale(t)).To(Equal(expected))
+		},
+		Entry("an unchanged class", nil, false),
+		Entry("a changed command", func(class *TaskClass) {
+			class.Command.Arguments = []string{"--rate", "20"}
+		}, true),
+		Entry("a removed command", func(class *TaskClass) {
+			class.Command = nil
+		}, true),
+		Entry("a new container", func(class *TaskClass) {
+			class.Container = &common.ContainerInfo{Image: "readout:1.0"}
+		}, true),
+		Entry("a changed control mode", func(class *TaskClass) {
+			class.Control.Mode = controlmode.FAIRMQ
+		}, true),
+		Entry("more CPUs", func(class *TaskClass) {
+			class.Wants.Cpu = float64Ptr(2)
+		}, true),
+		Entry("no memory wanted", func(class *TaskClass) {
+			class.Wants.Memory = nil
+		}, true),
+		Entry("other static ports", func(class *TaskClass) {
+			class.Wants.Ports = Ranges{{Begin: 5001, End: 5001}}
+		}, true),
+		Entry("a changed bind", func(class *TaskClass) {
+			class.Bind[0].SndBufSize = 2000
+		}, true),
+		Entry("another bound channel", func(class *TaskClass) {
+			class.Bind = append(class.Bind, channel.Inbound{})
+		}, true),
+		Entry("changed properties only", func(class *TaskClass) {
+			class.Properties = map[string]string{"severity": "debug"}
+		}, false),
+	)
+
+	It("should not tell stale the tasks whose class it does not know", func() {
+		deployed := &TaskClass{}
+		Expect(yaml.Unmarshal([]byte(staleTestClass), deployed)).To(Succeed())
+		m := &Manager{classes: map[string]*TaskClass{}}
+
+		Expect(m.isStale(nil)).To(BeFalse())
+		Expect(m.isStale(&Task{className: "readout"})).To(BeFalse())
+		Expect(m.isStale(&Task{className: "readout", deployedClass: deployed})).To(BeFalse())
+	})
+
+	It("should not tell stale the tasks of classes without a command", func() {
+		deployed := &TaskClass{}
+		current := &TaskClass{}
+		m := &Manager{classes: map[string]*TaskClass{"empty": current}}
+
+		Expect(m.isStale(&Task{className: "empty", deployedClass: deployed})).To(BeFalse())
+	})
+
+	DescribeTable("comparing optional quantities",
+		func(a *float64, b *float64, expected bool) {
+			Expect(equalFloatPtrs(a, b)).To(Equal(expected))
+			Expect(equalFloatPtrs(b, a)).To(Equal(expected))
+		},
+		Entry("both unset", nil, nil, true),
+		Entry("one unset", float64Ptr(1), nil, false),
+		Entry("equal", float64Ptr(1.5), float64Ptr(1.5), true),
+		Entry("different", float64Ptr(1), float64Ptr(2), false),
+		Entry("zero and unset", float64Ptr(0), nil, false),
+	)
+})
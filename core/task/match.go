@@ -25,10 +25,15 @@
 package task
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/AliceO2Group/Control/core/task/constraint"
 	"github.com/mesos/mesos-go/api/v1/lib/resources"
 	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/AliceO2Group/Control/core/task/placement"
+	"github.com/pborman/uuid"
 )
 
 type Wants struct {
@@ -36,6 +41,7 @@ type Wants struct {
 	Memory      float64
 	StaticPorts Ranges
 	BindPorts   []channel.Inbound
+	Custom      CustomResources
 }
 
 func (m *Manager) GetWantsForDescriptor(descriptor *Descriptor) (r *Wants) {
@@ -57,6 +63,21 @@ func (m *Manager) GetWantsForDescriptor(descriptor *Descriptor) (r *Wants) {
 			r.BindPorts = make([]channel.Inbound, len(taskClass.Bind))
 			copy(r.BindPorts, taskClass.Bind)
 		}
+		r.Custom = wants.Custom.MergedWith(nil)
+
+		// Wants declared by the workflow role take precedence over the task class
+		roleWants := descriptor.RoleWants
+		if roleWants.Cpu != nil {
+			r.Cpu = *roleWants.Cpu
+		}
+		if roleWants.Memory != nil {
+			r.Memory = *roleWants.Memory
+		}
+		if roleWants.Ports != nil {
+			r.StaticPorts = make(Ranges, len(roleWants.Ports))
+			copy(r.StaticPorts, roleWants.Ports)
+		}
+		r.Custom = r.Custom.MergedWith(roleWants.Custom)
 	}
 	return
 }
@@ -64,17 +85,23 @@ func (m *Manager) GetWantsForDescriptor(descriptor *Descriptor) (r *Wants) {
 type Resources mesos.Resources
 
 func (r Resources) Satisfy(wants *Wants) (bool) {
+	return len(r.Unsatisfied(wants)) == 0
+}
+
+// Unsatisfied returns the reason why the resources cannot satisfy wants, or an
+// empty string if they can.
+func (r Resources) Unsatisfied(wants *Wants) (reason string) {
 	availCpu, ok := resources.CPUs(r...)
 	if !ok || wants.Cpu > availCpu {
-		return false
+		return fmt.Sprintf("insufficient CPU: %g wanted, %g available", wants.Cpu, availCpu)
 	}
 	availMem, ok := resources.Memory(r...)
 	if !ok || wants.Memory > float64(availMem) {
-		return false
+		return fmt.Sprintf("insufficient memory: %g MB wanted, %d MB available", wants.Memory, availMem)
 	}
 	availPorts, ok := resources.Ports(r...)
 	if !ok {
-		return false
+		return "no ports available"
 	}
 
 	wantsStaticBuilder := resources.BuildRanges()
@@ -83,17 +110,31 @@ func (r Resources) Satisfy(wants *Wants) (bool) {
 	}
 	wantsStaticRanges := wantsStaticBuilder.Ranges.Sort().Squash()
 	if wantsStaticRanges.Compare(availPorts) != -1 { // if wantsStaticRanges is NOT a subset of ports
-		return false
+		return fmt.Sprintf("port ranges unavailable: %s wanted, %s available", formatRanges(wantsStaticRanges), formatRanges(availPorts))
 	}
 
 	wantsBindCount := len(wants.BindPorts)
 	// if total ports minus what we use for static ranges is LESS than the number of dynamic ports we'll need...
 	if availPorts.Size() - wantsStaticRanges.Size() < uint64(wantsBindCount) {
-		return false
+		return fmt.Sprintf("insufficient ports: %d dynamic ports wanted, %d available", wantsBindCount, availPorts.Size() - wantsStaticRanges.Size())
+	}
+
+	for _, cr := range wants.Custom {
+		if reason := cr.unsatisfied(r); len(reason) > 0 {
+			return reason
+		}
 	}
 
 	// good job surviving til here, a winrar is you
-	return true
+	return ""
+}
+
+func formatRanges(rs mesos.Ranges) string {
+	strs := make([]string, len(rs))
+	for i, rng := range rs {
+		strs[i] = fmt.Sprintf("%d-%d", rng.Begin, rng.End)
+	}
+	return "[" + strings.Join(strs, ",") + "]"
 }
 
 func (m *Manager) BuildDescriptorConstraints(descriptors Descriptors) (cm map[*Descriptor]constraint.Constraints) {
@@ -109,6 +150,89 @@ func (m *Manager) BuildDescriptorConstraints(descriptors Descriptors) (cm map[*D
 	return
 }
 
+// BuildDescriptorPlacements returns the placement policy of each descriptor,
+// i.e. the policy of its role merged over the policy of its task class.
+func (m *Manager) BuildDescriptorPlacements(descriptors Descriptors) (pm map[*Descriptor]placement.Policy) {
+	pm = make(map[*Descriptor]placement.Policy)
+	for _, descriptor := range descriptors {
+		pm[descriptor] = m.getPlacementForDescriptor(descriptor)
+	}
+	return
+}
+
+// getRestartPolicyForDescriptor returns the restart policy of the role if it
+// has one, otherwise the one of the task class.
+func (m *Manager) getRestartPolicyForDescriptor(descriptor *Descriptor) RestartPolicy {
+	if descriptor.RoleRestart != nil {
+		return *descriptor.RoleRestart
+	}
+	taskClass, ok := m.classes[descriptor.TaskClassName]
+	if !ok || taskClass == nil || taskClass.Restart == nil {
+		return RestartPolicy{}
+	}
+	return *taskClass.Restart
+}
+
+func (m *Manager) getPlacementForDescriptor(descriptor *Descriptor) placement.Policy {
+	taskClass, ok := m.classes[descriptor.TaskClassName]
+	if !ok || taskClass == nil {
+		return descriptor.RolePlacement
+	}
+	classPlacement := taskClass.Placement
+	if classPlacement.Strategy != placement.Default {
+		// A strategy declared by a task class groups all the tasks of that class
+		classPlacement.Group = descriptor.TaskClassName
+	}
+	return descriptor.RolePlacement.MergeParent(classPlacement)
+}
+
+// buildOccupancy returns the placement occupancy of the hosts by the tasks
+// already running in an environment.
+func (m *Manager) buildOccupancy(envId uuid.Array) (occupancy *placement.Occupancy) {
+	occupancy = placement.NewOccupancy()
+	for _, taskPtr := range m.roster {
+		if taskPtr.IsLocked() && taskPtr.GetEnvironmentId() == envId {
+			occupancy.Add(taskPtr.hostname, taskPtr.parent.GetPath(), taskPtr.placement)
+		}
+	}
+	return
+}
+
+// DeploymentOccupancy returns the placement occupancy for the deployment in
+// progress, i.e. the tasks of the environment being deployed, including the
+// running tasks claimed by AcquireTasks.
+// The scheduler must record on it the new tasks as it places them.
+func (m *Manager) DeploymentOccupancy() *placement.Occupancy {
+	if m == nil || m.occupancy == nil {
+		return placement.NewOccupancy()
+	}
+	return m.occupancy
+}
+
+// DeploymentPorts returns the port allocator of the deployment in progress.
+// Like DeploymentOccupancy, it is only meant to be used while AcquireTasks
+// waits on the scheduler.
+func (m *Manager) DeploymentPorts() *PortAllocator {
+	if m == nil || m.ports == nil {
+		var pools PortPools
+		if m != nil {
+			pools = m.PortPools
+		}
+		return NewPortAllocator(pools, nil)
+	}
+	return m.ports
+}
+
+// DeploymentReport returns the report of the deployment in progress, in which
+// the scheduler records why offers are rejected. Like DeploymentOccupancy, it
+// is only meant to be used while AcquireTasks waits on the scheduler.
+func (m *Manager) DeploymentReport() *DeploymentReport {
+	if m == nil || m.report == nil {
+		return NewDeploymentReport()
+	}
+	return m.report
+}
+
 /*
 // BuildTasksForOffers takes in a list of Descriptors and Mesos offers, tries to find a complete
 // match between them, and returns a slice of used offers, a slice of unused offers, a
@@ -0,0 +1,125 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package placement
+
+import (
+	"sync"
+)
+
+type placedTask struct {
+	rolePath string
+	policy   Policy
+}
+
+// unit returns the spreading unit of a task, which is the task itself unless
+// its policy says otherwise.
+func unit(rolePath string, policy Policy) string {
+	if len(policy.Unit) != 0 {
+		return policy.Unit
+	}
+	return rolePath
+}
+
+// Occupancy keeps track of the tasks of an environment on each host, both
+// running and about to be deployed, to check placement policies against.
+type Occupancy struct {
+	mu    sync.RWMutex
+	hosts map[string][]placedTask
+}
+
+func NewOccupancy() *Occupancy {
+	return &Occupancy{
+		hosts: make(map[string][]placedTask),
+	}
+}
+
+// Add records a task of the role at rolePath on hostname.
+func (o *Occupancy) Add(hostname string, rolePath string, policy Policy) {
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.hosts[hostname] = append(o.hosts[hostname], placedTask{rolePath: rolePath, policy: policy})
+}
+
+// GroupCount returns the number of tasks of a placement group on hostname.
+func (o *Occupancy) GroupCount(hostname string, group string) (count int) {
+	if o == nil || len(group) == 0 {
+		return
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	for _, t := range o.hosts[hostname] {
+		if t.policy.Strategy != Default && t.policy.Group == group {
+			count++
+		}
+	}
+	return
+}
+
+// Allows checks whether a task of the role at rolePath, with the given policy,
+// can be placed on hostname. If not, it also returns the reason.
+func (o *Occupancy) Allows(hostname string, rolePath string, policy Policy) (ok bool, reason string) {
+	if o == nil {
+		return true, ""
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	placed := o.hosts[hostname]
+
+	if policy.Strategy == Spread {
+		for _, t := range placed {
+			if t.policy.Strategy == Spread && t.policy.Group == policy.Group &&
+				unit(t.rolePath, t.policy) != unit(rolePath, policy) {
+				return false, "spread group " + policy.Group + " already has a task on this host"
+			}
+		}
+	}
+
+	for _, pattern := range policy.Affinity {
+		found := false
+		for _, t := range placed {
+			if matchesAny([]string{pattern}, t.rolePath) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, "no task matching affinity " + pattern + " on this host"
+		}
+	}
+
+	for _, t := range placed {
+		if matchesAny(policy.AntiAffinity, t.rolePath) {
+			return false, "task of role " + t.rolePath + " on this host is excluded by anti-affinity"
+		}
+		if matchesAny(t.policy.AntiAffinity, rolePath) {
+			return false, "task of role " + t.rolePath + " on this host has anti-affinity with this role"
+		}
+	}
+	return true, ""
+}
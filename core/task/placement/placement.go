@@ -0,0 +1,177 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+// Package placement implements placement policies, which tell the scheduler
+// how the tasks of a role or task class should be distributed over hosts:
+// spread over as many hosts as possible, packed onto as few hosts as possible,
+// co-located with the tasks of other roles (affinity) or kept away from them
+// (anti-affinity).
+// Like constraints, placement policies implement a MergeParent operation, to
+// implement inheritance in child Roles.
+package placement
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/gobwas/glob"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logger.New(logrus.StandardLogger(),"placement")
+
+// Role paths are globbed with the same separator as in the workflow package.
+const pathSeparatorRune = '.'
+
+type Strategy int8
+const (
+	Default Strategy = iota
+	Spread
+	Pack
+)
+
+func (s Strategy) String() string {
+	switch s {
+	case Default:
+		return "default"
+	case Spread:
+		return "spread"
+	case Pack:
+		return "pack"
+	}
+	return ""
+}
+
+func (s *Strategy) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	var str string
+	err = unmarshal(&str)
+	if err != nil {
+		return
+	}
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case "", "default":
+		*s = Default
+	case "spread":
+		*s = Spread
+	case "pack":
+		*s = Pack
+	default:
+		err = fmt.Errorf("unknown placement strategy %s", str)
+	}
+	return
+}
+
+func (s Strategy) MarshalYAML() (interface{}, error) {
+	return s.String(), nil
+}
+
+// Policy is a placement policy, written in YAML as
+//   placement:
+//     strategy: spread                     # or pack
+//     affinity: ["{{ parent }}.readout"]   # role paths, globs allowed
+//     antiAffinity: ["root.qc*"]
+// A spread strategy allows at most one task of the group per host, while a
+// pack strategy prefers the hosts which already run tasks of the group.
+// The group is the role which declares the strategy, or the task class if the
+// strategy comes from a task class, and it only spans one environment.
+// Within a group, spreading applies to units: each task is its own unit,
+// except when the strategy is declared in an iterator template, in which case
+// the group is the iterator and each role it generates is a unit, so that the
+// replicas are spread while the tasks of a replica can share a host.
+// Affinity requires a task of each listed role on the same host, while
+// anti-affinity forbids any task of the listed roles on the same host, in both
+// directions.
+type Policy struct {
+	Strategy     Strategy `yaml:"strategy,omitempty"`
+	Affinity     []string `yaml:"affinity,omitempty"`
+	AntiAffinity []string `yaml:"antiAffinity,omitempty"`
+	Group        string   `yaml:"-"`
+	Unit         string   `yaml:"-"`
+}
+
+func (p Policy) IsEmpty() bool {
+	return p.Strategy == Default && len(p.Affinity) == 0 && len(p.AntiAffinity) == 0
+}
+
+func (p Policy) String() string {
+	strs := make([]string, 0)
+	if p.Strategy != Default {
+		strs = append(strs, fmt.Sprintf("STRATEGY:%s GROUP:'%s'", p.Strategy.String(), p.Group))
+	}
+	if len(p.Affinity) != 0 {
+		strs = append(strs, fmt.Sprintf("AFFINITY:['%s']", strings.Join(p.Affinity, "', '")))
+	}
+	if len(p.AntiAffinity) != 0 {
+		strs = append(strs, fmt.Sprintf("ANTI_AFFINITY:['%s']", strings.Join(p.AntiAffinity, "', '")))
+	}
+	return fmt.Sprintf("[%s]", strings.Join(strs, "; "))
+}
+
+// MergeParent returns p completed with the parent policy.
+// The strategy of p, if any, overrides the parent's along with its group,
+// while affinity and anti-affinity rules accumulate.
+func (p Policy) MergeParent(parent Policy) (merged Policy) {
+	merged = Policy{
+		Strategy:     p.Strategy,
+		Group:        p.Group,
+		Unit:         p.Unit,
+		Affinity:     appendUnique(append([]string{}, parent.Affinity...), p.Affinity...),
+		AntiAffinity: appendUnique(append([]string{}, parent.AntiAffinity...), p.AntiAffinity...),
+	}
+	if merged.Strategy == Default {
+		merged.Strategy = parent.Strategy
+		merged.Group = parent.Group
+		merged.Unit = parent.Unit
+	}
+	return
+}
+
+func appendUnique(list []string, items ...string) []string {
+	ITEMS:
+	for _, item := range items {
+		for _, existing := range list {
+			if existing == item {
+				continue ITEMS
+			}
+		}
+		list = append(list, item)
+	}
+	return list
+}
+
+func matchesAny(patterns []string, rolePath string) bool {
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, pathSeparatorRune)
+		if err != nil {
+			log.WithError(err).WithField("pattern", pattern).Warning("invalid role path pattern in placement policy")
+			continue
+		}
+		if g.Match(rolePath) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package placement_test
+
+import (
+	. "github.com/AliceO2Group/Control/core/task/placement"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("Placement", func() {
+	var (
+		policy Policy
+		err    error
+	)
+
+	Describe("unmarshaling", func() {
+		It("should parse a placement policy", func() {
+			err = yaml.Unmarshal([]byte(`
+strategy: Spread
+affinity: ["root.readout"]
+antiAffinity: ["root.qc*"]
+`), &policy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.Strategy).To(Equal(Spread))
+			Expect(policy.Affinity).To(Equal([]string{"root.readout"}))
+			Expect(policy.AntiAffinity).To(Equal([]string{"root.qc*"}))
+			Expect(policy.IsEmpty()).To(BeFalse())
+		})
+
+		It("should reject an unknown strategy", func() {
+			err = yaml.Unmarshal([]byte(`strategy: scatter`), &policy)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should marshal the strategy by name", func() {
+			out, err := yaml.Marshal(Policy{Strategy: Pack})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(out)).To(Equal("strategy: pack\n"))
+		})
+	})
+
+	Describe("merging", func() {
+		It("should inherit the strategy of the parent along with its group", func() {
+			parent := Policy{Strategy: Spread, Group: "root.flp", Affinity: []string{"root.a"}}
+			merged := Policy{Affinity: []string{"root.b", "root.a"}}.MergeParent(parent)
+			Expect(merged.Strategy).To(Equal(Spread))
+			Expect(merged.Group).To(Equal("root.flp"))
+			Expect(merged.Affinity).To(Equal([]string{"root.a", "root.b"}))
+		})
+
+		It("should override the strategy of the parent", func() {
+			parent := Policy{Strategy: Spread, Group: "root.flp", AntiAffinity: []string{"root.qc"}}
+			merged := Policy{Strategy: Pack, Group: "root.flp.readout"}.MergeParent(parent)
+			Expect(merged.Strategy).To(Equal(Pack))
+			Expect(merged.Group).To(Equal("root.flp.readout"))
+			Expect(merged.AntiAffinity).To(Equal([]string{"root.qc"}))
+		})
+
+		It("should yield an empty policy from empty policies", func() {
+			Expect(Policy{}.MergeParent(Policy{}).IsEmpty()).To(BeTrue())
+		})
+	})
+
+	Describe("occupancy", func() {
+		var (
+			occupancy *Occupancy
+			ok        bool
+			reason    string
+		)
+
+		BeforeEach(func() {
+			occupancy = NewOccupancy()
+		})
+
+		It("should allow anything without a policy", func() {
+			occupancy.Add("host1", "root.a", Policy{})
+			ok, _ = occupancy.Allows("host1", "root.b", Policy{})
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should allow only one unit of a spread group per host", func() {
+			spread := Policy{Strategy: Spread, Group: "root.readout"}
+			occupancy.Add("host1", "root.readout0", spread)
+
+			ok, reason = occupancy.Allows("host1", "root.readout1", spread)
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("spread group root.readout already has a task on this host"))
+
+			ok, _ = occupancy.Allows("host2", "root.readout1", spread)
+			Expect(ok).To(BeTrue())
+
+			ok, _ = occupancy.Allows("host1", "root.qc", Policy{Strategy: Spread, Group: "root.qc"})
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should let the tasks of one spread unit share a host", func() {
+			unit0 := Policy{Strategy: Spread, Group: "root.flp", Unit: "root.flp0"}
+			occupancy.Add("host1", "root.flp0.readout", unit0)
+
+			ok, _ = occupancy.Allows("host1", "root.flp0.stfb", unit0)
+			Expect(ok).To(BeTrue())
+
+			unit1 := Policy{Strategy: Spread, Group: "root.flp", Unit: "root.flp1"}
+			ok, _ = occupancy.Allows("host1", "root.flp1.readout", unit1)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should count the tasks of a pack group per host", func() {
+			pack := Policy{Strategy: Pack, Group: "root.qc"}
+			occupancy.Add("host1", "root.qc0", pack)
+			occupancy.Add("host1", "root.qc1", pack)
+			occupancy.Add("host1", "root.other", Policy{})
+			occupancy.Add("host2", "root.qc2", pack)
+
+			Expect(occupancy.GroupCount("host1", "root.qc")).To(Equal(2))
+			Expect(occupancy.GroupCount("host2", "root.qc")).To(Equal(1))
+			Expect(occupancy.GroupCount("host3", "root.qc")).To(Equal(0))
+			Expect(occupancy.GroupCount("host1", "")).To(Equal(0))
+		})
+
+		It("should require a task of each affine role on the host", func() {
+			affine := Policy{Affinity: []string{"root.readout*", "root.stfb"}}
+			occupancy.Add("host1", "root.readout0", Policy{})
+
+			ok, reason = occupancy.Allows("host1", "root.qc", affine)
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("no task matching affinity root.stfb on this host"))
+
+			occupancy.Add("host1", "root.stfb", Policy{})
+			ok, _ = occupancy.Allows("host1", "root.qc", affine)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should enforce anti-affinity in both directions", func() {
+			occupancy.Add("host1", "root.qc", Policy{AntiAffinity: []string{"root.readout*"}})
+
+			ok, reason = occupancy.Allows("host1", "root.readout0", Policy{})
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("task of role root.qc on this host has anti-affinity with this role"))
+
+			ok, reason = occupancy.Allows("host1", "root.merger", Policy{AntiAffinity: []string{"root.qc"}})
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("task of role root.qc on this host is excluded by anti-affinity"))
+
+			ok, _ = occupancy.Allows("host2", "root.readout0", Policy{})
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should allow anything on a nil occupancy", func() {
+			var nilOccupancy *Occupancy
+			ok, _ = nilOccupancy.Allows("host1", "root.a", Policy{Affinity: []string{"root.b"}})
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,308 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"gopkg.in/yaml.v2"
+)
+
+// PortPurpose tells what a dynamically allocated port is used for.
+type PortPurpose int
+const (
+	BIND_PORTS PortPurpose = iota
+	CONTROL_PORTS
+)
+
+func (p PortPurpose) String() string {
+	switch p {
+	case BIND_PORTS:
+		return "bind"
+	case CONTROL_PORTS:
+		return "control"
+	}
+	return ""
+}
+
+// PortPool holds the ranges from which dynamic ports are allocated, for each
+// purpose. Static ports wanted by a task class are not bound by the pool.
+type PortPool struct {
+	Bind    Ranges
+	Control Ranges
+}
+
+// DefaultPortPool applies to the hosts and purposes for which no other pool
+// is configured.
+var DefaultPortPool = PortPool{
+	Bind:    Ranges{{Begin: 9000, End: 29999}},
+	Control: Ranges{{Begin: 30000, End: 65535}},
+}
+
+func (p PortPool) rangesFor(purpose PortPurpose) Ranges {
+	switch purpose {
+	case BIND_PORTS:
+		return p.Bind
+	case CONTROL_PORTS:
+		return p.Control
+	}
+	return nil
+}
+
+// mergedWith returns a copy of p in which the purposes without ranges are
+// taken from fallback.
+func (p PortPool) mergedWith(fallback PortPool) PortPool {
+	if len(p.Bind) == 0 {
+		p.Bind = fallback.Bind
+	}
+	if len(p.Control) == 0 {
+		p.Control = fallback.Control
+	}
+	return p
+}
+
+// HostPortPool is a PortPool which applies to the hosts whose attributes
+// satisfy its constraints, written in YAML as
+//   - constraints:
+//     - attribute: machine_id
+//       value: flp001
+//     bind: "10000-19999"
+//     control: "40000-40999"
+// Either of bind and control may be omitted, in which case the default pool
+// applies for that purpose.
+type HostPortPool struct {
+	Constraints constraint.Constraints
+	PortPool
+}
+
+func (hp *HostPortPool) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	aux := struct {
+		Constraints constraint.Constraints `yaml:"constraints"`
+		Bind        string                 `yaml:"bind"`
+		Control     string                 `yaml:"control"`
+	}{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+	pool := HostPortPool{Constraints: aux.Constraints}
+	pool.Bind, err = parsePortRanges(aux.Bind)
+	if err != nil {
+		return fmt.Errorf("bind port pool: %s", err.Error())
+	}
+	pool.Control, err = parsePortRanges(aux.Control)
+	if err != nil {
+		return fmt.Errorf("control port pool: %s", err.Error())
+	}
+	*hp = pool
+	return
+}
+
+// PortPools is the configuration of the port allocator: a default pool, and
+// the pools of specific hosts, of which the first whose constraints a host
+// satisfies applies.
+type PortPools struct {
+	Default PortPool
+	Hosts   []HostPortPool
+}
+
+// ParsePortPools builds the PortPools from the default bind and control port
+// ranges, e.g. "9000-29999", and the YAML list of per-host pools, if any.
+func ParsePortPools(bind string, control string, hostsYaml []byte) (pools PortPools, err error) {
+	pools.Default.Bind, err = parsePortRanges(bind)
+	if err != nil {
+		return pools, fmt.Errorf("bind port pool: %s", err.Error())
+	}
+	pools.Default.Control, err = parsePortRanges(control)
+	if err != nil {
+		return pools, fmt.Errorf("control port pool: %s", err.Error())
+	}
+	if len(hostsYaml) > 0 {
+		err = yaml.Unmarshal(hostsYaml, &pools.Hosts)
+		if err != nil {
+			return pools, fmt.Errorf("host port pools: %s", err.Error())
+		}
+	}
+	return
+}
+
+// For returns the pool which applies to a host with the given attributes.
+func (pp PortPools) For(attributes constraint.Attributes) PortPool {
+	fallback := pp.Default.mergedWith(DefaultPortPool)
+	for _, hp := range pp.Hosts {
+		if attributes.Satisfy(hp.Constraints) {
+			return hp.PortPool.mergedWith(fallback)
+		}
+	}
+	return fallback
+}
+
+// PortAllocator hands out the dynamic ports of a deployment.
+// It knows which ports the tasks in the roster hold on each host, static
+// ports included, and it records the ports it hands out, so that no two tasks
+// get the same port on a host, even before Mesos accounts for them.
+type PortAllocator struct {
+	pools PortPools
+	held  map[string]map[uint64]string // hostname → port → holder
+}
+
+// NewPortAllocator returns a PortAllocator for the given pools, with the ports
+// of tasks already held.
+func NewPortAllocator(pools PortPools, tasks Tasks) *PortAllocator {
+	a := &PortAllocator{
+		pools: pools,
+		held:  make(map[string]map[uint64]string),
+	}
+	for _, t := range tasks {
+		if t == nil {
+			continue
+		}
+		for _, port := range t.bindPorts {
+			a.Hold(t.hostname, port, t.name)
+		}
+		if t.controlPort != 0 {
+			a.Hold(t.hostname, t.controlPort, t.name)
+		}
+		if t.GetTaskClass != nil {
+			for _, rng := range t.GetWantsPorts() {
+				for port := rng.Begin; port <= rng.End && port != 0; port++ {
+					a.Hold(t.hostname, port, t.name)
+				}
+			}
+		}
+	}
+	return a
+}
+
+// Hold records that holder uses port on the given host.
+func (a *PortAllocator) Hold(hostname string, port uint64, holder string) {
+	if a == nil {
+		return
+	}
+	if a.held[hostname] == nil {
+		a.held[hostname] = make(map[uint64]string)
+	}
+	a.held[hostname][port] = holder
+}
+
+// Release drops all the ports held by holder on the given host, e.g. when the
+// task they were allocated for cannot be launched after all.
+func (a *PortAllocator) Release(hostname string, holder string) {
+	if a == nil {
+		return
+	}
+	for port, h := range a.held[hostname] {
+		if h == holder {
+			delete(a.held[hostname], port)
+		}
+	}
+}
+
+// Allocate picks a port for the given purpose among the available ports of an
+// offer, within the pool which applies to the host, and holds it for holder.
+// It picks preferred if it is free, e.g. the port a task had before it was
+// redeployed, and otherwise the lowest free port.
+func (a *PortAllocator) Allocate(hostname string, attributes constraint.Attributes, purpose PortPurpose, available mesos.Ranges, preferred uint64, holder string) (port uint64, err error) {
+	if a == nil {
+		a = NewPortAllocator(PortPools{}, nil)
+	}
+	pool := a.pools.For(attributes).rangesFor(purpose)
+	candidates := intersectRanges(available, pool)
+	if preferred != 0 && rangesContain(candidates, preferred) && !a.isHeld(hostname, preferred) {
+		a.Hold(hostname, preferred, holder)
+		return preferred, nil
+	}
+	for _, rng := range candidates {
+		for p := rng.Begin; p <= rng.End && p != 0; p++ {
+			if !a.isHeld(hostname, p) {
+				a.Hold(hostname, p, holder)
+				return p, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no %s ports available in pool %s", purpose.String(), pool.String())
+}
+
+// StaticConflicts returns the static ports among the given ranges which are
+// already held on the host, each with its holder, sorted by port.
+func (a *PortAllocator) StaticConflicts(hostname string, static Ranges) (conflicts []string) {
+	conflicts = make([]string, 0)
+	if a == nil {
+		return
+	}
+	ports := make([]uint64, 0)
+	for _, rng := range static {
+		for port := rng.Begin; port <= rng.End && port != 0; port++ {
+			if a.isHeld(hostname, port) {
+				ports = append(ports, port)
+			}
+		}
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	for _, port := range ports {
+		conflicts = append(conflicts, fmt.Sprintf("%d held by %s", port, a.held[hostname][port]))
+	}
+	return
+}
+
+func (a *PortAllocator) isHeld(hostname string, port uint64) bool {
+	_, ok := a.held[hostname][port]
+	return ok
+}
+
+// intersectRanges returns the ports of available which are also in pool.
+func intersectRanges(available mesos.Ranges, pool Ranges) (result mesos.Ranges) {
+	result = make(mesos.Ranges, 0)
+	for _, a := range available {
+		for _, p := range pool {
+			begin, end := a.Begin, a.End
+			if p.Begin > begin {
+				begin = p.Begin
+			}
+			if p.End < end {
+				end = p.End
+			}
+			if begin <= end {
+				result = append(result, mesos.Value_Range{Begin: begin, End: end})
+			}
+		}
+	}
+	return result.Sort().Squash()
+}
+
+func rangesContain(ranges mesos.Ranges, port uint64) bool {
+	for _, rng := range ranges {
+		if port >= rng.Begin && port <= rng.End {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package task
+
+import (
+	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func machineAttributes(machineId string) constraint.Attributes {
+	return constraint.Attributes{{
+		Name: "machine_id",
+		Type: mesos.TEXT,
+		Text: &mesos.Value_Text{Value: machineId},
+	}}
+}
+
+var _ = Describe("PortAllocator", func() {
+	var (
+		pools PortPools
+		a     *PortAllocator
+	)
+
+	BeforeEach(func() {
+		pools = PortPools{
+			Default: PortPool{
+				Bind:    Ranges{{10000, 10002}},
+				Control: Ranges{{40000, 40001}},
+			},
+			Hosts: []HostPortPool{{
+				Constraints: constraint.Constraints{{Attribute: "machine_id", Value: "flp2", Operator: constraint.Equals}},
+				PortPool:    PortPool{Bind: Ranges{{20000, 20000}}},
+			}},
+		}
+		a = NewPortAllocator(pools, nil)
+	})
+
+	// offered is what Mesos offers on every host in these specs
+	offered := mesos.Ranges{{Begin: 10000, End: 50000}}
+
+	type allocation struct {
+		hostname  string
+		machineId string
+		purpose   PortPurpose
+		preferred uint64
+		holder    string
+	}
+
+	table.DescribeTable("allocating ports",
+		func(held map[string]uint64, allocations []allocation, expected []uint64, expectedErr string) {
+			for holder, port := range held {
+				a.Hold("flp1", port, holder)
+			}
+			ports := make([]uint64, 0)
+			var err error
+			for _, al := range allocations {
+				var port uint64
+				port, err = a.Allocate(al.hostname, machineAttributes(al.machineId), al.purpose, offered, al.preferred, al.holder)
+				if err != nil {
+					break
+				}
+				ports = append(ports, port)
+			}
+			Expect(ports).To(Equal(expected))
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+		table.Entry("lowest free ports of the pool for each purpose", nil,
+			[]allocation{
+				{"flp1", "flp1", BIND_PORTS, 0, "a"},
+				{"flp1", "flp1", BIND_PORTS, 0, "b"},
+				{"flp1", "flp1", CONTROL_PORTS, 0, "a"},
+			},
+			[]uint64{10000, 10001, 40000}, ""),
+		table.Entry("preferred port when free", nil,
+			[]allocation{
+				{"flp1", "flp1", BIND_PORTS, 10002, "a"},
+				{"flp1", "flp1", BIND_PORTS, 10002, "b"},
+			},
+			[]uint64{10002, 10000}, ""),
+		table.Entry("no preferred port outside the pool", nil,
+			[]allocation{{"flp1", "flp1", BIND_PORTS, 9000, "a"}},
+			[]uint64{10000}, ""),
+		table.Entry("no port held by another task", map[string]uint64{"static": 10000},
+			[]allocation{{"flp1", "flp1", BIND_PORTS, 10000, "a"}},
+			[]uint64{10001}, ""),
+		table.Entry("pool exhaustion", nil,
+			[]allocation{
+				{"flp1", "flp1", CONTROL_PORTS, 0, "a"},
+				{"flp1", "flp1", CONTROL_PORTS, 0, "b"},
+				{"flp1", "flp1", CONTROL_PORTS, 0, "c"},
+			},
+			[]uint64{40000, 40001}, "no control ports available in pool [40000-40001]"),
+		table.Entry("per-host isolation", nil,
+			[]allocation{
+				{"flp1", "flp1", CONTROL_PORTS, 0, "a"},
+				{"flp3", "flp3", CONTROL_PORTS, 0, "b"},
+			},
+			[]uint64{40000, 40000}, ""),
+		table.Entry("per-host pool, with the default pool for the other purpose", nil,
+			[]allocation{
+				{"flp2", "flp2", BIND_PORTS, 0, "a"},
+				{"flp2", "flp2", CONTROL_PORTS, 0, "a"},
+				{"flp2", "flp2", BIND_PORTS, 0, "b"},
+			},
+			[]uint64{20000, 40000}, "no bind ports available in pool [20000]"),
+	)
+
+	It("should only allocate ports which the offer has", func() {
+		port, err := a.Allocate("flp1", nil, BIND_PORTS, mesos.Ranges{{Begin: 10001, End: 10001}}, 0, "a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(port).To(BeEquivalentTo(10001))
+
+		_, err = a.Allocate("flp1", nil, BIND_PORTS, mesos.Ranges{{Begin: 10001, End: 10001}}, 0, "b")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should make the ports of a holder available again once released", func() {
+		for _, holder := range []string{"a", "a", "b"} {
+			_, err := a.Allocate("flp1", nil, BIND_PORTS, offered, 0, holder)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		a.Release("flp1", "a")
+		a.Release("flp3", "b") // not held there, no effect
+
+		Expect(a.StaticConflicts("flp1", Ranges{{10000, 10002}})).To(Equal([]string{"10002 held by b"}))
+		port, err := a.Allocate("flp1", nil, BIND_PORTS, offered, 0, "c")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(port).To(BeEquivalentTo(10000))
+	})
+
+	table.DescribeTable("finding static port conflicts",
+		func(hostname string, static Ranges, expected []string) {
+			a.Hold("flp1", 9000, "readout")
+			a.Hold("flp1", 9005, "qc")
+			Expect(a.StaticConflicts(hostname, static)).To(Equal(expected))
+		},
+		table.Entry("none outside the held ports", "flp1", Ranges{{9001, 9004}}, []string{}),
+		table.Entry("each held port with its holder, sorted", "flp1", Ranges{{9005, 9010}, {8990, 9000}},
+			[]string{"9000 held by readout", "9005 held by qc"}),
+		table.Entry("none on another host", "flp2", Ranges{{9000, 9005}}, []string{}),
+	)
+
+	It("should hold the ports of the tasks it starts with", func() {
+		a = NewPortAllocator(pools, Tasks{
+			{name: "readout#1", hostname: "flp1", bindPorts: map[string]uint64{"data": 10000}, controlPort: 40000},
+			nil,
+		})
+		Expect(a.StaticConflicts("flp1", Ranges{{10000, 10000}, {40000, 40000}})).To(Equal([]string{
+			"10000 held by readout#1",
+			"40000 held by readout#1",
+		}))
+	})
+
+	It("should allocate from the default pools without an allocator", func() {
+		var nilAllocator *PortAllocator
+		port, err := nilAllocator.Allocate("flp1", nil, CONTROL_PORTS, offered, 0, "a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(port).To(BeEquivalentTo(30000))
+		Expect(nilAllocator.StaticConflicts("flp1", Ranges{{30000, 30000}})).To(BeEmpty())
+	})
+})
@@ -26,6 +26,8 @@ package task
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -62,7 +64,17 @@ type Range struct {
 
 type Ranges []Range
 
-
+func (this Ranges) String() string {
+	strs := make([]string, len(this))
+	for i, rng := range this {
+		if rng.Begin == rng.End {
+			strs[i] = fmt.Sprintf("%d", rng.Begin)
+			continue
+		}
+		strs[i] = fmt.Sprintf("%d-%d", rng.Begin, rng.End)
+	}
+	return "[" + strings.Join(strs, ",") + "]"
+}
 
 func (this Ranges) Equals(other Ranges) (response bool) {
 	if len(this) != len(other) {
@@ -80,6 +92,20 @@ func (this Ranges) Equals(other Ranges) (response bool) {
 	return
 }
 
+// firstOverlap returns the lowest port which belongs to more than one range,
+// if any.
+func (this Ranges) firstOverlap() (port uint64, overlap bool) {
+	sorted := make(Ranges, len(this))
+	copy(sorted, this)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Begin < sorted[j].Begin })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Begin <= sorted[i-1].End {
+			return sorted[i].Begin, true
+		}
+	}
+	return 0, false
+}
+
 func parsePortRanges(str string) (ranges Ranges, err error) {
 	r := make(Ranges, 0)
 	if len(strings.TrimSpace(str)) == 0 {
@@ -104,10 +130,14 @@ func parsePortRanges(str string) (ranges Ranges, err error) {
 			if err != nil {
 				return
 			}
-			end, err = strconv.ParseUint(rangeSplit[0], 10, 64)
+			end, err = strconv.ParseUint(rangeSplit[1], 10, 64)
 			if err != nil {
 				return
 			}
+			if begin > end {
+				err = fmt.Errorf("bad port range %s: begin above end", trimmed)
+				return
+			}
 			r = append(r, Range{Begin: begin, End: end})
 			continue
 		} else {
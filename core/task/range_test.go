@@ -0,0 +1,41 @@
+package task
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("port ranges", func() {
+	table.DescribeTable("parsing",
+		func(str string, expected Ranges) {
+			ranges, err := parsePortRanges(str)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ranges).To(Equal(expected))
+		},
+		table.Entry("a single port", "9000", Ranges{{9000, 9000}}),
+		table.Entry("a range up to its end", "9000-9010", Ranges{{9000, 9010}}),
+		table.Entry("a list of ranges", "9000-9010, 9020", Ranges{{9000, 9010}, {9020, 9020}}),
+	)
+
+	It("should reject a range which begins above its end", func() {
+		_, err := parsePortRanges("9010-9000")
+		Expect(err).To(MatchError("bad port range 9010-9000: begin above end"))
+	})
+
+	table.DescribeTable("finding the lowest port in more than one range",
+		func(ranges Ranges, port uint64, overlap bool) {
+			p, o := ranges.firstOverlap()
+			Expect(o).To(Equal(overlap))
+			Expect(p).To(Equal(port))
+		},
+		table.Entry("no ranges", Ranges{}, uint64(0), false),
+		table.Entry("disjoint ranges", Ranges{{9020, 9030}, {9000, 9010}}, uint64(0), false),
+		table.Entry("adjacent ranges", Ranges{{9000, 9010}, {9011, 9020}}, uint64(0), false),
+		table.Entry("ranges sharing an end", Ranges{{9010, 9020}, {9000, 9010}}, uint64(9010), true),
+		table.Entry("a range within another", Ranges{{9000, 9100}, {9050, 9060}}, uint64(9050), true),
+		table.Entry("a port repeated", Ranges{{9000, 9000}, {9000, 9000}}, uint64(9000), true),
+		table.Entry("a range overlapping one before the previous",
+			Ranges{{9000, 9100}, {9010, 9020}, {9030, 9040}}, uint64(9010), true),
+	)
+})
@@ -0,0 +1,135 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/sirupsen/logrus"
+)
+
+// GetMesosLabels returns the labels to attach to the Mesos task at launch,
+// from which the task can be rebuilt through reconciliation.
+func (t *Task) GetMesosLabels() *mesos.Labels {
+	labels := &mesos.Labels{}
+	if t == nil {
+		return labels
+	}
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_CLASS, t.className)
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_HOSTNAME, t.hostname)
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_OFFER_ID, t.offerId)
+	labels = common.WithTaskLabel(labels, common.TASK_LABEL_CONTROL_PORT, strconv.FormatUint(t.controlPort, 10))
+	if bindPorts, err := json.Marshal(t.bindPorts); err == nil {
+		labels = common.WithTaskLabel(labels, common.TASK_LABEL_BIND_PORTS, string(bindPorts))
+	}
+	return labels
+}
+
+// ReconcileTask makes sure that a task which Mesos reports as active through
+// reconciliation is in the roster.
+// A task which the roster doesn't know, e.g. after a core restart, is rebuilt
+// from the labels its executor reports, and joins the roster unlocked so that
+// it can be reused by a new environment or cleaned up.
+// An error means that the task cannot be rebuilt, and should be killed so that
+// it doesn't hold on to its resources.
+func (m *Manager) ReconcileTask(status *mesos.TaskStatus) (t *Task, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	taskId := status.GetTaskID().Value
+	t = m.roster.GetByTaskId(taskId)
+	if t != nil {
+		return
+	}
+
+	switch status.GetState() {
+	case mesos.TASK_STAGING, mesos.TASK_STARTING, mesos.TASK_RUNNING:
+	default:
+		// nothing left to rebuild
+		return
+	}
+
+	labels := status.GetLabels()
+	className, ok := common.GetTaskLabel(labels, common.TASK_LABEL_CLASS)
+	if !ok || len(className) == 0 {
+		err = fmt.Errorf("task %s carries no task class label", taskId)
+		return
+	}
+
+	t = &Task{
+		name:         fmt.Sprintf("%s#%s", className, taskId),
+		className:    className,
+		agentId:      status.GetAgentID().GetValue(),
+		taskId:       taskId,
+		executorId:   status.GetExecutorID().GetValue(),
+		bindPorts:    make(map[string]uint64),
+		state:        UNKNOWN,
+		status:       INACTIVE,
+	}
+	t.hostname, _ = common.GetTaskLabel(labels, common.TASK_LABEL_HOSTNAME)
+	t.offerId, _ = common.GetTaskLabel(labels, common.TASK_LABEL_OFFER_ID)
+	if controlPort, ok := common.GetTaskLabel(labels, common.TASK_LABEL_CONTROL_PORT); ok {
+		t.controlPort, _ = strconv.ParseUint(controlPort, 10, 64)
+	}
+	if bindPorts, ok := common.GetTaskLabel(labels, common.TASK_LABEL_BIND_PORTS); ok {
+		if err = json.Unmarshal([]byte(bindPorts), &t.bindPorts); err != nil {
+			err = fmt.Errorf("task %s carries bad bind ports label: %s", taskId, err.Error())
+			t = nil
+			return
+		}
+	}
+	if state, ok := common.GetTaskLabel(labels, common.TASK_LABEL_STATE); ok {
+		t.state = StateFromString(state)
+	}
+	if status.GetState() == mesos.TASK_RUNNING {
+		t.status = ACTIVE
+	}
+	if len(t.hostname) == 0 {
+		if agent := m.AgentCache.Get(mesos.AgentID{Value: t.agentId}); agent != nil {
+			t.hostname = agent.Hostname
+		}
+	}
+	t.GetTaskClass = func() *TaskClass {
+		return m.GetTaskClass(t.className)
+	}
+
+	m.roster = append(m.roster, t)
+
+	log.WithFields(logrus.Fields{
+			"taskId": taskId,
+			"taskClass": className,
+			"hostname": t.hostname,
+			"state": t.state.String(),
+		}).
+		Info("task rebuilt from reconciliation")
+	return
+}
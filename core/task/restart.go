@@ -0,0 +1,166 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mesos/mesos-go/api/v1/lib"
+)
+
+type RestartMode int8
+const (
+	RestartNever RestartMode = iota
+	RestartOnFailure
+)
+
+func (rm RestartMode) String() string {
+	switch rm {
+	case RestartNever:
+		return "never"
+	case RestartOnFailure:
+		return "on-failure"
+	}
+	return ""
+}
+
+func (rm *RestartMode) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	var str string
+	err = unmarshal(&str)
+	if err != nil {
+		return
+	}
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case "", "never":
+		*rm = RestartNever
+	case "on-failure":
+		*rm = RestartOnFailure
+	default:
+		err = fmt.Errorf("unknown restart policy %s", str)
+	}
+	return
+}
+
+func (rm RestartMode) MarshalYAML() (interface{}, error) {
+	return rm.String(), nil
+}
+
+const (
+	defaultRestartBackoff    = 1 * time.Second
+	defaultRestartMaxBackoff = 1 * time.Minute
+)
+
+// RestartPolicy tells what to do when a task of an environment dies, written
+// in YAML as
+//   restart:
+//     policy: on-failure   # or never, the default
+//     maxRetries: 3        # 0 means no limit
+//     backoff: 1s          # delay before the first restart, doubled every time
+//     maxBackoff: 1m
+// It can be set on a task class, and on a role, in which case it applies to
+// all the tasks of the role and of its descendants, unless overridden.
+type RestartPolicy struct {
+	Mode       RestartMode   `yaml:"policy"`
+	MaxRetries int           `yaml:"maxRetries,omitempty"`
+	Backoff    time.Duration `yaml:"backoff,omitempty"`
+	MaxBackoff time.Duration `yaml:"maxBackoff,omitempty"`
+}
+
+func (rp RestartPolicy) String() string {
+	if rp.Mode == RestartNever {
+		return rp.Mode.String()
+	}
+	return fmt.Sprintf("%s (max retries: %d, backoff: %s, max backoff: %s)",
+		rp.Mode.String(), rp.MaxRetries, rp.backoff().String(), rp.maxBackoff().String())
+}
+
+// ShouldRestart tells whether a task should be restarted after it reached the
+// given state, and had already been restarted the given number of times.
+func (rp RestartPolicy) ShouldRestart(state mesos.TaskState, restarts int) bool {
+	if rp.Mode != RestartOnFailure || !IsFailure(state) {
+		return false
+	}
+	return rp.MaxRetries == 0 || restarts < rp.MaxRetries
+}
+
+// Delay returns how long to wait before the next restart, given how many
+// restarts were already done.
+func (rp RestartPolicy) Delay(restarts int) (delay time.Duration) {
+	delay = rp.backoff()
+	for i := 0; i < restarts && delay < rp.maxBackoff(); i++ {
+		delay *= 2
+	}
+	if delay > rp.maxBackoff() {
+		delay = rp.maxBackoff()
+	}
+	return
+}
+
+func (rp RestartPolicy) backoff() time.Duration {
+	if rp.Backoff <= 0 {
+		return defaultRestartBackoff
+	}
+	return rp.Backoff
+}
+
+func (rp RestartPolicy) maxBackoff() time.Duration {
+	if rp.MaxBackoff <= 0 {
+		return defaultRestartMaxBackoff
+	}
+	return rp.MaxBackoff
+}
+
+// IsFailure tells whether a task which reaches the given state has failed.
+// Tasks of an environment are never killed on purpose while they belong to it,
+// so TASK_KILLED is a failure too.
+func IsFailure(state mesos.TaskState) bool {
+	switch state {
+	case mesos.TASK_FAILED, mesos.TASK_ERROR, mesos.TASK_LOST, mesos.TASK_DROPPED,
+		mesos.TASK_KILLED, mesos.TASK_GONE, mesos.TASK_GONE_BY_OPERATOR, mesos.TASK_UNREACHABLE:
+		return true
+	}
+	return false
+}
+
+// IsLost tells whether a task status reports that the task was lost along with
+// its host, e.g. because the agent was removed, rebooted or became unreachable,
+// rather than because the task itself failed.
+func IsLost(status *mesos.TaskStatus) bool {
+	switch status.GetState() {
+	case mesos.TASK_LOST, mesos.TASK_GONE, mesos.TASK_GONE_BY_OPERATOR, mesos.TASK_UNREACHABLE:
+		return true
+	}
+	switch status.GetReason() {
+	case mesos.REASON_AGENT_DISCONNECTED, mesos.REASON_AGENT_REMOVED, mesos.REASON_AGENT_REMOVED_BY_OPERATOR,
+		mesos.REASON_AGENT_RESTARTED, mesos.REASON_AGENT_UNKNOWN:
+		return IsFailure(status.GetState())
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package task
+
+import (
+	"time"
+
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"gopkg.in/yaml.v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RestartPolicy", func() {
+	onFailure := RestartPolicy{Mode: RestartOnFailure}
+	limited := RestartPolicy{Mode: RestartOnFailure, MaxRetries: 2}
+
+	DescribeTable("deciding whether to restart",
+		func(rp RestartPolicy, state mesos.TaskState, restarts int, expected bool) {
+			Expect(rp.ShouldRestart(state, restarts)).To(Equal(expected))
+		},
+		Entry("never restarts by default", RestartPolicy{}, mesos.TASK_FAILED, 0, false),
+		Entry("restarts a failed task", onFailure, mesos.TASK_FAILED, 0, true),
+		Entry("restarts a lost task", onFailure, mesos.TASK_LOST, 0, true),
+		Entry("restarts a killed task", onFailure, mesos.TASK_KILLED, 0, true),
+		Entry("does not restart a finished task", onFailure, mesos.TASK_FINISHED, 0, false),
+		Entry("does not restart a running task", onFailure, mesos.TASK_RUNNING, 0, false),
+		Entry("restarts without limit", onFailure, mesos.TASK_FAILED, 1000, true),
+		Entry("restarts below the limit", limited, mesos.TASK_FAILED, 1, true),
+		Entry("stops restarting at the limit", limited, mesos.TASK_FAILED, 2, false),
+		Entry("stops restarting past the limit", limited, mesos.TASK_FAILED, 3, false),
+	)
+
+	DescribeTable("delaying the restarts",
+		func(rp RestartPolicy, restarts int, expected time.Duration) {
+			Expect(rp.Delay(restarts)).To(Equal(expected))
+		},
+		Entry("waits for the default backoff first", onFailure, 0, time.Second),
+		Entry("doubles the backoff every time", onFailure, 3, 8*time.Second),
+		Entry("caps the backoff at the default maximum", onFailure, 10, time.Minute),
+		Entry("waits for the given backoff first",
+			RestartPolicy{Mode: RestartOnFailure, Backoff: 100 * time.Millisecond}, 0, 100*time.Millisecond),
+		Entry("doubles the given backoff",
+			RestartPolicy{Mode: RestartOnFailure, Backoff: 100 * time.Millisecond}, 2, 400*time.Millisecond),
+		Entry("caps the backoff at the given maximum",
+			RestartPolicy{Mode: RestartOnFailure, Backoff: time.Second, MaxBackoff: 5 * time.Second}, 3, 5*time.Second),
+		Entry("caps a backoff above the maximum",
+			RestartPolicy{Mode: RestartOnFailure, Backoff: time.Hour}, 0, time.Minute),
+		Entry("does not overflow after many restarts", onFailure, 1000, time.Minute),
+	)
+
+	DescribeTable("unmarshaling",
+		func(doc string, expected RestartPolicy, fails bool) {
+			var rp RestartPolicy
+			err := yaml.Unmarshal([]byte(doc), &rp)
+			if fails {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rp).To(Equal(expected))
+		},
+		Entry("never", "policy: never", RestartPolicy{}, false),
+		Entry("the default", "maxRetries: 3", RestartPolicy{MaxRetries: 3}, false),
+		Entry("on-failure with its settings", "policy: On-Failure\nmaxRetries: 3\nbackoff: 2s\nmaxBackoff: 30s",
+			RestartPolicy{Mode: RestartOnFailure, MaxRetries: 3, Backoff: 2 * time.Second, MaxBackoff: 30 * time.Second}, false),
+		Entry("an unknown policy", "policy: always", RestartPolicy{}, true),
+	)
+})
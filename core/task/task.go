@@ -30,11 +30,14 @@
 package task
 
 import (
+	"fmt"
+
 	"github.com/AliceO2Group/Control/common"
 	"github.com/AliceO2Group/Control/common/controlmode"
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/AliceO2Group/Control/core/task/placement"
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/pborman/uuid"
 	"github.com/sirupsen/logrus"
@@ -52,7 +55,8 @@ type parentRole interface {
 	SetTask(*Task)
 	GetEnvironmentId() uuid.Array
 	CollectOutboundChannels() []channel.Outbound
-	//GetVars()
+	GetVars() VarMap
+	GetCurrentRunNumber() uint32
 }
 
 type Task struct {
@@ -67,6 +71,14 @@ type Task struct {
 	executorId   string
 
 	bindPorts    map[string]uint64
+	controlPort  uint64
+
+	roleWants         ResourceWants
+	cmdExtraEnv       []string
+	cmdExtraArguments []string
+	placement         placement.Policy
+	restartPolicy     RestartPolicy
+	deployedClass     *TaskClass // copy of the class as it was when deployed
 
 	status       Status
 	state        State
@@ -108,10 +120,35 @@ func (t *Task) GetClassName() string {
 
 // Returns a consolidated CommandInfo for this Task, based on Roles tree and
 // TaskClass.
-func (t Task) BuildTaskCommand() (cmd *common.TaskCommandInfo) {
+// The command value, arguments and env vars are rendered as templates, see
+// templateContext.
+func (t Task) BuildTaskCommand() (cmd *common.TaskCommandInfo, err error) {
 	if class := t.GetTaskClass(); class != nil {
 		cmd = &common.TaskCommandInfo{}
 		cmd.CommandInfo = *class.Command.Copy()
+
+		// Arguments and env vars declared by the workflow role extend those of the
+		// task class. An env var set by the role overrides the one in the class
+		// because the last occurrence of a variable wins.
+		cmd.Arguments = append(cmd.Arguments, t.cmdExtraArguments...)
+		cmd.Env = append(cmd.Env, t.cmdExtraEnv...)
+
+		ctx := t.newTemplateContext()
+		if cmd.Value != nil {
+			*cmd.Value, err = ctx.render(t.name, *cmd.Value)
+			if err != nil {
+				return nil, fmt.Errorf("cannot render command value for task %s: %s", t.name, err.Error())
+			}
+		}
+		cmd.Arguments, err = ctx.renderSlice(t.name, cmd.Arguments)
+		if err != nil {
+			return nil, fmt.Errorf("cannot render command arguments for task %s: %s", t.name, err.Error())
+		}
+		cmd.Env, err = ctx.renderSlice(t.name, cmd.Env)
+		if err != nil {
+			return nil, fmt.Errorf("cannot render command env for task %s: %s", t.name, err.Error())
+		}
+
 		if class.Control.Mode == controlmode.FAIRMQ {
 			// FIXME read this from configuration
 			contains := func(s []string, str string) bool {
@@ -132,14 +169,48 @@ func (t Task) BuildTaskCommand() (cmd *common.TaskCommandInfo) {
 				"--color", "false")
 		}
 		cmd.ControlMode = class.Control.Mode
+
+		if class.Container != nil {
+			cmd.Container = class.Container.Copy()
+			cmd.Container.Image, err = ctx.render(t.name, cmd.Container.Image)
+			if err != nil {
+				return nil, fmt.Errorf("cannot render container image for task %s: %s", t.name, err.Error())
+			}
+		}
 	} else {
 		cmd = &common.TaskCommandInfo{}
 	}
 	return
 }
 
+// matchesOverrides returns whether the task was deployed with the overrides
+// requested by the role of descriptor, i.e. whether taking it over yields the
+// command and resources that role asks for.
+func (t *Task) matchesOverrides(descriptor *Descriptor) bool {
+	if t == nil || descriptor == nil {
+		return false
+	}
+	equalStrings := func(a, b []string) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+	return t.roleWants.Equals(descriptor.RoleWants) &&
+		equalStrings(t.cmdExtraEnv, descriptor.CmdExtraEnv) &&
+		equalStrings(t.cmdExtraArguments, descriptor.CmdExtraArguments)
+}
+
 func (t *Task) GetWantsCPU() float64 {
 	if t != nil {
+		if t.roleWants.Cpu != nil {
+			return *t.roleWants.Cpu
+		}
 		if tt := t.GetTaskClass(); tt != nil {
 			return *tt.Wants.Cpu
 		}
@@ -149,6 +220,9 @@ func (t *Task) GetWantsCPU() float64 {
 
 func (t *Task) GetWantsMemory() float64 {
 	if t != nil {
+		if t.roleWants.Memory != nil {
+			return *t.roleWants.Memory
+		}
 		if tt := t.GetTaskClass(); tt != nil {
 			return *tt.Wants.Memory
 		}
@@ -158,6 +232,11 @@ func (t *Task) GetWantsMemory() float64 {
 
 func (t *Task) GetWantsPorts() Ranges {
 	if t != nil {
+		if t.roleWants.Ports != nil {
+			wantsPorts := make(Ranges, len(t.roleWants.Ports))
+			copy(wantsPorts, t.roleWants.Ports)
+			return wantsPorts
+		}
 		if tt := t.GetTaskClass(); tt != nil {
 			wantsPorts := make(Ranges, len(tt.Wants.Ports))
 			copy(wantsPorts, tt.Wants.Ports)
@@ -167,6 +246,19 @@ func (t *Task) GetWantsPorts() Ranges {
 	return nil
 }
 
+// GetWantsCustom returns the custom resources wanted by the task class, as
+// overridden by those wanted by the role.
+func (t *Task) GetWantsCustom() CustomResources {
+	if t != nil {
+		var classCustom CustomResources
+		if tt := t.GetTaskClass(); tt != nil {
+			classCustom = tt.Wants.Custom
+		}
+		return classCustom.MergedWith(t.roleWants.Custom)
+	}
+	return nil
+}
+
 func (t Task) GetOfferId() string {
 	return t.offerId
 }
@@ -194,52 +286,91 @@ func (t Task) GetEnvironmentId() uuid.Array {
 	return t.parent.GetEnvironmentId()
 }
 
+func (t Task) GetStatus() Status {
+	return t.status
+}
+
+func (t Task) GetState() State {
+	return t.state
+}
+
+func (t Task) GetRestartPolicy() RestartPolicy {
+	return t.restartPolicy
+}
+
 func (t Task) GetBindPorts() map[string]uint64 {
 	return t.bindPorts
 }
 
-func (t Task) BuildPropertyMap(bindMap channel.BindMap) controlcommands.PropertyMap {
-	//parentMap := t.parent.GetPropertyMap()
-	//FIXME support parent properties
+func (t Task) GetControlPort() uint64 {
+	return t.controlPort
+}
+
+// SetControlPort records the control port claimed for the task at launch.
+func (t *Task) SetControlPort(port uint64) {
+	t.controlPort = port
+}
 
-	propMap := make(controlcommands.PropertyMap)
+// BuildPropertyMap returns the properties pushed to the task at CONFIGURE.
+// These are, from lowest to highest precedence, the properties of the task
+// class, the vars of the roles up to the task's own role, the vars of the
+// environment (workflow parameter defaults, overridden by the values supplied
+// by the user), and the properties of the inbound and outbound channels.
+// Tasks receive the same properties regardless of their control mode.
+func (t Task) BuildPropertyMap(bindMap channel.BindMap) (propMap controlcommands.PropertyMap, err error) {
+	propMap = make(controlcommands.PropertyMap)
 	if class := t.GetTaskClass(); class != nil {
-		if class.Control.Mode == controlmode.FAIRMQ {
-			for _, inbCh := range class.Bind {
-				port, ok := t.bindPorts[inbCh.Name]
-				if !ok {
-					log.WithFields(logrus.Fields{
-							"channelName": inbCh.Name,
-							"taskName": t.name,
-						}).
-						Error("port not allocated for inbound channel")
-					continue
-				}
+		// Task class properties are rendered as templates, see templateContext
+		ctx := t.newTemplateContext()
+		for k, v := range class.Properties {
+			propMap[k], err = ctx.render(t.name, v)
+			if err != nil {
+				return nil, fmt.Errorf("cannot render property %s for task %s: %s", k, t.name, err.Error())
+			}
+		}
 
-				// We get the FairMQ-formatted propertyMap from the inbound channel spec
-				chanProps := inbCh.ToFMQMap(port)
+		var outbound []channel.Outbound
+		if t.parent != nil {
+			for k, v := range t.parent.GetVars() {
+				propMap[k] = v
+			}
+			outbound = t.parent.CollectOutboundChannels()
+		}
 
-				// And we copy it into the task's propertyMap
-				for k, v := range chanProps {
-					propMap[k] = v
-				}
+		for _, inbCh := range class.Bind {
+			port, ok := t.bindPorts[inbCh.Name]
+			if !ok {
+				log.WithFields(logrus.Fields{
+						"channelName": inbCh.Name,
+						"taskName": t.name,
+					}).
+					Error("port not allocated for inbound channel")
+				continue
 			}
 
-			for _, outboundCh := range t.parent.CollectOutboundChannels() {
-				// We get the FairMQ-formatted propertyMap from the outbound channel spec
-				chanProps := outboundCh.ToFMQMap(bindMap)
+			// We get the FairMQ-formatted propertyMap from the inbound channel spec
+			chanProps := inbCh.ToFMQMap(port)
 
-				// And if valid, we copy it into the task's propertyMap
-				if len(chanProps) > 0 {
-					for k, v := range chanProps {
-						propMap[k] = v
-					}
+			// And we copy it into the task's propertyMap
+			for k, v := range chanProps {
+				propMap[k] = v
+			}
+		}
+
+		for _, outboundCh := range outbound {
+			// We get the FairMQ-formatted propertyMap from the outbound channel spec
+			chanProps := outboundCh.ToFMQMap(bindMap)
+
+			// And if valid, we copy it into the task's propertyMap
+			if len(chanProps) > 0 {
+				for k, v := range chanProps {
+					propMap[k] = v
 				}
 			}
 		}
 	}
 
-	return propMap
+	return
 }
 
 func (t Task) GetMesosCommandTarget() controlcommands.MesosCommandTarget {
@@ -0,0 +1,122 @@
+package task
+
+import (
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pborman/uuid"
+)
+
+var _ = Describe("Task", func() {
+	Describe("matching role overrides", func() {
+		var (
+			cpu        float64
+			t          *Task
+			descriptor *Descriptor
+		)
+
+		BeforeEach(func() {
+			cpu = 2
+			t = &Task{
+				roleWants:         ResourceWants{Cpu: &cpu},
+				cmdExtraEnv:       []string{"VERBOSE=1"},
+				cmdExtraArguments: []string{"--rate", "10"},
+			}
+			otherCpu := cpu
+			descriptor = &Descriptor{
+				RoleWants:         ResourceWants{Cpu: &otherCpu},
+				CmdExtraEnv:       []string{"VERBOSE=1"},
+				CmdExtraArguments: []string{"--rate", "10"},
+			}
+		})
+
+		It("should match a descriptor with the same overrides", func() {
+			Expect(t.matchesOverrides(descriptor)).To(BeTrue())
+		})
+
+		It("should not match a descriptor which wants other resources", func() {
+			otherCpu := 4.0
+			descriptor.RoleWants.Cpu = &otherCpu
+			Expect(t.matchesOverrides(descriptor)).To(BeFalse())
+
+			descriptor.RoleWants.Cpu = nil
+			Expect(t.matchesOverrides(descriptor)).To(BeFalse())
+		})
+
+		It("should not match a descriptor with other extra env vars", func() {
+			descriptor.CmdExtraEnv = []string{"VERBOSE=0"}
+			Expect(t.matchesOverrides(descriptor)).To(BeFalse())
+		})
+
+		It("should not match a descriptor with other extra arguments", func() {
+			descriptor.CmdExtraArguments = []string{"--rate"}
+			Expect(t.matchesOverrides(descriptor)).To(BeFalse())
+		})
+
+		It("should match a task without overrides only to a descriptor without overrides", func() {
+			bare := &Task{}
+			Expect(bare.matchesOverrides(&Descriptor{})).To(BeTrue())
+			Expect(bare.matchesOverrides(descriptor)).To(BeFalse())
+		})
+	})
+})
+
+type fakeParentRole struct {
+	vars VarMap
+}
+
+func (*fakeParentRole) UpdateStatus(Status)                         {}
+func (*fakeParentRole) UpdateState(State)                           {}
+func (*fakeParentRole) GetPath() string                             { return "wf.readout" }
+func (*fakeParentRole) GetTaskClass() string                        { return "readout" }
+func (*fakeParentRole) SetTask(*Task)                               {}
+func (*fakeParentRole) GetEnvironmentId() uuid.Array                { return uuid.NIL.Array() }
+func (*fakeParentRole) CollectOutboundChannels() []channel.Outbound { return nil }
+func (p *fakeParentRole) GetVars() VarMap                           { return p.vars }
+func (*fakeParentRole) GetCurrentRunNumber() uint32                 { return 0 }
+
+var _ = Describe("building the property map", func() {
+	var t *Task
+
+	BeforeEach(func() {
+		class := &TaskClass{
+			Properties: controlcommands.PropertyMap{
+				"detector": "ITS",
+				"rate":     "{{ .Vars.rate }}",
+				"severity": "info",
+			},
+		}
+		t = &Task{
+			name:         "readout#1",
+			GetTaskClass: func() *TaskClass { return class },
+		}
+	})
+
+	It("should return the task class properties without a parent role", func() {
+		class := t.GetTaskClass()
+		class.Properties["rate"] = "1"
+
+		propMap, err := t.BuildPropertyMap(channel.BindMap{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(propMap).To(BeEquivalentTo(map[string]string{
+			"detector": "ITS",
+			"rate":     "1",
+			"severity": "info",
+		}))
+	})
+
+	It("should override the task class properties with the vars of the parent role", func() {
+		// The parent role vars already merge role vars with the environment
+		// vars, which take precedence, see workflow.roleBase.GetVars
+		t.parent = &fakeParentRole{vars: VarMap{"detector": "TPC", "rate": "20"}}
+
+		propMap, err := t.BuildPropertyMap(channel.BindMap{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(propMap).To(BeEquivalentTo(map[string]string{
+			"detector": "TPC",
+			"rate":     "20",
+			"severity": "info",
+		}))
+	})
+})
@@ -34,6 +34,10 @@ import (
 type Tasks []*Task
 type DeploymentMap map[*Task]*Descriptor
 
+// Stages is a list of groups of tasks to be transitioned in order, one group
+// after the other, as required by role dependencies.
+type Stages []Tasks
+
 type Filter func(*Task) bool
 var Filter_NIL Filter = func(*Task) bool {
 	return true
@@ -107,7 +111,42 @@ func (m Tasks) BuildPropertyMaps(bindMap channel.BindMap) (propMapMap controlcom
 		}
 		receiver := task.GetMesosCommandTarget()
 
-		propMapMap[receiver] = task.BuildPropertyMap(bindMap)
+		propMapMap[receiver], err = task.BuildPropertyMap(bindMap)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return
-}
\ No newline at end of file
+}
+
+// Flatten returns the tasks of all stages, in stage order.
+func (s Stages) Flatten() (tasks Tasks) {
+	tasks = make(Tasks, 0)
+	for _, stage := range s {
+		tasks = append(tasks, stage...)
+	}
+	return
+}
+
+// Reversed returns the stages in reverse order, for transitions such as STOP and
+// RESET which must be committed on dependent tasks before their dependencies.
+func (s Stages) Reversed() (reversed Stages) {
+	reversed = make(Stages, len(s))
+	for i, stage := range s {
+		reversed[len(s)-1-i] = stage
+	}
+	return
+}
+
+// Filtered returns the stages with only the tasks accepted by filter, dropping
+// the stages which end up empty.
+func (s Stages) Filtered(filter Filter) (filtered Stages) {
+	filtered = make(Stages, 0, len(s))
+	for _, stage := range s {
+		tasks := stage.Filtered(filter)
+		if len(tasks) != 0 {
+			filtered = append(filtered, tasks)
+		}
+	}
+	return
+}
@@ -0,0 +1,63 @@
+package task
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func taskIds(tasks Tasks) (ids []string) {
+	ids = make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.taskId)
+	}
+	return
+}
+
+var _ = Describe("Stages", func() {
+	var (
+		a, b, c, d *Task
+		stages     Stages
+	)
+
+	BeforeEach(func() {
+		a = &Task{taskId: "a"}
+		b = &Task{taskId: "b", className: "readout"}
+		c = &Task{taskId: "c"}
+		d = &Task{taskId: "d", className: "readout"}
+		stages = Stages{{a, b}, {c}, {d}}
+	})
+
+	Describe("flattening", func() {
+		It("should keep the tasks in stage order", func() {
+			Expect(taskIds(stages.Flatten())).To(Equal([]string{"a", "b", "c", "d"}))
+		})
+
+		It("should return an empty list for no stages", func() {
+			Expect(Stages{}.Flatten()).To(BeEmpty())
+			Expect(Stages(nil).Flatten()).NotTo(BeNil())
+		})
+	})
+
+	Describe("reversing", func() {
+		It("should reverse the stage order but not the tasks in a stage", func() {
+			reversed := stages.Reversed()
+			Expect(reversed).To(HaveLen(3))
+			Expect(taskIds(reversed.Flatten())).To(Equal([]string{"d", "c", "a", "b"}))
+		})
+
+		It("should not modify the original stages", func() {
+			stages.Reversed()
+			Expect(taskIds(stages.Flatten())).To(Equal([]string{"a", "b", "c", "d"}))
+		})
+	})
+
+	Describe("filtering", func() {
+		It("should drop the stages which end up empty", func() {
+			filtered := stages.Filtered(func(t *Task) bool {
+				return t.className == "readout"
+			})
+			Expect(filtered).To(HaveLen(2))
+			Expect(taskIds(filtered.Flatten())).To(Equal([]string{"b", "d"}))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+)
+
+// templateContext holds the values which task class templates can refer to.
+// The command value, arguments and env vars of a task class are rendered at
+// deployment time, and its properties at configure time, e.g.
+//   arguments:
+//     - "--id"
+//     - "{{ .Vars.detector }}-{{ .Hostname }}"
+//     - "--data-port"
+//     - "{{ .BindPorts.data }}"
+// There is no run number, since both happen before START, which is when an
+// environment gets its run number. Tasks receive it as the runNumber argument
+// of the START transition instead.
+type templateContext struct {
+	TaskId        string
+	Hostname      string
+	BindPorts     map[string]uint64
+	RolePath      string
+	EnvironmentId string
+	Vars          VarMap // the vars of the parent role, merged with those of its ancestors
+}
+
+func (t Task) newTemplateContext() *templateContext {
+	ctx := &templateContext{
+		TaskId:    t.taskId,
+		Hostname:  t.hostname,
+		BindPorts: make(map[string]uint64),
+		Vars:      make(VarMap),
+	}
+	for k, v := range t.bindPorts {
+		ctx.BindPorts[k] = v
+	}
+	if t.parent != nil {
+		ctx.RolePath = t.parent.GetPath()
+		ctx.EnvironmentId = t.parent.GetEnvironmentId().UUID().String()
+		for k, v := range t.parent.GetVars() {
+			ctx.Vars[k] = v
+		}
+	}
+	return ctx
+}
+
+// render executes text as a template against the context. Strings without
+// template actions are returned as they are, and referring to a missing var or
+// bind port is an error.
+func (ctx *templateContext) render(name string, text string) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, ctx)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (ctx *templateContext) renderSlice(name string, texts []string) (rendered []string, err error) {
+	rendered = make([]string, len(texts))
+	for i, text := range texts {
+		rendered[i], err = ctx.render(name, text)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return
+}
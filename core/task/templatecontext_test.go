@@ -0,0 +1,105 @@
+package task
+
+import (
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/pborman/uuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("templateContext", func() {
+	var t *Task
+
+	BeforeEach(func() {
+		t = &Task{
+			name:      "readout#1",
+			taskId:    "task-1",
+			hostname:  "flp001",
+			bindPorts: map[string]uint64{"data": 31000},
+			parent:    &fakeParentRole{vars: VarMap{"detector": "ITS"}},
+		}
+	})
+
+	Describe("rendering", func() {
+		It("should render every field of the context", func() {
+			ctx := t.newTemplateContext()
+			for text, expected := range map[string]string{
+				"{{ .TaskId }}":          "task-1",
+				"{{ .Hostname }}":        "flp001",
+				"{{ .BindPorts.data }}":  "31000",
+				"{{ .RolePath }}":        "wf.readout",
+				"{{ .EnvironmentId }}":   uuid.NIL.String(),
+				"{{ .Vars.detector }}":   "ITS",
+				"{{ .Vars.detector }}-1": "ITS-1",
+			} {
+				Expect(ctx.render(t.name, text)).To(Equal(expected), text)
+			}
+		})
+
+		It("should return strings without template actions as they are", func() {
+			ctx := t.newTemplateContext()
+			Expect(ctx.render(t.name, "--rate {.Vars.rate} }}")).To(Equal("--rate {.Vars.rate} }}"))
+			Expect(ctx.render(t.name, "")).To(Equal(""))
+		})
+
+		It("should fail on a missing var or bind port", func() {
+			ctx := t.newTemplateContext()
+			_, err := ctx.render(t.name, "{{ .Vars.rate }}")
+			Expect(err).To(HaveOccurred())
+			_, err = ctx.render(t.name, "{{ .BindPorts.control }}")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should leave the fields of the parent role empty without one", func() {
+			t.parent = nil
+			ctx := t.newTemplateContext()
+			Expect(ctx.render(t.name, "{{ .RolePath }}{{ .EnvironmentId }}")).To(BeEmpty())
+			_, err := ctx.render(t.name, "{{ .Vars.detector }}")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("a bad template", func() {
+		var class *TaskClass
+
+		BeforeEach(func() {
+			value := "readout.exe"
+			class = &TaskClass{
+				Command: &common.CommandInfo{
+					Value:     &value,
+					Arguments: []string{"--detector", "{{ .Vars.detector }}"},
+				},
+				Properties: controlcommands.PropertyMap{"detector": "{{ .Vars.detector }}"},
+			}
+			t.GetTaskClass = func() *TaskClass { return class }
+		})
+
+		It("should fail the deployment of the task", func() {
+			cmd, err := t.BuildTaskCommand()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd.Arguments).To(Equal([]string{"--detector", "ITS"}))
+
+			class.Command.Arguments = []string{"--rate", "{{ .Vars.rate }}"}
+			_, err = t.BuildTaskCommand()
+			Expect(err).To(MatchError(ContainSubstring("cannot render command arguments for task readout#1")))
+
+			class.Command.Arguments = nil
+			class.Command.Env = []string{"DETECTOR={{ .Vars.detector"}
+			_, err = t.BuildTaskCommand()
+			Expect(err).To(MatchError(ContainSubstring("cannot render command env for task readout#1")))
+		})
+
+		It("should fail the configuration of the task", func() {
+			propMap, err := t.BuildPropertyMap(channel.BindMap{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(propMap).To(HaveKeyWithValue("detector", "ITS"))
+
+			class.Properties["rate"] = "{{ .Vars.rate }}"
+			_, err = t.BuildPropertyMap(channel.BindMap{})
+			Expect(err).To(MatchError(ContainSubstring("cannot render property rate for task readout#1")))
+		})
+	})
+})
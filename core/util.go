@@ -36,6 +36,7 @@ import (
 	"time"
 
 	"github.com/AliceO2Group/Control/common/product"
+	"github.com/AliceO2Group/Control/core/task"
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/mesos/mesos-go/api/v1/lib/httpcli"
@@ -43,8 +44,41 @@ import (
 	"github.com/mesos/mesos-go/api/v1/lib/resources"
 	"github.com/mesos/mesos-go/api/v1/lib/scheduler/calls"
 	"path/filepath"
+	"strconv"
+
+	"github.com/AliceO2Group/Control/common"
 )
 
+// preferredBindPort returns the port a restarted task previously had for the
+// given inbound channel, as long as the candidate offer is on the same host.
+// It returns 0 if there is no preference.
+func preferredBindPort(descriptor *task.Descriptor, hostname string, channel string) uint64 {
+	if descriptor == nil || len(descriptor.PreferredHostname) == 0 ||
+		descriptor.PreferredHostname != hostname {
+		return 0
+	}
+	return descriptor.PreferredBindPorts[channel]
+}
+
+// preferredControlPort is like preferredBindPort, for the control port.
+func preferredControlPort(descriptor *task.Descriptor, hostname string) uint64 {
+	if descriptor == nil || len(descriptor.PreferredHostname) == 0 ||
+		descriptor.PreferredHostname != hostname {
+		return 0
+	}
+	return descriptor.PreferredControlPort
+}
+
+// setControlPort appends the control port to the arguments and environment of
+// cmd. For the control port parameter and/or environment variable, see
+// occ/OccGlobals.h
+func setControlPort(cmd *common.TaskCommandInfo, controlPort uint64, hostname string) {
+	cmd.Arguments = append(cmd.Arguments, "--control-port", strconv.FormatUint(controlPort, 10))
+	cmd.ControlPort = controlPort
+	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", "OCC_CONTROL_PORT", controlPort))
+	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", "O2_ROLE", hostname))
+}
+
 
 func prepareExecutorInfo(
 	execBinary, execImage string,
@@ -145,10 +179,7 @@ func buildFrameworkInfo() *mesos.FrameworkInfo {
 	if failoverTimeout > 0 {
 		frameworkInfo.FailoverTimeout = &failoverTimeout
 	}
-	mesosFrameworkRole := viper.GetString("mesosFrameworkRole")
-	if mesosFrameworkRole != "" {
-		frameworkInfo.Role = &mesosFrameworkRole
-	}
+	setFrameworkRoles(frameworkInfo, viper.GetStringSlice("mesosFrameworkRoles"), viper.GetString("mesosFrameworkRole"))
 	mesosPrincipal := viper.GetString("mesosPrincipal")
 	if mesosPrincipal != "" {
 		frameworkInfo.Principal = &mesosPrincipal
@@ -170,6 +201,20 @@ func buildFrameworkInfo() *mesos.FrameworkInfo {
 	return frameworkInfo
 }
 
+// setFrameworkRoles makes the framework register with roles, or with the
+// single role if there are none. With several roles, each offer is allocated
+// to one of them.
+func setFrameworkRoles(frameworkInfo *mesos.FrameworkInfo, roles []string, role string) {
+	if len(roles) > 0 {
+		frameworkInfo.Roles = roles
+		frameworkInfo.Capabilities = append(frameworkInfo.Capabilities,
+			mesos.FrameworkInfo_Capability{Type: mesos.FrameworkInfo_Capability_MULTI_ROLE},
+		)
+	} else if role != "" {
+		frameworkInfo.Role = &role
+	}
+}
+
 func loadCredentials(username string, password string) (result credentials, err error) {
 	result = credentials{username, password}
 	if result.password != "" {
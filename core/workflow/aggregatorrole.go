@@ -83,6 +83,8 @@ func (r *aggregatorRole) ProcessTemplates(workflowRepo *repos.Repo) (err error)
 	}
 
 	r.resolveOutboundChannelTargets()
+	r.resolveDependencies()
+	r.resolvePlacement()
 
 	for _, role := range r.Roles {
 		err = role.ProcessTemplates(workflowRepo)
@@ -96,6 +96,12 @@ func (at *aggregatorTemplate) generateRole(t templateMap) (c Role, err error) {
 		return
 	}
 
+	ar.Vars = t.mergeInto(ar.Vars)
+	ar.iteratorVars = t.vars()
+	for _, v := range ar.Roles {
+		v.setParent(&ar)
+	}
+
 	// 3 + 4)
 	c = &ar
 	return
@@ -0,0 +1,276 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AliceO2Group/Control/core/task"
+)
+
+// IteratorChange is the result of SetIterator on a role tree: it holds the
+// child roles which are generated or dropped by the iterator, so that their
+// tasks can be deployed or released before the change is applied to the tree,
+// and it can apply or undo the change on the tree.
+type IteratorChange struct {
+	root          Role
+	iterator      *iteratorRole
+	previousFor   iteratorInfo
+	previousRoles []Role
+	nextFor       iteratorInfo
+	nextRoles     []Role
+
+	Added   []Role
+	Removed []Role
+}
+
+// SetIterator changes the range or list of values of the iterator role found at
+// path, and regenerates its child roles accordingly.
+// The iterator can be identified either by its own path, i.e. the path of its
+// template, or by the path of any of its current child roles.
+// Child roles whose path is unchanged are kept as they are, along with their
+// tasks. New child roles have their templates processed, but no tasks yet.
+// If values is not nil, begin and end are ignored.
+// The role tree is left as it is: the change only takes effect with Apply, so
+// that the tasks of the new child roles can be deployed beforehand.
+// The caller must hold the lock of the role tree.
+func SetIterator(root Role, path string, begin int, end int, values []string) (change *IteratorChange, err error) {
+	iterator := findIterator(root, path)
+	if iterator == nil {
+		return nil, fmt.Errorf("no iterator role %s", path)
+	}
+
+	info := iteratorInfo{
+		Begin:  begin,
+		End:    end,
+		Values: values,
+		Var:    iterator.For.Var,
+	}
+
+	var generated []Role
+	generated, err = iterator.generateRoles(info)
+	if err != nil {
+		return
+	}
+
+	existing := make(map[string]Role)
+	for _, role := range iterator.Roles {
+		existing[role.GetPath()] = role
+	}
+
+	change = &IteratorChange{
+		root:          root,
+		iterator:      iterator,
+		previousFor:   iterator.For,
+		previousRoles: iterator.Roles,
+		nextFor:       info,
+		Added:         make([]Role, 0),
+		Removed:       make([]Role, 0),
+	}
+
+	roles := make([]Role, 0, len(generated))
+	kept := make(map[string]bool)
+	for _, role := range generated {
+		rolePath := role.GetPath()
+		if kept[rolePath] {
+			return nil, fmt.Errorf("iterator %s generates role %s more than once", path, rolePath)
+		}
+		kept[rolePath] = true
+
+		if oldRole, ok := existing[rolePath]; ok {
+			roles = append(roles, oldRole)
+			continue
+		}
+		err = iterator.processChildTemplates(role)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+		change.Added = append(change.Added, role)
+	}
+	for _, role := range iterator.Roles {
+		if !kept[role.GetPath()] {
+			change.Removed = append(change.Removed, role)
+		}
+	}
+
+	change.nextRoles = roles
+
+	// The new roles must not break the dependency graph of the workflow.
+	change.Apply()
+	_, err = taskRoleStages(root)
+	change.Revert()
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Apply replaces the child roles of the iterator with those generated for the
+// new range or list of values. The caller must hold the lock of the role tree.
+func (c *IteratorChange) Apply() {
+	if c == nil || c.iterator == nil {
+		return
+	}
+	c.iterator.For = c.nextFor
+	c.iterator.Roles = c.nextRoles
+}
+
+// Revert restores the iterator and its child roles as they were before the
+// change. Any tasks acquired for the added roles must be released separately.
+// The caller must hold the lock of the role tree.
+func (c *IteratorChange) Revert() {
+	if c == nil || c.iterator == nil {
+		return
+	}
+	c.iterator.For = c.previousFor
+	c.iterator.Roles = c.previousRoles
+}
+
+// AddedTasks returns the tasks currently assigned to the added roles.
+func (c *IteratorChange) AddedTasks() (tasks task.Tasks) {
+	return tasksOfRoles(c.Added)
+}
+
+// RemovedTasks returns the tasks of the roles dropped by the iterator.
+func (c *IteratorChange) RemovedTasks() (tasks task.Tasks) {
+	return tasksOfRoles(c.Removed)
+}
+
+// RewiredTasks returns the tasks which stay in the workflow, but which have at
+// least one outbound channel towards an added or removed role, and therefore
+// need to be reconfigured for their channels to be rewired.
+// The result is the same whether or not the change is applied.
+func (c *IteratorChange) RewiredTasks() (tasks task.Tasks) {
+	tasks = make(task.Tasks, 0)
+	if c == nil {
+		return
+	}
+
+	changedPaths := make([]string, 0, len(c.Added) + len(c.Removed))
+	for _, role := range append(append([]Role{}, c.Added...), c.Removed...) {
+		changedPaths = append(changedPaths, role.GetPath())
+	}
+
+	changed := make(map[*taskRole]bool)
+	for _, role := range append(append([]Role{}, c.Added...), c.Removed...) {
+		for _, tr := range collectTaskRoles(role) {
+			changed[tr] = true
+		}
+	}
+
+	for _, tr := range collectTaskRoles(c.root) {
+		if changed[tr] || tr.GetTask() == nil {
+			continue
+		}
+		for _, ch := range tr.CollectOutboundChannels() {
+			if targetsAnyRole(ch.Target, changedPaths) {
+				tasks = append(tasks, tr.GetTask())
+				break
+			}
+		}
+	}
+	return
+}
+
+// targetsAnyRole checks whether an outbound channel target of the form
+// <role path>:<channel name> points to one of rolePaths or to a descendant.
+func targetsAnyRole(target string, rolePaths []string) bool {
+	sepIdx := strings.LastIndex(target, ":")
+	if sepIdx < 0 {
+		return false
+	}
+	targetPath := target[:sepIdx]
+	for _, rolePath := range rolePaths {
+		if targetPath == rolePath ||
+			strings.HasPrefix(targetPath, rolePath + PATH_SEPARATOR) {
+			return true
+		}
+	}
+	return false
+}
+
+// TasksTargetingRoles returns the tasks in the workflow under root which have
+// at least one outbound channel towards one of the roles with the given paths,
+// or towards their children.
+func TasksTargetingRoles(root Role, rolePaths []string) (tasks task.Tasks) {
+	tasks = make(task.Tasks, 0)
+	if root == nil {
+		return
+	}
+	for _, tr := range collectTaskRoles(root) {
+		if tr.GetTask() == nil {
+			continue
+		}
+		for _, ch := range tr.CollectOutboundChannels() {
+			if targetsAnyRole(ch.Target, rolePaths) {
+				tasks = append(tasks, tr.GetTask())
+				break
+			}
+		}
+	}
+	return
+}
+
+func tasksOfRoles(roles []Role) (tasks task.Tasks) {
+	tasks = make(task.Tasks, 0)
+	for _, role := range roles {
+		for _, taskPtr := range role.GetTasks() {
+			if taskPtr != nil {
+				tasks = append(tasks, taskPtr)
+			}
+		}
+	}
+	return
+}
+
+// findIterator looks for an iterator role in the tree by its own path, or by
+// the path of one of its child roles.
+// NOTE: GetRoles flattens iterators into their child roles, so here we must walk
+// the Roles lists directly.
+func findIterator(role Role, path string) *iteratorRole {
+	var children []Role
+	switch r := role.(type) {
+	case *iteratorRole:
+		if r.GetPath() == path {
+			return r
+		}
+		for _, child := range r.Roles {
+			if child.GetPath() == path {
+				return r
+			}
+		}
+		children = r.Roles
+	case *aggregatorRole:
+		children = r.Roles
+	}
+	for _, child := range children {
+		if found := findIterator(child, path); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package workflow
+
+import (
+	"github.com/AliceO2Group/Control/core/repos"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func taskRolePaths(root Role) (paths []string) {
+	paths = make([]string, 0)
+	for _, tr := range collectTaskRoles(root) {
+		paths = append(paths, tr.GetPath())
+	}
+	return
+}
+
+func rolePaths(roles []Role) (paths []string) {
+	paths = make([]string, 0, len(roles))
+	for _, role := range roles {
+		paths = append(paths, role.GetPath())
+	}
+	return
+}
+
+var _ = Describe("iterator changes", func() {
+	var root Role
+
+	BeforeEach(func() {
+		root = loadRoleTree(`
+name: wf
+roles:
+  - name: "readout{{ .it }}"
+    for:
+      begin: 0
+      end: 1
+      var: it
+    task:
+      load: readout
+  - name: merger
+    dependsOn: ["wf.readout1"]
+    task:
+      load: merger
+`)
+		repo, err := repos.NewRepo("github.com/AliceO2Group/ControlWorkflows")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(root.ProcessTemplates(repo)).To(Succeed())
+		Expect(taskRolePaths(root)).To(Equal([]string{"wf.readout0", "wf.readout1", "wf.merger"}))
+	})
+
+	It("should change the range of an iterator only once applied", func() {
+		change, err := SetIterator(root, "wf.readout0", 1, 2, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rolePaths(change.Added)).To(Equal([]string{"wf.readout2"}))
+		Expect(rolePaths(change.Removed)).To(Equal([]string{"wf.readout0"}))
+		Expect(taskRolePaths(root)).To(Equal([]string{"wf.readout0", "wf.readout1", "wf.merger"}))
+
+		change.Apply()
+		Expect(taskRolePaths(root)).To(Equal([]string{"wf.readout1", "wf.readout2", "wf.merger"}))
+
+		change.Revert()
+		Expect(taskRolePaths(root)).To(Equal([]string{"wf.readout0", "wf.readout1", "wf.merger"}))
+	})
+
+	It("should keep the child roles whose path is unchanged", func() {
+		kept := findIterator(root, "wf.readout1").Roles[1]
+		change, err := SetIterator(root, "wf.readout1", 1, 3, nil)
+		Expect(err).NotTo(HaveOccurred())
+		change.Apply()
+		Expect(findIterator(root, "wf.readout1").Roles[0]).To(BeIdenticalTo(kept))
+	})
+
+	It("should replace the range of an iterator with a list of values", func() {
+		change, err := SetIterator(root, "wf.readout0", 0, 0, []string{"1", "tpc"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rolePaths(change.Added)).To(Equal([]string{"wf.readouttpc"}))
+		Expect(rolePaths(change.Removed)).To(Equal([]string{"wf.readout0"}))
+
+		change.Apply()
+		Expect(taskRolePaths(root)).To(Equal([]string{"wf.readout1", "wf.readouttpc", "wf.merger"}))
+		Expect(collectTaskRoles(root)[1].GetVars()).To(HaveKeyWithValue("it", "tpc"))
+	})
+
+	It("should fail for an unknown iterator", func() {
+		_, err := SetIterator(root, "wf.merger", 0, 1, nil)
+		Expect(err).To(MatchError("no iterator role wf.merger"))
+	})
+
+	It("should fail if a role would be generated more than once", func() {
+		_, err := SetIterator(root, "wf.readout0", 0, 0, []string{"1", "1"})
+		Expect(err).To(HaveOccurred())
+		Expect(taskRolePaths(root)).To(Equal([]string{"wf.readout0", "wf.readout1", "wf.merger"}))
+	})
+
+	It("should fail and leave the tree untouched if a dependency would break", func() {
+		_, err := SetIterator(root, "wf.readout0", 0, 0, nil)
+		Expect(err).To(MatchError("role wf.merger depends on wf.readout1, which matches no role"))
+		Expect(taskRolePaths(root)).To(Equal([]string{"wf.readout0", "wf.readout1", "wf.merger"}))
+	})
+})
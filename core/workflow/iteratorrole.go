@@ -26,22 +26,48 @@ package workflow
 
 import (
 	"errors"
+	"fmt"
 	"github.com/AliceO2Group/Control/core/repos"
 	"strconv"
 
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/placement"
 	"github.com/gobwas/glob"
 )
 
 type iteratorRole struct {
 	aggregator
-	For      iteratorInfo            `yaml:"for,omitempty"`
-	template roleTemplate
+	For          iteratorInfo            `yaml:"for,omitempty"`
+	template     roleTemplate
+	workflowRepo *repos.Repo
 }
 
 type templateMap map[string]interface{}
 
+// mergeInto adds the template values to a role's vars, so that the iterator
+// variable is also available to task class templates as {{ .Vars.<var> }}.
+func (t templateMap) mergeInto(vars task.VarMap) task.VarMap {
+	if vars == nil {
+		vars = make(task.VarMap)
+	}
+	for k, v := range t {
+		if _, ok := vars[k]; !ok {
+			vars[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	return vars
+}
+
+// vars returns the template values as the iterator vars of a generated role.
+func (t templateMap) vars() task.VarMap {
+	vars := make(task.VarMap)
+	for k, v := range t {
+		vars[k] = fmt.Sprintf("%v", v)
+	}
+	return vars
+}
+
 type roleTemplate interface {
 	Role
 	generateRole(t templateMap) (Role, error)
@@ -90,9 +116,13 @@ func (i *iteratorRole) UnmarshalYAML(unmarshal func(interface{}) error) (err err
 	return
 }
 
+// iteratorInfo describes the values taken by an iterator variable: either the
+// integers from Begin to End (inclusive), or, if Values is set, each of the
+// strings in Values.
 type iteratorInfo struct {
 	Begin       int                     `yaml:"begin"`
 	End         int                     `yaml:"end"`
+	Values      []string                `yaml:"values,omitempty"`
 	Var         string                  `yaml:"var"`
 }
 
@@ -100,6 +130,7 @@ func (f *iteratorInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err err
 	aux := struct{
 		Begin       string                  `yaml:"begin"`
 		End         string                  `yaml:"end"`
+		Values      []string                `yaml:"values"`
 		Var         string                  `yaml:"var"`
 	}{}
 	err = unmarshal(&aux)
@@ -107,6 +138,12 @@ func (f *iteratorInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err err
 		return
 	}
 
+	if aux.Values != nil {
+		f.Values = aux.Values
+		f.Var = aux.Var
+		return
+	}
+
 	f.Begin, err = strconv.Atoi(aux.Begin)
 	if err != nil {
 		return
@@ -119,6 +156,18 @@ func (f *iteratorInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err err
 	return
 }
 
+// values returns the successive values of the iterator variable.
+func (f iteratorInfo) values() (values []string) {
+	if f.Values != nil {
+		return append([]string{}, f.Values...)
+	}
+	values = make([]string, 0)
+	for j := f.Begin; j <= f.End; j++ {
+		values = append(values, strconv.Itoa(j))
+	}
+	return
+}
+
 func (i *iteratorRole) GlobFilter(g glob.Glob) (rs []Role) {
 	rs = make([]Role, 0)
 	for _, chr := range i.Roles {
@@ -135,8 +184,12 @@ func (i *iteratorRole) ProcessTemplates(workflowRepo *repos.Repo) (err error) {
 		return errors.New("role tree error when processing templates")
 	}
 
+	// We keep the repo around, in case the iterator is later modified and
+	// some new child roles need their templates processed.
+	i.workflowRepo = workflowRepo
+
 	for _, role := range i.Roles {
-		err = role.ProcessTemplates(workflowRepo)
+		err = i.processChildTemplates(role)
 		if err != nil {
 			return
 		}
@@ -144,13 +197,37 @@ func (i *iteratorRole) ProcessTemplates(workflowRepo *repos.Repo) (err error) {
 	return
 }
 
+// processChildTemplates processes the templates of a role generated by this
+// iterator.
+// A placement strategy declared in the iterator template applies to all the
+// generated roles as a group, each of them being a unit to spread or pack.
+func (i *iteratorRole) processChildTemplates(role Role) error {
+	if grouper, ok := role.(interface{ setPlacementGroup(string, string) }); ok {
+		grouper.setPlacementGroup(i.GetPath(), role.GetPath())
+	}
+	return role.ProcessTemplates(i.workflowRepo)
+}
+
 func (i *iteratorRole) expandTemplate() (err error) {
+	var roles []Role
+	roles, err = i.generateRoles(i.For)
+	if err != nil {
+		return
+	}
+
+	i.Roles = roles
+	return
+}
+
+// generateRoles instantiates the template once for each value of the iterator
+// variable described by info, without modifying the iterator itself.
+func (i *iteratorRole) generateRoles(info iteratorInfo) (roles []Role, err error) {
 	values := make(templateMap)
 
-	roles := make([]Role, 0)
+	roles = make([]Role, 0)
 
-	for j := i.For.Begin; j <= i.For.End; j++ {
-		values[i.For.Var] = strconv.Itoa(j)
+	for _, v := range info.values() {
+		values[info.Var] = v
 		var newRole Role
 		newRole, err = i.template.generateRole(values)
 		if err != nil {
@@ -158,8 +235,6 @@ func (i *iteratorRole) expandTemplate() (err error) {
 		}
 		roles = append(roles, newRole)
 	}
-
-	i.Roles = roles
 	return
 }
 
@@ -215,3 +290,33 @@ func (i *iteratorRole) getConstraints() (cts constraint.Constraints) {
 	}
 	return
 }
+
+func (i *iteratorRole) getDependencies() (deps []string) {
+	if i == nil {
+		return
+	}
+	if parentRole := i.GetParentRole(); parentRole != nil {
+		deps = parentRole.getDependencies()
+	}
+	return
+}
+
+func (i *iteratorRole) getRestartPolicy() *task.RestartPolicy {
+	if i == nil {
+		return nil
+	}
+	if parentRole := i.GetParentRole(); parentRole != nil {
+		return parentRole.getRestartPolicy()
+	}
+	return nil
+}
+
+func (i *iteratorRole) getPlacement() (policy placement.Policy) {
+	if i == nil {
+		return
+	}
+	if parentRole := i.GetParentRole(); parentRole != nil {
+		policy = parentRole.getPlacement()
+	}
+	return
+}
@@ -34,7 +34,9 @@ import (
 )
 
 // FIXME: workflowPath should be of type configuration.Path, not string
-func Load(cfg configuration.ROSource, workflowPath string, parent Updatable, taskManager *task.Manager) (workflow Role, err error) {
+// Load also validates userVars against the parameters declared by the workflow
+// template, and returns them completed with the declared default values.
+func Load(cfg configuration.ROSource, workflowPath string, parent Updatable, taskManager *task.Manager, userVars map[string]string) (workflow Role, vars task.VarMap, err error) {
 	repoManager := the.RepoManager()
 
 	var resolvedWorkflowPath string
@@ -50,11 +52,21 @@ func Load(cfg configuration.ROSource, workflowPath string, parent Updatable, tas
 		return
 	}
 
+	var params repos.WorkflowParameters
+	params, err = repos.ParseWorkflowParameters(yamlDoc)
+	if err != nil {
+		return
+	}
+	vars, err = params.Validate(userVars)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	root := new(aggregatorRole)
 	root.parent = parent
 	err = yaml.Unmarshal(yamlDoc, root)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if parent != nil {
 		root.parent = parent
@@ -62,6 +74,13 @@ func Load(cfg configuration.ROSource, workflowPath string, parent Updatable, tas
 
 	workflow = root
 	workflow.ProcessTemplates(workflowRepo)
+
+	// We don't need the stages yet, but this way broken dependsOn declarations
+	// and dependency cycles are caught when the workflow is loaded.
+	_, err = taskRoleStages(workflow)
+	if err != nil {
+		return nil, nil, err
+	}
 	log.WithField("path", workflowPath).Debug("workflow loaded")
 	//pp.Println(workflow)
 
@@ -33,17 +33,23 @@ import (
 )
 
 type GetEnvIdFunc func() uuid.Array
+type GetCurrentRunNumberFunc func() uint32
+type GetVarsFunc func() task.VarMap
 
 type ParentAdapter struct {
 	mu sync.Mutex
 	getEnvIdFunc GetEnvIdFunc
+	getCurrentRunNumberFunc GetCurrentRunNumberFunc
+	getVarsFunc GetVarsFunc
 	stateSubscriptions map[string]chan task.State
 	statusSubscriptions map[string]chan task.Status
 }
 
-func NewParentAdapter(getEnvId GetEnvIdFunc) *ParentAdapter {
+func NewParentAdapter(getEnvId GetEnvIdFunc, getCurrentRunNumber GetCurrentRunNumberFunc, getVars GetVarsFunc) *ParentAdapter {
 	return &ParentAdapter{
 		getEnvIdFunc: getEnvId,
+		getCurrentRunNumberFunc: getCurrentRunNumber,
+		getVarsFunc: getVars,
 		stateSubscriptions: make(map[string]chan task.State),
 		statusSubscriptions: make(map[string]chan task.Status, 0),
 	}
@@ -104,6 +110,14 @@ func (p *ParentAdapter) GetEnvironmentId() uuid.Array {
 	return p.getEnvIdFunc()
 }
 
+func (p *ParentAdapter) GetCurrentRunNumber() uint32 {
+	return p.getCurrentRunNumberFunc()
+}
+
+func (p *ParentAdapter) GetVars() task.VarMap {
+	return p.getVarsFunc()
+}
+
 func (*ParentAdapter) GetPath() string {
 	return ""
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/channel"
 	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/placement"
 	"github.com/gobwas/glob"
 	"github.com/pborman/uuid"
 )
@@ -50,6 +51,9 @@ type Role interface {
 	GetTaskClasses() []string
 	GenerateTaskDescriptors() task.Descriptors
 	getConstraints() constraint.Constraints
+	getDependencies() []string
+	getPlacement() placement.Policy
+	getRestartPolicy() *task.RestartPolicy
 	setParent(role Updatable)
 	ProcessTemplates(workflowRepo *repos.Repo) error
 	GlobFilter(g glob.Glob) []Role
@@ -62,6 +66,8 @@ type Updatable interface {
 	GetEnvironmentId() uuid.Array
 	GetPath() string
 	CollectOutboundChannels() []channel.Outbound
+	GetVars() task.VarMap
+	GetCurrentRunNumber() uint32
 }
 
 type copyable interface {
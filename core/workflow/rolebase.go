@@ -32,11 +32,11 @@ import (
 
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/AliceO2Group/Control/core/task/channel"
-	"github.com/jinzhu/copier"
 	"github.com/sirupsen/logrus"
 
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/placement"
 	"github.com/pborman/uuid"
 )
 
@@ -54,6 +54,10 @@ type roleBase struct {
 	Vars        task.VarMap             `yaml:"vars,omitempty"`
 	Connect     []channel.Outbound      `yaml:"connect,omitempty"`
 	Constraints constraint.Constraints  `yaml:"constraints,omitempty"`
+	DependsOn   []string                `yaml:"dependsOn,omitempty"`
+	Placement   placement.Policy        `yaml:"placement,omitempty"`
+	Restart     *task.RestartPolicy     `yaml:"restart,omitempty"`
+	iteratorVars task.VarMap // the values of the iterators which generated this role
 	status      SafeStatus
 	state       SafeState
 }
@@ -88,15 +92,15 @@ func (r *roleBase) UnmarshalYAML(unmarshal func(interface{}) error) (err error)
 	return
 }
 
-func (r *roleBase) resolveOutboundChannelTargets() {
-	// TODO this func should return err
-
+// pathFuncMap returns the template functions available to role fields which
+// refer to other roles by path, i.e. outbound channel targets and dependencies.
+func (r *roleBase) pathFuncMap() template.FuncMap {
 	type _parentRole interface {
 		GetParent() Updatable
 		GetPath() string
 	}
 
-	funcMap := template.FuncMap{
+	return template.FuncMap{
 		"this": func() string {
 			return r.GetPath()
 		},
@@ -123,6 +127,12 @@ func (r *roleBase) resolveOutboundChannelTargets() {
 			return p.GetPath()
 		},
 	}
+}
+
+func (r *roleBase) resolveOutboundChannelTargets() {
+	// TODO this func should return err
+
+	funcMap := r.pathFuncMap()
 
 	for i, ch := range r.Connect {
 		tmpl := template.New(r.GetPath())
@@ -142,6 +152,68 @@ func (r *roleBase) resolveOutboundChannelTargets() {
 	}
 }
 
+func (r *roleBase) resolveDependencies() {
+	for i, dep := range r.DependsOn {
+		if resolved, ok := r.resolvePathTemplate("dependsOn", dep); ok {
+			r.DependsOn[i] = resolved
+		}
+	}
+}
+
+// resolvePlacement resolves the role paths of the affinity rules, and makes
+// this role the placement group of its strategy, if it declares one.
+func (r *roleBase) resolvePlacement() {
+	for i, aff := range r.Placement.Affinity {
+		if resolved, ok := r.resolvePathTemplate("affinity", aff); ok {
+			r.Placement.Affinity[i] = resolved
+		}
+	}
+	for i, aff := range r.Placement.AntiAffinity {
+		if resolved, ok := r.resolvePathTemplate("antiAffinity", aff); ok {
+			r.Placement.AntiAffinity[i] = resolved
+		}
+	}
+	// Roles generated by an iterator already have their group and unit
+	if r.Placement.Strategy != placement.Default && len(r.Placement.Group) == 0 {
+		r.Placement.Group = r.GetPath()
+	}
+}
+
+// setPlacementGroup makes the strategy declared by this role, if any, apply to
+// a group wider than the role itself, this role being a unit of the group.
+func (r *roleBase) setPlacementGroup(group string, unit string) {
+	if r.Placement.Strategy != placement.Default {
+		r.Placement.Group = group
+		r.Placement.Unit = unit
+	}
+}
+
+// resolvePathTemplate executes a role path template with the pathFuncMap, and
+// logs any failure as an error in the role field named by kind.
+// The vars of the role are also available, so that roles generated by an
+// iterator can refer to the iterator variable, as in readout{{ .it }}; the
+// iterator variables take precedence over any var of the same name.
+func (r *roleBase) resolvePathTemplate(kind string, str string) (resolved string, ok bool) {
+	tmpl := template.New(r.GetPath())
+	parsed, err := tmpl.Funcs(r.pathFuncMap()).Parse(str)
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{"role": r.GetPath(), kind: str}).Error("cannot parse role path template")
+		return
+	}
+	buf := new(bytes.Buffer)
+	vars := r.GetVars()
+	_, _, iteratorVars := r.collectVars()
+	for k, v := range iteratorVars {
+		vars[k] = v
+	}
+	err = parsed.Execute(buf, vars)
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{"role": r.GetPath(), kind: str}).Error("cannot execute role path template")
+		return
+	}
+	return buf.String(), true
+}
+
 func (r *roleBase) copy() copyable {
 	rCopy := roleBase{
 		Name: r.Name,
@@ -149,13 +221,27 @@ func (r *roleBase) copy() copyable {
 		Vars: make(task.VarMap),
 		Connect: make([]channel.Outbound, len(r.Connect)),
 		Constraints: make(constraint.Constraints, len(r.Constraints)),
+		DependsOn: make([]string, len(r.DependsOn)),
+		Placement: placement.Policy{
+			Strategy: r.Placement.Strategy,
+			Affinity: append([]string{}, r.Placement.Affinity...),
+			AntiAffinity: append([]string{}, r.Placement.AntiAffinity...),
+			Group: r.Placement.Group,
+			Unit: r.Placement.Unit,
+		},
 		status: r.status,
 		state: r.state,
 	}
 
-	err := copier.Copy(&rCopy.Vars, &r.Vars)
-	if err != nil {
-		log.WithField("role", r.GetPath()).WithError(err).Error("role copy error")
+	// NOTE: copier.Copy would make the copy share the same map
+	for k, v := range r.Vars {
+		rCopy.Vars[k] = v
+	}
+	if r.iteratorVars != nil {
+		rCopy.iteratorVars = make(task.VarMap)
+		for k, v := range r.iteratorVars {
+			rCopy.iteratorVars[k] = v
+		}
 	}
 
 	copied := copy(rCopy.Connect, r.Connect)
@@ -172,6 +258,18 @@ func (r *roleBase) copy() copyable {
 			Error("role copy error")
 	}
 
+	copied = copy(rCopy.DependsOn, r.DependsOn)
+	if copied != len(r.DependsOn) {
+		log.WithField("role", r.GetPath()).
+			WithError(fmt.Errorf("slice copy copied %d items, %d expected", copied, len(r.DependsOn))).
+			Error("role copy error")
+	}
+
+	if r.Restart != nil {
+		restart := *r.Restart
+		rCopy.Restart = &restart
+	}
+
 	return &rCopy
 }
 
@@ -211,6 +309,60 @@ func (r* roleBase) GetEnvironmentId() uuid.Array {
 	return r.parent.GetEnvironmentId()
 }
 
+func (r *roleBase) GetCurrentRunNumber() uint32 {
+	if r.parent == nil {
+		return 0
+	}
+	return r.parent.GetCurrentRunNumber()
+}
+
+// varCollector is implemented by all roles, and lets a role gather the vars
+// of its ancestors separately from those of the environment.
+type varCollector interface {
+	collectVars() (roleVars task.VarMap, envVars task.VarMap, iteratorVars task.VarMap)
+}
+
+// collectVars returns the vars of this role merged with those of its ancestor
+// roles, with the vars of a role taking precedence over those of its parent,
+// and separately the vars of the environment at the root of the tree and the
+// iterator variables of the roles generated by an iterator.
+func (r *roleBase) collectVars() (roleVars task.VarMap, envVars task.VarMap, iteratorVars task.VarMap) {
+	if parentRole, ok := r.parent.(varCollector); ok {
+		roleVars, envVars, iteratorVars = parentRole.collectVars()
+	} else {
+		roleVars = make(task.VarMap)
+		iteratorVars = make(task.VarMap)
+		if r.parent != nil {
+			envVars = r.parent.GetVars()
+		}
+	}
+	for k, v := range r.Vars {
+		roleVars[k] = v
+	}
+	for k, v := range r.iteratorVars {
+		iteratorVars[k] = v
+	}
+	return
+}
+
+// GetVars returns the vars of this role merged with those of its ancestors,
+// with the vars of a role taking precedence over those of its parent, and the
+// vars of the environment taking precedence over those of any role.
+func (r *roleBase) GetVars() (vars task.VarMap) {
+	vars = make(task.VarMap)
+	if r == nil {
+		return
+	}
+	roleVars, envVars, _ := r.collectVars()
+	for k, v := range roleVars {
+		vars[k] = v
+	}
+	for k, v := range envVars {
+		vars[k] = v
+	}
+	return
+}
+
 func (r *roleBase) GetPath() string {
 	if r == nil {
 		return ""
@@ -261,4 +413,49 @@ func (r *roleBase) getConstraints() (cts constraint.Constraints) {
 	}
 
 	return
-}
\ No newline at end of file
+}
+
+// getDependencies returns the role path patterns this role depends on, including
+// those inherited from its ancestors.
+func (r *roleBase) getDependencies() (deps []string) {
+	if r == nil {
+		return
+	}
+
+	deps = make([]string, len(r.DependsOn))
+	copy(deps, r.DependsOn)
+
+	if parentRole := r.GetParentRole(); parentRole != nil {
+		deps = append(deps, parentRole.getDependencies()...)
+	}
+	return
+}
+
+// getRestartPolicy returns the restart policy of this role, or else of its
+// nearest ancestor which has one, or nil.
+func (r *roleBase) getRestartPolicy() *task.RestartPolicy {
+	if r == nil {
+		return nil
+	}
+	if r.Restart != nil {
+		restart := *r.Restart
+		return &restart
+	}
+	if parentRole := r.GetParentRole(); parentRole != nil {
+		return parentRole.getRestartPolicy()
+	}
+	return nil
+}
+
+// getPlacement returns the placement policy of this role, merged with those
+// of its ancestors.
+func (r *roleBase) getPlacement() (policy placement.Policy) {
+	if r == nil {
+		return
+	}
+	policy = r.Placement.MergeParent(placement.Policy{})
+	if parentRole := r.GetParentRole(); parentRole != nil {
+		policy = r.Placement.MergeParent(parentRole.getPlacement())
+	}
+	return
+}
@@ -0,0 +1,147 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/gobwas/glob"
+)
+
+// TaskStages splits the tasks of a role tree into ordered stages, according to
+// the dependsOn declarations of its roles.
+// The tasks in each stage only depend on tasks in earlier stages, so a forward
+// transition can be committed one stage at a time, and a reverse transition
+// (STOP, RESET) on the same stages in reverse order.
+func TaskStages(root Role) (stages task.Stages, err error) {
+	var roleStages [][]*taskRole
+	roleStages, err = taskRoleStages(root)
+	if err != nil {
+		return
+	}
+
+	stages = make(task.Stages, 0, len(roleStages))
+	for _, roleStage := range roleStages {
+		stage := make(task.Tasks, 0, len(roleStage))
+		for _, tr := range roleStage {
+			if taskPtr := tr.GetTask(); taskPtr != nil {
+				stage = append(stage, taskPtr)
+			}
+		}
+		if len(stage) != 0 {
+			stages = append(stages, stage)
+		}
+	}
+	return
+}
+
+// taskRoleStages groups the task roles of a role tree by dependency depth: a
+// task role with no dependencies is in stage 0, and any other task role is one
+// stage after its deepest dependency.
+// A role's dependencies are inherited by all its descendants, and a dependency
+// on a non-task role is a dependency on all the task roles below it.
+func taskRoleStages(root Role) (stages [][]*taskRole, err error) {
+	if root == nil {
+		return nil, fmt.Errorf("cannot compute stages of nil role")
+	}
+
+	taskRoles := collectTaskRoles(root)
+
+	deps := make(map[*taskRole][]*taskRole)
+	for _, tr := range taskRoles {
+		for _, pattern := range tr.getDependencies() {
+			var g glob.Glob
+			g, err = glob.Compile(pattern, PATH_SEPARATOR_RUNE)
+			if err != nil {
+				err = fmt.Errorf("role %s has invalid dependency %s: %s", tr.GetPath(), pattern, err.Error())
+				return
+			}
+			matched := root.GlobFilter(g)
+			if len(matched) == 0 {
+				err = fmt.Errorf("role %s depends on %s, which matches no role", tr.GetPath(), pattern)
+				return
+			}
+			for _, role := range matched {
+				for _, dep := range collectTaskRoles(role) {
+					if dep != tr {
+						deps[tr] = append(deps[tr], dep)
+					}
+				}
+			}
+		}
+	}
+
+	depth := make(map[*taskRole]int)
+	visiting := make(map[*taskRole]bool)
+	var visit func(tr *taskRole) (int, error)
+	visit = func(tr *taskRole) (int, error) {
+		if d, ok := depth[tr]; ok {
+			return d, nil
+		}
+		if visiting[tr] {
+			return 0, fmt.Errorf("dependency cycle involving role %s", tr.GetPath())
+		}
+		visiting[tr] = true
+		d := 0
+		for _, dep := range deps[tr] {
+			depDepth, err := visit(dep)
+			if err != nil {
+				return 0, err
+			}
+			if depDepth + 1 > d {
+				d = depDepth + 1
+			}
+		}
+		visiting[tr] = false
+		depth[tr] = d
+		return d, nil
+	}
+
+	stages = make([][]*taskRole, 0)
+	for _, tr := range taskRoles {
+		var d int
+		d, err = visit(tr)
+		if err != nil {
+			return nil, err
+		}
+		for len(stages) <= d {
+			stages = append(stages, make([]*taskRole, 0))
+		}
+		stages[d] = append(stages[d], tr)
+	}
+	return
+}
+
+func collectTaskRoles(role Role) (taskRoles []*taskRole) {
+	taskRoles = make([]*taskRole, 0)
+	if tr, ok := role.(*taskRole); ok {
+		return append(taskRoles, tr)
+	}
+	for _, child := range role.GetRoles() {
+		taskRoles = append(taskRoles, collectTaskRoles(child)...)
+	}
+	return
+}
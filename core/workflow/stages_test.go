@@ -0,0 +1,156 @@
+package workflow
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+func loadRoleTree(doc string) Role {
+	root := new(aggregatorRole)
+	Expect(yaml.Unmarshal([]byte(doc), root)).To(Succeed())
+	return root
+}
+
+func stagePaths(stages [][]*taskRole) (paths [][]string) {
+	paths = make([][]string, 0, len(stages))
+	for _, stage := range stages {
+		stagePaths := make([]string, 0, len(stage))
+		for _, tr := range stage {
+			stagePaths = append(stagePaths, tr.GetPath())
+		}
+		paths = append(paths, stagePaths)
+	}
+	return
+}
+
+var _ = Describe("task stages", func() {
+	It("should put all task roles in one stage without dependencies", func() {
+		root := loadRoleTree(`
+name: wf
+roles:
+  - name: a
+    task:
+      load: qc
+  - name: b
+    task:
+      load: qc
+`)
+		stages, err := taskRoleStages(root)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stagePaths(stages)).To(Equal([][]string{{"wf.a", "wf.b"}}))
+	})
+
+	It("should put a task role one stage after its deepest dependency", func() {
+		root := loadRoleTree(`
+name: wf
+roles:
+  - name: c
+    dependsOn: ["wf.a", "wf.b"]
+    task:
+      load: qc
+  - name: b
+    dependsOn: ["wf.a"]
+    task:
+      load: qc
+  - name: a
+    task:
+      load: qc
+`)
+		stages, err := taskRoleStages(root)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stagePaths(stages)).To(Equal([][]string{{"wf.a"}, {"wf.b"}, {"wf.c"}}))
+	})
+
+	It("should inherit dependencies and expand dependencies on aggregator roles", func() {
+		root := loadRoleTree(`
+name: wf
+roles:
+  - name: flp
+    roles:
+      - name: readout
+        task:
+          load: readout
+      - name: stfb
+        task:
+          load: stfb
+  - name: qc
+    dependsOn: ["wf.flp"]
+    roles:
+      - name: merger
+        task:
+          load: merger
+`)
+		stages, err := taskRoleStages(root)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stagePaths(stages)).To(Equal([][]string{{"wf.flp.readout", "wf.flp.stfb"}, {"wf.qc.merger"}}))
+	})
+
+	It("should match dependencies by glob", func() {
+		root := loadRoleTree(`
+name: wf
+roles:
+  - name: readout1
+    task:
+      load: readout
+  - name: readout2
+    task:
+      load: readout
+  - name: merger
+    dependsOn: ["wf.readout*"]
+    task:
+      load: merger
+`)
+		stages, err := taskRoleStages(root)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stagePaths(stages)).To(Equal([][]string{{"wf.readout1", "wf.readout2"}, {"wf.merger"}}))
+	})
+
+	It("should fail on a dependency cycle", func() {
+		root := loadRoleTree(`
+name: wf
+roles:
+  - name: a
+    dependsOn: ["wf.b"]
+    task:
+      load: qc
+  - name: b
+    dependsOn: ["wf.a"]
+    task:
+      load: qc
+`)
+		_, err := taskRoleStages(root)
+		Expect(err).To(MatchError(ContainSubstring("dependency cycle involving role")))
+	})
+
+	It("should fail on a dependency which matches no role", func() {
+		root := loadRoleTree(`
+name: wf
+roles:
+  - name: a
+    dependsOn: ["wf.missing"]
+    task:
+      load: qc
+`)
+		_, err := taskRoleStages(root)
+		Expect(err).To(MatchError("role wf.a depends on wf.missing, which matches no role"))
+	})
+
+	It("should skip task roles without tasks when building task stages", func() {
+		root := loadRoleTree(`
+name: wf
+roles:
+  - name: a
+    task:
+      load: qc
+`)
+		stages, err := TaskStages(root)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stages).To(BeEmpty())
+	})
+
+	It("should fail on a nil role", func() {
+		_, err := taskRoleStages(nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
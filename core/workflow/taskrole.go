@@ -35,12 +35,23 @@ type taskRole struct {
 	roleBase
 	Task          *task.Task `yaml:"-,omitempty"`
 	LoadTaskClass string     `yaml:"-,omitempty"`
+
+	// Per-role overrides of the task class: wants replace the class wants,
+	// arguments and env vars are appended to the class command
+	Wants          task.ResourceWants `yaml:"-,omitempty"`
+	ExtraArguments []string           `yaml:"-,omitempty"`
+	ExtraEnv       []string           `yaml:"-,omitempty"`
 }
 
 func (t *taskRole) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 	aux := struct{
 		Task struct{
-			Load string
+			Load    string
+			Wants   task.ResourceWants
+			Command struct{
+				Arguments []string
+				Env       []string
+			}
 		}
 	}{}
 
@@ -58,6 +69,9 @@ func (t *taskRole) UnmarshalYAML(unmarshal func(interface{}) error) (err error)
 	}
 
 	role.LoadTaskClass = aux.Task.Load
+	role.Wants = aux.Task.Wants
+	role.ExtraArguments = aux.Task.Command.Arguments
+	role.ExtraEnv = aux.Task.Command.Env
 	*t = taskRole(role)
 	return
 }
@@ -76,6 +90,8 @@ func (t *taskRole) ProcessTemplates(workflowRepo *repos.Repo) (err error) {
 
 	t.resolveTaskClassIdentifier(workflowRepo)
 	t.resolveOutboundChannelTargets()
+	t.resolveDependencies()
+	t.resolvePlacement()
 
 	return
 }
@@ -116,8 +132,15 @@ func (t *taskRole) SetTask(taskPtr *task.Task) {
 func (t *taskRole) copy() copyable {
 	rCopy := taskRole{
 		roleBase:      *t.roleBase.copy().(*roleBase),
-		Task:          nil,
-		LoadTaskClass: t.LoadTaskClass,
+		Task:           nil,
+		LoadTaskClass:  t.LoadTaskClass,
+		Wants:          t.Wants,
+		ExtraArguments: append([]string{}, t.ExtraArguments...),
+		ExtraEnv:       append([]string{}, t.ExtraEnv...),
+	}
+	if t.Wants.Ports != nil {
+		rCopy.Wants.Ports = make(task.Ranges, len(t.Wants.Ports))
+		copy(rCopy.Wants.Ports, t.Wants.Ports)
 	}
 	rCopy.status = SafeStatus{status:task.INACTIVE}
 	rCopy.state  = SafeState{state:task.STANDBY}
@@ -138,6 +161,11 @@ func (t *taskRole) GenerateTaskDescriptors() (ds task.Descriptors) {
 		TaskRole: t,
 		TaskClassName: t.LoadTaskClass,
 		RoleConstraints: t.getConstraints(),
+		RolePlacement: t.getPlacement(),
+		RoleWants: t.Wants,
+		CmdExtraEnv: append([]string{}, t.ExtraEnv...),
+		CmdExtraArguments: append([]string{}, t.ExtraArguments...),
+		RoleRestart: t.getRestartPolicy(),
 	}}
 	return
 }
@@ -197,7 +225,3 @@ func (*taskRole) GetRoles() []Role {
 func (t *taskRole) setParent(role Updatable) {
 	t.parent = role
 }
-
-func (t *taskRole) GetVars() task.VarMap {
-	panic("niy")
-}
\ No newline at end of file
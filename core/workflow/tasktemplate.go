@@ -54,10 +54,13 @@ func (tt *taskTemplate) UnmarshalYAML(unmarshal func(interface{}) error) (err er
 	role.stringTemplates = make(map[string]template.Template)
 
 	// Fields to parse as templates:
-	for _, str := range []string{
+	fields := []string{
 		role.LoadTaskClass,
 		role.Name,
-	} {
+	}
+	fields = append(fields, role.ExtraArguments...)
+	fields = append(fields, role.ExtraEnv...)
+	for _, str := range fields {
 		var tempTmpl *template.Template
 		tempTmpl, err = tmpl.Parse(str)
 		if err != nil {
@@ -79,11 +82,20 @@ func (tt *taskTemplate) generateRole(t templateMap) (c Role, err error) {
 	tr := *tt.taskRole.copy().(*taskRole)
 
 	tf := templateFields{&tr.Name, &tr.LoadTaskClass}
+	for i := range tr.ExtraArguments {
+		tf = append(tf, &tr.ExtraArguments[i])
+	}
+	for i := range tr.ExtraEnv {
+		tf = append(tf, &tr.ExtraEnv[i])
+	}
 	err = tf.execute(tt.GetPath(), t, tt.stringTemplates)
 	if err != nil {
 		return
 	}
 
+	tr.Vars = t.mergeInto(tr.Vars)
+	tr.iteratorVars = t.vars()
+
 	c = &tr
 	return
 }
\ No newline at end of file
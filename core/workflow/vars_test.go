@@ -0,0 +1,95 @@
+package workflow
+
+import (
+	"github.com/AliceO2Group/Control/core/repos"
+	"github.com/AliceO2Group/Control/core/task"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pborman/uuid"
+)
+
+var _ = Describe("role vars", func() {
+	var (
+		root Role
+		leaf Role
+	)
+
+	BeforeEach(func() {
+		root = loadRoleTree(`
+name: wf
+vars:
+  detector: TPC
+  rate: "10"
+  mode: physics
+roles:
+  - name: readout
+    vars:
+      rate: "20"
+      mode: cosmics
+    task:
+      load: readout
+`)
+		leaf = root.GetRoles()[0]
+	})
+
+	It("should give the vars of a role precedence over those of its ancestors", func() {
+		Expect(leaf.(Updatable).GetVars()).To(Equal(task.VarMap{
+			"detector": "TPC",
+			"rate":     "20",
+			"mode":     "cosmics",
+		}))
+	})
+
+	It("should give the environment vars precedence over those of any role", func() {
+		root.(*aggregatorRole).setParent(NewParentAdapter(
+			func() uuid.Array { return uuid.NIL.Array() },
+			func() uint32 { return 0 },
+			func() task.VarMap { return task.VarMap{"mode": "technical", "run_type": "test"} },
+		))
+
+		Expect(leaf.(Updatable).GetVars()).To(Equal(task.VarMap{
+			"detector": "TPC",
+			"rate":     "20",
+			"mode":     "technical",
+			"run_type": "test",
+		}))
+		Expect(root.(Updatable).GetVars()).To(Equal(task.VarMap{
+			"detector": "TPC",
+			"rate":     "10",
+			"mode":     "technical",
+			"run_type": "test",
+		}))
+	})
+})
+
+var _ = Describe("path templates", func() {
+	It("should give the iterator variables precedence over any var of the same name", func() {
+		root := loadRoleTree(`
+name: wf
+vars:
+  it: role
+roles:
+  - name: "qc{{ .it }}"
+    for:
+      values: [tpc, its]
+      var: it
+    dependsOn: ["wf.readout{{ .it }}"]
+    task:
+      load: qc
+`)
+		root.(*aggregatorRole).setParent(NewParentAdapter(
+			func() uuid.Array { return uuid.NIL.Array() },
+			func() uint32 { return 0 },
+			func() task.VarMap { return task.VarMap{"it": "env"} },
+		))
+		repo, err := repos.NewRepo("github.com/AliceO2Group/ControlWorkflows")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(root.ProcessTemplates(repo)).To(Succeed())
+
+		taskRoles := collectTaskRoles(root)
+		Expect(taskRoles).To(HaveLen(2))
+		Expect(taskRoles[0].DependsOn).To(Equal([]string{"wf.readouttpc"}))
+		Expect(taskRoles[1].DependsOn).To(Equal([]string{"wf.readoutits"}))
+		Expect(taskRoles[0].GetVars()).To(HaveKeyWithValue("it", "env"))
+	})
+})
@@ -38,8 +38,6 @@ import (
 	"io"
 	"net/url"
 	"os"
-	"os/exec"
-	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -119,6 +117,9 @@ func Run(cfg config.Config) {
 			failedTasks:    make(map[mesos.TaskID]mesos.TaskStatus),
 			killedTasks:    make(map[mesos.TaskID]mesos.TaskStatus),
 			rpcClients:     make(map[mesos.TaskID]*executorcmd.RpcClient),
+			taskLabels:     make(map[mesos.TaskID]*mesos.Labels),
+			containerized:  make(map[mesos.TaskID]bool),
+			containerRuntime: os.Getenv(common.CONTAINER_RUNTIME_ENV),
 		}
 		subscriber = calls.SenderWith(
 			// Here too, callOptions for all outgoing subscriber calls
@@ -384,6 +385,9 @@ func handleMessage(state *internalState, data []byte) (err error) {
 					"state": response.CurrentState,
 				}).
 				Debug("response sent")
+			if transitionError == nil {
+				reportState(state, taskId, newState)
+			}
 		}()
 	default:
 		err = errors.New(fmt.Sprintf("unrecognized controlcommand %s", incoming.Name))
@@ -400,6 +404,9 @@ func launch(state *internalState, task mesos.TaskInfo) {
 	log.WithField("payload", fmt.Sprintf("%s", jsonTask[:])).Debug("received task to launch")
 
 	status := newStatus(state, task.TaskID)
+	// The task reaches STANDBY before we send TASK_RUNNING
+	status.Labels = common.WithTaskLabel(task.GetLabels(), common.TASK_LABEL_STATE, "STANDBY")
+	state.taskLabels[task.TaskID] = task.GetLabels()
 
 	var commandInfo common.TaskCommandInfo
 
@@ -426,27 +433,27 @@ func launch(state *internalState, task mesos.TaskInfo) {
 		state.mu.Unlock()
 		return
 	}
+	containerRuntime := state.containerRuntime
 	state.mu.Unlock()
 
-	var taskCmd *exec.Cmd
-	if *commandInfo.Shell {
-		rawCommand := strings.Join(append([]string{*commandInfo.Value}, commandInfo.Arguments...), " ")
-		taskCmd = exec.Command("/bin/sh", []string{"-c", rawCommand}...)
-	} else {
-		taskCmd = exec.Command(*commandInfo.Value, commandInfo.Arguments...)
-	}
-	taskCmd.Env = append(os.Environ(), commandInfo.Env...)
-
-	// We must setpgid(2) in order to be able to kill the whole process group which consists of
-	// the containing shell and all of its children
-	taskCmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-
 	var errStdout, errStderr error
-	stdoutIn, _ := taskCmd.StdoutPipe()
-	stderrIn, _ := taskCmd.StderrPipe()
+	var stdoutIn, stderrIn io.ReadCloser
+	taskCmd, err := executorcmd.NewTaskCmd(task.TaskID.Value, commandInfo, containerRuntime)
+	if err == nil {
+		if commandInfo.Container != nil {
+			log.WithFields(logrus.Fields{
+					"image":   commandInfo.Container.Image,
+					"runtime": containerRuntime,
+					"task":    task.Name,
+				}).
+				Info("task runs in a container")
+		}
+		stdoutIn, _ = taskCmd.StdoutPipe()
+		stderrIn, _ = taskCmd.StderrPipe()
 
-	log.WithField("payload", string(task.GetData()[:])).WithField("task", task.Name).Debug("starting task")
-	err := taskCmd.Start()
+		log.WithField("payload", string(task.GetData()[:])).WithField("task", task.Name).Debug("starting task")
+		err = taskCmd.Start()
+	}
 	if err != nil {
 		log.WithFields(logrus.Fields{
 				"id":      task.TaskID.Value,
@@ -481,6 +488,9 @@ func launch(state *internalState, task mesos.TaskInfo) {
 		Debug("starting gRPC client")
 	state.rpcClients[task.TaskID] = executorcmd.NewClient(commandInfo.ControlPort, commandInfo.ControlMode)
 	state.rpcClients[task.TaskID].TaskCmd = taskCmd
+	if commandInfo.Container != nil {
+		state.containerized[task.TaskID] = true
+	}
 	state.mu.Unlock()
 
 	go func() {
@@ -522,6 +532,9 @@ func launch(state *internalState, task mesos.TaskInfo) {
 
 				log.Debug("unlocking state")
 				state.mu.Unlock()
+				if commandInfo.Container != nil {
+					stopTaskContainer(task.TaskID, containerRuntime)
+				}
 				return
 			} else if elapsed >= startupTimeout {
 				err = errors.New("timeout while waiting for task startup")
@@ -597,6 +610,9 @@ func launch(state *internalState, task mesos.TaskInfo) {
 				}
 			}
 			state.mu.Unlock()
+			if commandInfo.Container != nil {
+				stopTaskContainer(task.TaskID, containerRuntime)
+			}
 			return
 		}
 		state.mu.Unlock()
@@ -657,6 +673,8 @@ func launch(state *internalState, task mesos.TaskInfo) {
 			delete(state.rpcClients, task.TaskID)
 			log.Debug("rpc client removed")
 		}
+		delete(state.taskLabels, task.TaskID)
+		delete(state.containerized, task.TaskID)
 
 		if _, ok := state.killedTasks[task.TaskID]; !ok && err != nil {
 			log.WithFields(logrus.Fields{
@@ -723,6 +741,8 @@ func kill(state *internalState, e *executor.Event_Kill) error {
 	}
 	// NOTE: we acquire the transitioner-dependent STANDBY equivalent state
 	reachedState := rpcClient.FromDeviceState(response.GetState())
+	containerized := state.containerized[e.GetTaskID()]
+	containerRuntime := state.containerRuntime
 	state.mu.RUnlock()
 
 	nextTransition := func(currentState string) (exc *executorcmd.ExecutorCommand_Transition) {
@@ -786,6 +806,10 @@ func kill(state *internalState, e *executor.Event_Kill) error {
 
 	log.Debug("end transition loop done")
 
+	if containerized {
+		stopTaskContainer(e.GetTaskID(), containerRuntime)
+	}
+
 	state.mu.Lock()
 	log.Debug("state locked")
 
@@ -812,6 +836,15 @@ func kill(state *internalState, e *executor.Event_Kill) error {
 	return err
 }
 
+// stopTaskContainer stops the container of a task which runs in one, since
+// killing the process group of the task leaves its container running.
+func stopTaskContainer(taskId mesos.TaskID, containerRuntime string) {
+	err := executorcmd.StopContainer(taskId.Value, containerRuntime)
+	if err != nil {
+		log.WithError(err).WithField("taskId", taskId.Value).Warning("could not stop task container")
+	}
+}
+
 // helper func to package strings up nicely for protobuf
 func protoString(s string) *string { return &s }
 
@@ -837,6 +870,26 @@ func update(state *internalState, status mesos.TaskStatus) error {
 	return err
 }
 
+// reportState sends a TASK_RUNNING update which carries the labels of the task
+// along with its current state. Mesos keeps the latest status of each task, so
+// that the core can rebuild its roster through reconciliation after a restart.
+// The caller must hold the lock on state.
+func reportState(state *internalState, taskId mesos.TaskID, taskState string) {
+	labels, ok := state.taskLabels[taskId]
+	if !ok {
+		return
+	}
+	status := newStatus(state, taskId)
+	status.State = mesos.TASK_RUNNING.Enum()
+	status.Labels = common.WithTaskLabel(labels, common.TASK_LABEL_STATE, taskState)
+	err := update(state, status)
+	if err != nil {
+		log.WithError(err).
+			WithField("id", taskId.Value).
+			Warning("cannot report task state")
+	}
+}
+
 // newStatus constructs a new mesos.TaskStatus to describe a task.
 func newStatus(state *internalState, id mesos.TaskID) mesos.TaskStatus {
 	return mesos.TaskStatus{
@@ -860,5 +913,8 @@ type internalState struct {
 	failedTasks    map[mesos.TaskID]mesos.TaskStatus // send updates for these as we can
 	killedTasks    map[mesos.TaskID]mesos.TaskStatus
 	rpcClients     map[mesos.TaskID]*executorcmd.RpcClient
+	taskLabels     map[mesos.TaskID]*mesos.Labels // as received at launch, see reportState
+	containerRuntime string // for tasks which run in a container, set by the core
+	containerized  map[mesos.TaskID]bool // tasks which run in a container, see stopTaskContainer
 	shouldQuit     bool
 }
@@ -0,0 +1,85 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executorcmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/AliceO2Group/Control/common"
+)
+
+// NewTaskCmd returns the command which runs a task process.
+// A task without a container runs directly on the host, while a task whose
+// class declares a container image runs through containerRuntime, i.e. the
+// path of a Docker-compatible container runtime such as docker or podman.
+func NewTaskCmd(taskId string, commandInfo common.TaskCommandInfo, containerRuntime string) (taskCmd *exec.Cmd, err error) {
+	if commandInfo.Value == nil {
+		return nil, fmt.Errorf("no command value for task %s", taskId)
+	}
+	command := append([]string{*commandInfo.Value}, commandInfo.Arguments...)
+	if commandInfo.Shell != nil && *commandInfo.Shell {
+		command = []string{"/bin/sh", "-c", strings.Join(command, " ")}
+	}
+
+	if commandInfo.Container == nil {
+		taskCmd = exec.Command(command[0], command[1:]...)
+		taskCmd.Env = append(os.Environ(), commandInfo.Env...)
+	} else {
+		if len(containerRuntime) == 0 {
+			return nil, fmt.Errorf("task %s wants container image %s, but no container runtime is configured",
+				taskId, commandInfo.Container.Image)
+		}
+		// The environment of the task goes to the container, while the runtime
+		// itself only needs ours.
+		taskCmd = exec.Command(containerRuntime,
+			commandInfo.Container.RuntimeArguments(taskId, commandInfo.Env, command)...)
+		taskCmd.Env = os.Environ()
+	}
+
+	// We must setpgid(2) in order to be able to kill the whole process group which consists of
+	// the containing shell and all of its children
+	taskCmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	return
+}
+
+// StopContainer stops the container in which NewTaskCmd runs a task, which is
+// named after the task. Killing the process group of the task only ends the
+// runtime client, while the container keeps running.
+func StopContainer(taskId string, containerRuntime string) (err error) {
+	err = exec.Command(containerRuntime, "stop", taskId).Run()
+	if err != nil {
+		// The container is started with --rm, so it is only left behind if it
+		// cannot be stopped
+		err = exec.Command(containerRuntime, "rm", "--force", taskId).Run()
+	}
+	return
+}
@@ -0,0 +1,142 @@
+package executorcmd_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common"
+	. "github.com/AliceO2Group/Control/executor/executorcmd"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// The stand-in container runtime prints the arguments it is run with, one per
+// line, instead of running a container.
+const standInRuntime = `#!/bin/sh
+for arg in "$@"; do
+	echo "$arg"
+done
+`
+
+// The recording container runtime appends its arguments to the calls file next
+// to it, and fails to stop containers if the stuck file exists.
+const recordingRuntime = `#!/bin/sh
+dir=$(dirname "$0")
+echo "$@" >> "$dir/calls"
+if [ "$1" = stop ] && [ -e "$dir/stuck" ]; then
+	exit 1
+fi
+`
+
+func taskCommand(shell bool, value string, args ...string) common.TaskCommandInfo {
+	return common.TaskCommandInfo{
+		CommandInfo: common.CommandInfo{
+			Env:       []string{"O2_TEST=1"},
+			Shell:     &shell,
+			Value:     &value,
+			Arguments: args,
+		},
+	}
+}
+
+func outputLines(out []byte) []string {
+	return strings.Split(strings.TrimSpace(string(out)), "\n")
+}
+
+var _ = Describe("task commands", func() {
+	var (
+		tmpDir  string
+		runtime string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "executorcmd")
+		Expect(err).NotTo(HaveOccurred())
+		runtime = filepath.Join(tmpDir, "runtime")
+		Expect(ioutil.WriteFile(runtime, []byte(standInRuntime), 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("should run tasks without a container on the host", func() {
+		cmd, err := NewTaskCmd("task-1", taskCommand(false, "/bin/sh", "-c", "echo $O2_TEST"), runtime)
+		Expect(err).NotTo(HaveOccurred())
+		out, err := cmd.Output()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outputLines(out)).To(Equal([]string{"1"}))
+	})
+
+	It("should run containerized tasks through the container runtime", func() {
+		tci := taskCommand(false, "readout.exe", "--config", "file:/etc/readout.cfg")
+		tci.Container = &common.ContainerInfo{
+			Image: "registry.example.org/readout:v1",
+			Volumes: []common.ContainerVolume{
+				{Host: "/etc/readout.cfg", Container: "/etc/readout.cfg", Mode: "ro"},
+				{Host: "/data", Container: "/data"},
+			},
+			Devices:    []string{"/dev/infiniband/uverbs0"},
+			Privileged: true,
+		}
+		cmd, err := NewTaskCmd("task-2", tci, runtime)
+		Expect(err).NotTo(HaveOccurred())
+		out, err := cmd.Output()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outputLines(out)).To(Equal([]string{
+			"run", "--rm", "--name", "task-2", "--network", "host",
+			"--privileged",
+			"--volume", "/etc/readout.cfg:/etc/readout.cfg:ro",
+			"--volume", "/data:/data",
+			"--device", "/dev/infiniband/uverbs0",
+			"--env", "O2_TEST=1",
+			"registry.example.org/readout:v1",
+			"readout.exe", "--config", "file:/etc/readout.cfg",
+		}))
+	})
+
+	It("should run shell commands through a shell inside the container", func() {
+		tci := taskCommand(true, "readout.exe", "--config", "$CONFIG")
+		tci.Container = &common.ContainerInfo{Image: "readout:v1"}
+		cmd, err := NewTaskCmd("task-3", tci, runtime)
+		Expect(err).NotTo(HaveOccurred())
+		out, err := cmd.Output()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outputLines(out)[8:]).To(Equal([]string{
+			"readout:v1", "/bin/sh", "-c", "readout.exe --config $CONFIG",
+		}))
+	})
+
+	It("should refuse containerized tasks without a container runtime", func() {
+		tci := taskCommand(false, "readout.exe")
+		tci.Container = &common.ContainerInfo{Image: "readout:v1"}
+		_, err := NewTaskCmd("task-4", tci, "")
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("stopping containers", func() {
+		calls := func() []string {
+			out, err := ioutil.ReadFile(filepath.Join(tmpDir, "calls"))
+			Expect(err).NotTo(HaveOccurred())
+			return outputLines(out)
+		}
+
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(runtime, []byte(recordingRuntime), 0755)).To(Succeed())
+		})
+
+		It("should stop the container named after the task", func() {
+			Expect(StopContainer("task-5", runtime)).To(Succeed())
+			Expect(calls()).To(Equal([]string{"stop task-5"}))
+		})
+
+		It("should force the removal of a container which cannot be stopped", func() {
+			Expect(ioutil.WriteFile(filepath.Join(tmpDir, "stuck"), nil, 0644)).To(Succeed())
+			Expect(StopContainer("task-6", runtime)).To(Succeed())
+			Expect(calls()).To(Equal([]string{"stop task-6", "rm --force task-6"}))
+		})
+	})
+})
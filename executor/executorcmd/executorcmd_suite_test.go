@@ -0,0 +1,13 @@
+package executorcmd_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestExecutorcmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Executorcmd Suite")
+}
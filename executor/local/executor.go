@@ -0,0 +1,449 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package local runs O² tasks as child processes of the current process,
+// without a Mesos agent. Tasks are controlled with the same OCC gRPC client and
+// transitioners as with the Mesos executor, and the executor talks back to its
+// owner with the same task statuses and JSON messages.
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	"github.com/AliceO2Group/Control/executor/protos"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	startupPollingInterval = 500 * time.Millisecond
+	startupTimeout         = 30 * time.Second
+)
+
+var log = logger.New(logrus.StandardLogger(), "localexecutor")
+
+// StatusFunc is called whenever the status of a task changes, with the same
+// statuses the Mesos executor would send as UPDATE.
+type StatusFunc func(status mesos.TaskStatus)
+
+// MessageFunc is called with the messages the Mesos executor would send as
+// MESSAGE, i.e. command responses and device events, JSON-serialized.
+type MessageFunc func(data []byte)
+
+type Executor struct {
+	mu          sync.RWMutex
+	agentId     mesos.AgentID
+	executorId  mesos.ExecutorID
+	rpcClients  map[mesos.TaskID]*executorcmd.RpcClient
+	killedTasks map[mesos.TaskID]chan mesos.TaskStatus // final status of tasks being killed
+	containerRuntime string // for tasks which run in a container, see executorcmd.NewTaskCmd
+	containerized map[mesos.TaskID]bool // tasks which run in a container, see stopContainer
+
+	sendStatus  StatusFunc
+	sendMessage MessageFunc
+}
+
+func NewExecutor(agentId mesos.AgentID, executorId mesos.ExecutorID, containerRuntime string, sendStatus StatusFunc, sendMessage MessageFunc) *Executor {
+	return &Executor{
+		agentId:     agentId,
+		executorId:  executorId,
+		containerRuntime: containerRuntime,
+		rpcClients:  make(map[mesos.TaskID]*executorcmd.RpcClient),
+		killedTasks: make(map[mesos.TaskID]chan mesos.TaskStatus),
+		containerized: make(map[mesos.TaskID]bool),
+		sendStatus:  sendStatus,
+		sendMessage: sendMessage,
+	}
+}
+
+// Launch starts the process described by commandInfo, and reports TASK_RUNNING
+// through the StatusFunc once the task is ready for control input.
+// It returns as soon as the process is started.
+func (e *Executor) Launch(taskId mesos.TaskID, name string, commandInfo common.TaskCommandInfo) error {
+	if commandInfo.Value == nil || commandInfo.Shell == nil {
+		return errors.New("incomplete command for task")
+	}
+
+	log.WithFields(logrus.Fields{
+			"shell": *commandInfo.Shell,
+			"value": *commandInfo.Value,
+			"args":  commandInfo.Arguments,
+			"task":  name,
+		}).
+		Info("launching task")
+
+	taskCmd, err := executorcmd.NewTaskCmd(taskId.Value, commandInfo, e.containerRuntime)
+	if err != nil {
+		return err
+	}
+	stdoutIn, _ := taskCmd.StdoutPipe()
+	stderrIn, _ := taskCmd.StderrPipe()
+
+	err = taskCmd.Start()
+	if err != nil {
+		return err
+	}
+	log.WithField("id", taskId.Value).WithField("task", name).Debug("task started")
+
+	go func() {
+		_, _ = io.Copy(log.WithPrefix("task-stdout").WithField("task", name).Writer(), stdoutIn)
+	}()
+	go func() {
+		_, _ = io.Copy(log.WithPrefix("task-stderr").WithField("task", name).Writer(), stderrIn)
+	}()
+
+	rpcClient := executorcmd.NewClient(commandInfo.ControlPort, commandInfo.ControlMode)
+	if rpcClient == nil {
+		_ = syscall.Kill(-taskCmd.Process.Pid, syscall.SIGKILL)
+		if commandInfo.Container != nil {
+			e.stopContainer(taskId)
+		}
+		return fmt.Errorf("cannot connect to task on control port %d", commandInfo.ControlPort)
+	}
+	rpcClient.TaskCmd = taskCmd
+
+	e.mu.Lock()
+	e.rpcClients[taskId] = rpcClient
+	if commandInfo.Container != nil {
+		e.containerized[taskId] = true
+	}
+	e.mu.Unlock()
+
+	go e.run(taskId, name, rpcClient)
+	return nil
+}
+
+// run waits for the task to be ready, forwards its events and finally reports
+// its termination.
+func (e *Executor) run(taskId mesos.TaskID, name string, rpcClient *executorcmd.RpcClient) {
+	status := e.newStatus(taskId)
+
+	var startupErr error
+	err := e.waitForStandby(taskId, name, rpcClient)
+	if err == nil {
+		var esc pb.Occ_EventStreamClient
+		esc, err = rpcClient.EventStream(context.TODO(), &pb.EventStreamRequest{}, grpc.EmptyCallOption{})
+		if err == nil {
+			status.State = mesos.TASK_RUNNING.Enum()
+			e.sendStatus(status)
+			go e.forwardEvents(taskId, esc)
+		}
+	}
+	if err != nil {
+		log.WithField("task", name).WithError(err).Error("task did not start correctly")
+		startupErr = err
+		_ = syscall.Kill(-rpcClient.TaskCmd.Process.Pid, syscall.SIGKILL)
+		if e.isContainerized(taskId) {
+			e.stopContainer(taskId)
+		}
+	}
+
+	err = rpcClient.TaskCmd.Wait()
+
+	e.mu.Lock()
+	_ = rpcClient.Close() // NOTE: might return non-nil error, but we don't care much
+	delete(e.rpcClients, taskId)
+	delete(e.containerized, taskId)
+	killed, isKilled := e.killedTasks[taskId]
+	delete(e.killedTasks, taskId)
+	e.mu.Unlock()
+
+	if isKilled {
+		// The process might exit on its own after the EXIT transition, so we wait for
+		// Kill to tell us how the teardown went.
+		status = <-killed
+	} else if startupErr != nil {
+		status = e.failedStatus(taskId, startupErr)
+	} else {
+		if err == nil {
+			err = errors.New("process exited without being killed")
+		}
+		log.WithFields(logrus.Fields{
+				"id":    taskId.Value,
+				"task":  name,
+				"error": err.Error(),
+			}).
+			Error("process terminated with error")
+		status = e.failedStatus(taskId, err)
+	}
+	log.WithField("task", name).
+		WithField("status", status.State.String()).
+		Debug("sending final status update")
+	e.sendStatus(status)
+}
+
+func (e *Executor) waitForStandby(taskId mesos.TaskID, name string, rpcClient *executorcmd.RpcClient) error {
+	for elapsed := 0 * time.Second; elapsed < startupTimeout; elapsed += startupPollingInterval {
+		response, err := rpcClient.GetState(context.TODO(), &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+		if err != nil {
+			log.WithError(err).WithField("task", name).Debug("cannot query task status")
+		} else {
+			// NOTE: we acquire the transitioner-dependent STANDBY equivalent state
+			switch rpcClient.FromDeviceState(response.GetState()) {
+			case "STANDBY":
+				log.WithField("id", taskId.Value).
+					WithField("task", name).
+					Debug("task running and ready for control input")
+				return nil
+			case "DONE", "ERROR":
+				return fmt.Errorf("task reached state %s on startup", response.GetState())
+			}
+		}
+		time.Sleep(startupPollingInterval)
+	}
+	return errors.New("timeout while waiting for task startup")
+}
+
+func (e *Executor) forwardEvents(taskId mesos.TaskID, esc pb.Occ_EventStreamClient) {
+	deo := event.DeviceEventOrigin{
+		AgentId:    e.agentId,
+		ExecutorId: e.executorId,
+		TaskId:     taskId,
+	}
+	for {
+		esr, err := esc.Recv()
+		if err == io.EOF {
+			log.WithError(err).Debug("event stream EOF")
+			return
+		}
+		if err != nil {
+			e.mu.RLock()
+			_, ok := e.rpcClients[taskId]
+			e.mu.RUnlock()
+			if !ok {
+				log.Debug("event stream done")
+				return
+			}
+			log.WithError(err).Warning("error receiving event from task")
+			time.Sleep(startupPollingInterval)
+			continue
+		}
+
+		deviceEvent := event.NewDeviceEvent(deo, esr.GetEvent().GetType())
+		if deviceEvent == nil {
+			log.Debug("nil DeviceEvent received (NULL_DEVICE_EVENT) - closing stream")
+			return
+		}
+		jsonEvent, err := json.Marshal(deviceEvent)
+		if err != nil {
+			log.WithError(err).Warning("error marshaling event from task")
+			continue
+		}
+		e.sendMessage(jsonEvent)
+	}
+}
+
+// HandleMessage processes a command for one of the tasks of this executor, as
+// the Mesos executor does with an incoming MESSAGE, and sends back the response
+// through the MessageFunc.
+func (e *Executor) HandleMessage(data []byte) (err error) {
+	var incoming struct {
+		Name            string       `json:"name"`
+		TargetList      []struct{
+			TaskId       mesos.TaskID
+		}                            `json:"targetList"`
+	}
+	err = json.Unmarshal(data, &incoming)
+	if err != nil {
+		return
+	}
+	if len(incoming.TargetList) != 1 {
+		err = fmt.Errorf("cannot apply ExecutorCommand with %d!=1 target taskIds", len(incoming.TargetList))
+		return
+	}
+	if incoming.Name != "MesosCommand_Transition" {
+		err = fmt.Errorf("unrecognized controlcommand %s", incoming.Name)
+		return
+	}
+
+	taskId := incoming.TargetList[0].TaskId
+	e.mu.RLock()
+	rpcClient, ok := e.rpcClients[taskId]
+	e.mu.RUnlock()
+	if !ok {
+		err = fmt.Errorf("no RPC client for taskId %s", taskId.Value)
+		return
+	}
+
+	var cmd *executorcmd.ExecutorCommand_Transition
+	cmd, err = rpcClient.UnmarshalTransition(data)
+	if err != nil {
+		return
+	}
+
+	newState, transitionError := cmd.Commit()
+
+	response := cmd.PrepareResponse(transitionError, newState, taskId.Value)
+	data, err = json.Marshal(response)
+	if err != nil {
+		return
+	}
+	e.sendMessage(data)
+	return
+}
+
+// Kill brings the task to its final state, kills its process group and reports
+// TASK_FINISHED, or TASK_KILLED if the task could not be ended gracefully.
+// It returns as soon as the teardown is started.
+func (e *Executor) Kill(taskId mesos.TaskID) error {
+	e.mu.Lock()
+	rpcClient, ok := e.rpcClients[taskId]
+	if !ok {
+		e.mu.Unlock()
+		return errors.New("invalid task ID")
+	}
+	if _, ok = e.killedTasks[taskId]; ok {
+		e.mu.Unlock()
+		return errors.New("task already being killed")
+	}
+	killed := make(chan mesos.TaskStatus, 1)
+	e.killedTasks[taskId] = killed
+	containerized := e.containerized[taskId]
+	e.mu.Unlock()
+
+	go func() {
+		reachedState := e.endTask(taskId, rpcClient)
+
+		status := e.newStatus(taskId)
+		if reachedState == "DONE" {
+			log.Debug("task exited correctly")
+			status.State = mesos.TASK_FINISHED.Enum()
+		} else { // something went wrong
+			log.Debug("task killed")
+			status.State = mesos.TASK_KILLED.Enum()
+		}
+		killed <- status
+
+		if containerized {
+			e.stopContainer(taskId)
+		}
+		// When killing we must always use syscall.Kill with a negative PID, in order to kill all
+		// children which were assigned the same PGID at launch
+		killErr := syscall.Kill(-rpcClient.TaskCmd.Process.Pid, syscall.SIGKILL)
+		if killErr != nil {
+			log.WithError(killErr).WithField("taskId", taskId.Value).Warning("could not kill task")
+		}
+	}()
+	return nil
+}
+
+func (e *Executor) isContainerized(taskId mesos.TaskID) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.containerized[taskId]
+}
+
+// stopContainer stops the container of a task which runs in one, since
+// killing the process group of the task leaves its container running.
+func (e *Executor) stopContainer(taskId mesos.TaskID) {
+	err := executorcmd.StopContainer(taskId.Value, e.containerRuntime)
+	if err != nil {
+		log.WithError(err).WithField("taskId", taskId.Value).Warning("could not stop task container")
+	}
+}
+
+// endTask walks the task through its state machine until DONE, and returns the
+// last state reached.
+func (e *Executor) endTask(taskId mesos.TaskID, rpcClient *executorcmd.RpcClient) (reachedState string) {
+	response, err := rpcClient.GetState(context.TODO(), &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		log.WithError(err).WithField("taskId", taskId.Value).Error("cannot query task status")
+		return
+	}
+	reachedState = rpcClient.FromDeviceState(response.GetState())
+
+	for reachedState != "DONE" {
+		var evt, destination string
+		switch reachedState {
+		case "RUNNING":
+			evt, destination = "STOP", "CONFIGURED"
+		case "CONFIGURED":
+			evt, destination = "RESET", "STANDBY"
+		case "ERROR":
+			evt, destination = "RECOVER", "STANDBY"
+		case "STANDBY":
+			evt, destination = "EXIT", "DONE"
+		default:
+			log.WithField("state", reachedState).Error("cannot gracefully end task")
+			return
+		}
+
+		cmd := executorcmd.NewLocalExecutorCommand_Transition(
+			rpcClient,
+			[]controlcommands.MesosCommandTarget{
+				{
+					AgentId:    e.agentId,
+					ExecutorId: e.executorId,
+					TaskId:     taskId,
+				},
+			},
+			reachedState,
+			evt,
+			destination,
+			nil,
+		)
+		newState, transitionError := cmd.Commit()
+		if transitionError != nil {
+			log.WithError(transitionError).Error("cannot gracefully end task")
+			return
+		}
+		reachedState = newState
+	}
+	return
+}
+
+func (e *Executor) newStatus(taskId mesos.TaskID) mesos.TaskStatus {
+	return mesos.TaskStatus{
+		TaskID:     taskId,
+		Source:     mesos.SOURCE_EXECUTOR.Enum(),
+		ExecutorID: &e.executorId,
+		AgentID:    &e.agentId,
+		UUID:       []byte(uuid.NewRandom()),
+	}
+}
+
+func (e *Executor) failedStatus(taskId mesos.TaskID, err error) mesos.TaskStatus {
+	status := e.newStatus(taskId)
+	status.State = mesos.TASK_FAILED.Enum()
+	message := err.Error()
+	status.Message = &message
+	return status
+}
@@ -0,0 +1,373 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package fakedevice implements a simulated O² device, which serves the OCC
+// gRPC API with the DIRECT or FairMQ state machine but does no data
+// processing.
+//
+// Transitions can be delayed and made to fail, and a RUNNING device can be
+// made to crash or to emit END_OF_DATA, so the executor and the core can be
+// exercised against many such devices without building FairMQ, and workflow
+// authors can rehearse their topologies.
+package fakedevice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/executor/protos"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var log = logger.New(logrus.StandardLogger(), "fakedevice")
+
+type Config struct {
+	ControlMode controlmode.ControlMode
+
+	// TransitionDelay is how long every transition takes, unless the event has
+	// its own entry in EventDelays.
+	TransitionDelay time.Duration
+	EventDelays     map[string]time.Duration
+
+	// FailEvents are the events, in the device's own state machine, whose
+	// transitions fail and leave the device in its error state.
+	FailEvents []string
+
+	// CrashAfter and EndOfDataAfter, if nonzero, are the times after which a
+	// RUNNING device respectively goes to its error state and quits, or emits
+	// an END_OF_DATA event.
+	CrashAfter     time.Duration
+	EndOfDataAfter time.Duration
+}
+
+// Device is a simulated O² device, which implements pb.OccServer.
+type Device struct {
+	cfg Config
+	sm  stateMachine
+
+	transitionMu sync.Mutex // serializes transitions
+	mu           sync.RWMutex
+	state        string
+	properties   map[string]string
+	runNumber    int // increases every time RUNNING is entered
+	stateSubs    map[chan *pb.StateStreamReply]struct{}
+	eventSubs    map[chan *pb.EventStreamReply]struct{}
+
+	crashed   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func NewDevice(cfg Config) *Device {
+	sm := stateMachineFor(cfg.ControlMode)
+	return &Device{
+		cfg:        cfg,
+		sm:         sm,
+		state:      sm.initial,
+		properties: make(map[string]string),
+		stateSubs:  make(map[chan *pb.StateStreamReply]struct{}),
+		eventSubs:  make(map[chan *pb.EventStreamReply]struct{}),
+		crashed:    make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Serve serves the OCC API on lis until the device reaches its final state or
+// crashes.
+func (d *Device) Serve(lis net.Listener) error {
+	server := grpc.NewServer()
+	pb.RegisterOccServer(server, d)
+
+	go func() {
+		select {
+		case <-d.done:
+		case <-d.crashed:
+		}
+		// Give the executor a chance to receive the last reply and state
+		time.Sleep(100 * time.Millisecond)
+		server.Stop()
+	}()
+	return server.Serve(lis)
+}
+
+// Stop makes Serve return as if the device had reached its final state.
+func (d *Device) Stop() {
+	d.closeOnce.Do(func() { close(d.done) })
+}
+
+// Done is closed when the device reaches its final state, or once stopped.
+func (d *Device) Done() <-chan struct{} {
+	return d.done
+}
+
+// Crashed is closed when the device crashes as configured with CrashAfter.
+func (d *Device) Crashed() <-chan struct{} {
+	return d.crashed
+}
+
+func (d *Device) State() string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.state
+}
+
+// Properties returns all the properties received with transitions so far.
+func (d *Device) Properties() map[string]string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	properties := make(map[string]string, len(d.properties))
+	for k, v := range d.properties {
+		properties[k] = v
+	}
+	return properties
+}
+
+func (d *Device) EventStream(req *pb.EventStreamRequest, srv pb.Occ_EventStreamServer) error {
+	ch := make(chan *pb.EventStreamReply, 16)
+	d.mu.Lock()
+	d.eventSubs[ch] = struct{}{}
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		delete(d.eventSubs, ch)
+		d.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-d.done:
+			return nil
+		case reply := <-ch:
+			if err := srv.Send(reply); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (d *Device) StateStream(req *pb.StateStreamRequest, srv pb.Occ_StateStreamServer) error {
+	ch := make(chan *pb.StateStreamReply, 16)
+	d.mu.Lock()
+	d.stateSubs[ch] = struct{}{}
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		delete(d.stateSubs, ch)
+		d.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-d.done:
+			return nil
+		case reply := <-ch:
+			if err := srv.Send(reply); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (d *Device) GetState(ctx context.Context, req *pb.GetStateRequest) (*pb.GetStateReply, error) {
+	return &pb.GetStateReply{State: d.State()}, nil
+}
+
+func (d *Device) Transition(ctx context.Context, req *pb.TransitionRequest) (*pb.TransitionReply, error) {
+	d.transitionMu.Lock()
+	defer d.transitionMu.Unlock()
+
+	evt := req.GetTransitionEvent()
+	src := d.State()
+	logFields := logrus.Fields{
+		"event": evt,
+		"src":   src,
+	}
+
+	if len(req.GetSrcState()) > 0 && req.GetSrcState() != src {
+		log.WithFields(logFields).WithField("expectedSrc", req.GetSrcState()).
+			Warn("transition requested from wrong state")
+		return d.reply(evt, src, false), nil
+	}
+	dst, ok := d.sm.transitions[src][evt]
+	if !ok {
+		log.WithFields(logFields).Warn("invalid transition")
+		return d.reply(evt, src, false), nil
+	}
+	logFields["dst"] = dst
+
+	d.receiveProperties(evt, req.GetArguments())
+
+	delay := d.cfg.TransitionDelay
+	if eventDelay, ok := d.cfg.EventDelays[evt]; ok {
+		delay = eventDelay
+	}
+	if delay > 0 {
+		log.WithFields(logFields).WithField("delay", delay.String()).Debug("transition in progress")
+		time.Sleep(delay)
+	}
+
+	for _, failEvent := range d.cfg.FailEvents {
+		if failEvent == evt {
+			log.WithFields(logFields).Error("transition failed as configured")
+			d.setState(d.sm.error)
+			return d.reply(evt, d.sm.error, false), nil
+		}
+	}
+
+	d.setState(dst)
+	log.WithFields(logFields).Info("transition done")
+	return d.reply(evt, dst, true), nil
+}
+
+func (d *Device) reply(evt string, state string, ok bool) *pb.TransitionReply {
+	return &pb.TransitionReply{
+		Trigger:         pb.StateChangeTrigger_EXECUTOR,
+		State:           state,
+		TransitionEvent: evt,
+		Ok:              ok,
+	}
+}
+
+func (d *Device) receiveProperties(evt string, arguments []*pb.ConfigEntry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, entry := range arguments {
+		d.properties[entry.GetKey()] = entry.GetValue()
+		log.WithFields(logrus.Fields{
+			"event": evt,
+			"key":   entry.GetKey(),
+			"value": entry.GetValue(),
+		}).
+			Info("property received")
+	}
+}
+
+func (d *Device) setState(state string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.state == state {
+		return
+	}
+	wasRunning := d.state == d.sm.running
+	d.state = state
+
+	stateType := pb.StateType_STATE_STABLE
+	if !d.sm.isStable(state) {
+		stateType = pb.StateType_STATE_INTERMEDIATE
+	}
+	for ch := range d.stateSubs {
+		select {
+		case ch <- &pb.StateStreamReply{Type: stateType, State: state}:
+		default:
+		}
+	}
+
+	if state == d.sm.running && !wasRunning {
+		d.runNumber++
+		d.scheduleWhileRunning(d.cfg.EndOfDataAfter, d.endOfData)
+		d.scheduleWhileRunning(d.cfg.CrashAfter, d.crash)
+	}
+	if state == d.sm.final {
+		d.closeOnce.Do(func() { close(d.done) })
+	}
+}
+
+// scheduleWhileRunning calls f after delay, unless the device has left the
+// RUNNING state it is in when this is called.
+func (d *Device) scheduleWhileRunning(delay time.Duration, f func()) {
+	if delay <= 0 {
+		return
+	}
+	runNumber := d.runNumber
+	time.AfterFunc(delay, func() {
+		d.transitionMu.Lock()
+		defer d.transitionMu.Unlock()
+		d.mu.RLock()
+		stillRunning := d.state == d.sm.running && d.runNumber == runNumber
+		d.mu.RUnlock()
+		if stillRunning {
+			f()
+		}
+	})
+}
+
+func (d *Device) endOfData() {
+	log.WithField("after", d.cfg.EndOfDataAfter.String()).Info("emitting END_OF_DATA")
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	for ch := range d.eventSubs {
+		select {
+		case ch <- &pb.EventStreamReply{Event: &pb.DeviceEvent{Type: pb.DeviceEventType_END_OF_DATA}}:
+		default:
+		}
+	}
+}
+
+func (d *Device) crash() {
+	log.WithField("after", d.cfg.CrashAfter.String()).Error("crashing as configured")
+	d.setState(d.sm.error)
+	close(d.crashed)
+}
+
+// ErrCrashed is returned by Run if the device crashed as configured.
+var ErrCrashed = errors.New("device crashed")
+
+// Run serves the OCC API on the given port until the device is done, and
+// returns ErrCrashed if it crashed instead.
+func Run(cfg Config, controlPort uint64) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", controlPort))
+	if err != nil {
+		return err
+	}
+	device := NewDevice(cfg)
+	log.WithFields(logrus.Fields{
+		"port":        controlPort,
+		"controlMode": cfg.ControlMode.String(),
+		"state":       device.State(),
+	}).Info("fake device waiting for control commands")
+
+	if err = device.Serve(lis); err != nil {
+		return err
+	}
+	select {
+	case <-device.Crashed():
+		return ErrCrashed
+	default:
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package fakedevice_test
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner/fairmq"
+	"github.com/AliceO2Group/Control/executor/protos"
+	"github.com/AliceO2Group/Control/occ/fakedevice"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// The devices are driven through the gRPC client of the executor, which
+// translates the O² transitions for the FairMQ state machine.
+var _ = Describe("fake device", func() {
+	var (
+		device *fakedevice.Device
+		client *executorcmd.RpcClient
+		lis    net.Listener
+		served chan error
+	)
+
+	serve := func(cfg fakedevice.Config) {
+		var err error
+		lis, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		device = fakedevice.NewDevice(cfg)
+		// The goroutine only sees this spec's device, listener and channel
+		d, l, ch := device, lis, make(chan error, 1)
+		go func() {
+			ch <- d.Serve(l)
+			close(ch)
+		}()
+		served = ch
+		client = executorcmd.NewClient(uint64(lis.Addr().(*net.TCPAddr).Port), cfg.ControlMode)
+		Expect(client).NotTo(BeNil())
+	}
+
+	transition := func(src string, evt string, dst string, args map[string]string) (string, error) {
+		cmd := executorcmd.NewLocalExecutorCommand_Transition(client, nil, src, evt, dst, nil)
+		cmd.Arguments = args
+		return cmd.Commit()
+	}
+
+	AfterEach(func() {
+		if client != nil {
+			_ = client.Close()
+		}
+		client = nil
+		if device != nil {
+			device.Stop()
+			_ = lis.Close()
+			Eventually(served).Should(BeClosed())
+		}
+		device, lis, served = nil, nil, nil
+	})
+
+	Describe("with the direct control mode", func() {
+		It("should go through the O² state machine and exit", func() {
+			serve(fakedevice.Config{})
+			Expect(device.State()).To(Equal(fakedevice.STANDBY))
+
+			state, err := transition("STANDBY", "CONFIGURE", "CONFIGURED", map[string]string{"chans.data.0.address": "tcp://*:5555"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal("CONFIGURED"))
+			Expect(device.Properties()).To(HaveKeyWithValue("chans.data.0.address", "tcp://*:5555"))
+
+			for _, step := range [][3]string{
+				{"CONFIGURED", "START", "RUNNING"},
+				{"RUNNING", "STOP", "CONFIGURED"},
+				{"CONFIGURED", "RESET", "STANDBY"},
+				{"STANDBY", "EXIT", "DONE"},
+			} {
+				state, err = transition(step[0], step[1], step[2], nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(state).To(Equal(step[2]))
+				Expect(device.State()).To(Equal(step[2]))
+			}
+			Eventually(device.Done()).Should(BeClosed())
+			Eventually(served).Should(Receive(BeNil()))
+		})
+
+		It("should refuse the transitions its state does not allow", func() {
+			serve(fakedevice.Config{})
+
+			state, err := transition("STANDBY", "START", "RUNNING", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(state).To(Equal("STANDBY"))
+
+			state, err = transition("CONFIGURED", "START", "RUNNING", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(state).To(Equal("STANDBY"))
+			Expect(device.State()).To(Equal(fakedevice.STANDBY))
+		})
+
+		It("should fail the configured transitions and recover", func() {
+			serve(fakedevice.Config{FailEvents: []string{"CONFIGURE"}})
+
+			state, err := transition("STANDBY", "CONFIGURE", "CONFIGURED", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(state).To(Equal("ERROR"))
+			Expect(device.State()).To(Equal(fakedevice.ERROR))
+
+			state, err = transition("ERROR", "RECOVER", "STANDBY", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal("STANDBY"))
+		})
+
+		It("should take as long as configured for each transition", func() {
+			serve(fakedevice.Config{
+				TransitionDelay: 10 * time.Millisecond,
+				EventDelays:     map[string]time.Duration{"CONFIGURE": 300 * time.Millisecond},
+			})
+
+			start := time.Now()
+			_, err := transition("STANDBY", "CONFIGURE", "CONFIGURED", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically(">=", 300*time.Millisecond))
+
+			start = time.Now()
+			_, err = transition("CONFIGURED", "START", "RUNNING", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically("<", 300*time.Millisecond))
+		})
+
+		It("should stream its states", func() {
+			serve(fakedevice.Config{})
+			stream, err := client.StateStream(context.Background(), &pb.StateStreamRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			// The stream only starts once the device has subscribed it
+			time.Sleep(100 * time.Millisecond)
+
+			_, err = transition("STANDBY", "CONFIGURE", "CONFIGURED", nil)
+			Expect(err).NotTo(HaveOccurred())
+			reply, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetState()).To(Equal(fakedevice.CONFIGURED))
+			Expect(reply.GetType()).To(Equal(pb.StateType_STATE_STABLE))
+		})
+
+		It("should emit END_OF_DATA while running", func() {
+			serve(fakedevice.Config{EndOfDataAfter: 100 * time.Millisecond})
+			stream, err := client.EventStream(context.Background(), &pb.EventStreamRequest{})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = transition("STANDBY", "CONFIGURE", "CONFIGURED", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = transition("CONFIGURED", "START", "RUNNING", nil)
+			Expect(err).NotTo(HaveOccurred())
+			reply, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetEvent().GetType()).To(Equal(pb.DeviceEventType_END_OF_DATA))
+		})
+
+		It("should crash while running if configured to", func() {
+			serve(fakedevice.Config{CrashAfter: 100 * time.Millisecond})
+
+			_, err := transition("STANDBY", "CONFIGURE", "CONFIGURED", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(device.Crashed(), 200*time.Millisecond).ShouldNot(BeClosed())
+
+			_, err = transition("CONFIGURED", "START", "RUNNING", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(device.Crashed()).Should(BeClosed())
+			Expect(device.State()).To(Equal(fakedevice.ERROR))
+			Eventually(served).Should(Receive())
+		})
+
+		It("should not crash once it has stopped running", func() {
+			serve(fakedevice.Config{CrashAfter: 200 * time.Millisecond})
+
+			_, err := transition("STANDBY", "CONFIGURE", "CONFIGURED", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = transition("CONFIGURED", "START", "RUNNING", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = transition("RUNNING", "STOP", "CONFIGURED", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(device.Crashed(), 400*time.Millisecond).ShouldNot(BeClosed())
+			Expect(device.State()).To(Equal(fakedevice.CONFIGURED))
+		})
+	})
+
+	Describe("with the FairMQ control mode", func() {
+		It("should go through the FairMQ state machine as the O² transitions require", func() {
+			serve(fakedevice.Config{ControlMode: controlmode.FAIRMQ})
+			Expect(device.State()).To(Equal(fairmq.IDLE))
+			Expect(client.FromDeviceState(device.State())).To(Equal("STANDBY"))
+
+			for _, step := range []struct {
+				src, evt, dst string
+				deviceState   string
+			}{
+				{"STANDBY", "CONFIGURE", "CONFIGURED", fairmq.READY},
+				{"CONFIGURED", "START", "RUNNING", fairmq.RUNNING},
+				{"RUNNING", "STOP", "CONFIGURED", fairmq.READY},
+				{"CONFIGURED", "RESET", "STANDBY", fairmq.IDLE},
+				{"STANDBY", "EXIT", "DONE", fairmq.EXITING},
+			} {
+				state, err := transition(step.src, step.evt, step.dst, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(state).To(Equal(step.dst))
+				Expect(device.State()).To(Equal(step.deviceState))
+			}
+			Eventually(device.Done()).Should(BeClosed())
+		})
+
+		It("should fail the configured FairMQ transitions", func() {
+			serve(fakedevice.Config{
+				ControlMode: controlmode.FAIRMQ,
+				FailEvents:  []string{fairmq.EvtINIT_TASK},
+			})
+
+			state, err := transition("STANDBY", "CONFIGURE", "CONFIGURED", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(state).To(Equal("ERROR"))
+			Expect(device.State()).To(Equal(fairmq.ERROR))
+		})
+	})
+})
@@ -0,0 +1,145 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018-2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * Portions from examples in <https://github.com/mesos/mesos-go>:
+ *     Copyright 2013-2015, Mesosphere, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package fakedevice
+
+import (
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner/fairmq"
+)
+
+// Direct states and events, as implemented by OCClib for non-FairMQ devices.
+const (
+	STANDBY    = "STANDBY"
+	CONFIGURED = "CONFIGURED"
+	RUNNING    = "RUNNING"
+	ERROR      = "ERROR"
+	DONE       = "DONE"
+
+	EvtCONFIGURE = "CONFIGURE"
+	EvtRESET     = "RESET"
+	EvtSTART     = "START"
+	EvtSTOP      = "STOP"
+	EvtEXIT      = "EXIT"
+	EvtGO_ERROR  = "GO_ERROR"
+	EvtRECOVER   = "RECOVER"
+)
+
+// transitionTable maps a source state and an event to a destination state.
+type transitionTable map[string]map[string]string
+
+type stateMachine struct {
+	initial     string
+	running     string
+	error       string
+	final       string
+	transitions transitionTable
+}
+
+var directStateMachine = stateMachine{
+	initial: STANDBY,
+	running: RUNNING,
+	error:   ERROR,
+	final:   DONE,
+	transitions: transitionTable{
+		STANDBY: {
+			EvtCONFIGURE: CONFIGURED,
+			EvtEXIT:      DONE,
+			EvtGO_ERROR:  ERROR,
+		},
+		CONFIGURED: {
+			EvtSTART:    RUNNING,
+			EvtRESET:    STANDBY,
+			EvtEXIT:     DONE,
+			EvtGO_ERROR: ERROR,
+		},
+		RUNNING: {
+			EvtSTOP:     CONFIGURED,
+			EvtGO_ERROR: ERROR,
+		},
+		ERROR: {
+			EvtRECOVER: STANDBY,
+			EvtEXIT:    DONE,
+		},
+	},
+}
+
+var fairMQStateMachine = stateMachine{
+	initial: fairmq.IDLE,
+	running: fairmq.RUNNING,
+	error:   fairmq.ERROR,
+	final:   fairmq.EXITING,
+	transitions: transitionTable{
+		fairmq.IDLE: {
+			fairmq.EvtINIT_DEVICE: fairmq.INITIALIZING_DEVICE,
+			fairmq.EvtEND:         fairmq.EXITING,
+		},
+		fairmq.INITIALIZING_DEVICE: {
+			fairmq.EvtCOMPLETE_INIT: fairmq.INITIALIZED,
+		},
+		fairmq.INITIALIZED: {
+			fairmq.EvtBIND:         fairmq.BOUND,
+			fairmq.EvtRESET_DEVICE: fairmq.IDLE,
+		},
+		fairmq.BOUND: {
+			fairmq.EvtCONNECT:      fairmq.DEVICE_READY,
+			fairmq.EvtRESET_DEVICE: fairmq.IDLE,
+		},
+		fairmq.DEVICE_READY: {
+			fairmq.EvtINIT_TASK:    fairmq.READY,
+			fairmq.EvtRESET_DEVICE: fairmq.IDLE,
+		},
+		fairmq.READY: {
+			fairmq.EvtRUN:        fairmq.RUNNING,
+			fairmq.EvtRESET_TASK: fairmq.DEVICE_READY,
+		},
+		fairmq.RUNNING: {
+			fairmq.EvtSTOP: fairmq.READY,
+		},
+		fairmq.ERROR: {
+			fairmq.EvtEND: fairmq.EXITING,
+		},
+	},
+}
+
+func stateMachineFor(cm controlmode.ControlMode) stateMachine {
+	if cm == controlmode.FAIRMQ {
+		return fairMQStateMachine
+	}
+	return directStateMachine
+}
+
+// isStable tells whether a state is one the device can rest in, as opposed to
+// the intermediate FairMQ states which the executor crosses in a single O²
+// transition.
+func (sm stateMachine) isStable(state string) bool {
+	switch state {
+	case fairmq.INITIALIZING_DEVICE, fairmq.INITIALIZED, fairmq.BOUND, fairmq.DEVICE_READY:
+		return false
+	}
+	return true
+}